@@ -0,0 +1,266 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"iter"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	blobstore_configuration "github.com/buildbarn/bb-storage/pkg/blobstore/configuration"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/grpcclients"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/mirrored"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/global"
+	bb_grpc "github.com/buildbarn/bb-storage/pkg/grpc"
+	"github.com/buildbarn/bb-storage/pkg/program"
+	"github.com/buildbarn/bb-storage/pkg/proto/configuration/bb_anti_entropy"
+	grpc_pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/grpc"
+	"github.com/buildbarn/bb-storage/pkg/proto/digestenumeration"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// A utility for repairing inconsistencies between the backends of a
+// mirrored pair of Content Addressable Storage backends.
+//
+// MirroredBlobAccess only repairs inconsistencies between backends
+// when clients call FindMissing() against blobs that are present in
+// only one of them. Blobs that are not accessed by clients therefore
+// remain present in only a single backend, meaning they are lost
+// entirely if that backend fails as well. This utility calls
+// FindMissing() for a list of digests provided through files,
+// replicating any blobs that are only present in one of the backends
+// to the other.
+//
+// The BlobAccess interface provides no way to enumerate stored blobs.
+// If both backends are capable of enumerating their contents (e.g.,
+// LocalBlobAccess with a digest index, exposed by bb_storage through
+// the DigestEnumeration service), this utility can also compare the
+// contents of the backends, and repair all blobs that are only present
+// in one of them. Otherwise, only blobs whose digests are contained in
+// the digest lists are checked.
+
+func main() {
+	program.RunMain(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+		if len(os.Args) != 2 {
+			return status.Error(codes.InvalidArgument, "Usage: bb_anti_entropy bb_anti_entropy.jsonnet")
+		}
+		var configuration bb_anti_entropy.ApplicationConfiguration
+		if err := util.UnmarshalConfigurationFromFile(os.Args[1], &configuration); err != nil {
+			return util.StatusWrapf(err, "Failed to read configuration from %s", os.Args[1])
+		}
+		lifecycleState, grpcClientFactory, err := global.ApplyConfiguration(configuration.Global)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to apply global configuration options")
+		}
+
+		mirroredConfiguration := configuration.Mirrored
+		if mirroredConfiguration == nil {
+			return status.Error(codes.InvalidArgument, "No mirrored storage configuration provided")
+		}
+		if configuration.BatchSize <= 0 {
+			return status.Error(codes.InvalidArgument, "Batch size must be positive")
+		}
+		if configuration.MaximumBlobsPerSecond <= 0 {
+			return status.Error(codes.InvalidArgument, "Maximum number of blobs per second must be positive")
+		}
+
+		blobAccessCreator := blobstore_configuration.NewCASBlobAccessCreator(
+			grpcClientFactory,
+			int(configuration.MaximumMessageSizeBytes))
+		backendA, err := blobstore_configuration.NewBlobAccessFromConfiguration(
+			dependenciesGroup,
+			mirroredConfiguration.BackendA,
+			blobAccessCreator)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to create backend A")
+		}
+		backendB, err := blobstore_configuration.NewBlobAccessFromConfiguration(
+			dependenciesGroup,
+			mirroredConfiguration.BackendB,
+			blobAccessCreator)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to create backend B")
+		}
+		blobReplicatorCreator := blobstore_configuration.NewCASBlobReplicatorCreator(grpcClientFactory)
+		replicatorAToB, err := blobstore_configuration.NewBlobReplicatorFromConfiguration(
+			dependenciesGroup,
+			mirroredConfiguration.ReplicatorAToB,
+			backendA.BlobAccess,
+			backendB,
+			blobReplicatorCreator)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to create replicator from backend A to backend B")
+		}
+		replicatorBToA, err := blobstore_configuration.NewBlobReplicatorFromConfiguration(
+			dependenciesGroup,
+			mirroredConfiguration.ReplicatorBToA,
+			backendB.BlobAccess,
+			backendA,
+			blobReplicatorCreator)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to create replicator from backend B to backend A")
+		}
+
+		repairer := mirrored.NewAntiEntropyRepairer(
+			backendA.BlobAccess,
+			backendB.BlobAccess,
+			replicatorAToB,
+			replicatorBToA,
+			clock.SystemClock,
+			util.DefaultErrorLogger,
+			int(configuration.BatchSize),
+			configuration.MaximumBlobsPerSecond,
+			"cas")
+
+		// Optionally compare the contents of both backends.
+		var scan func(ctx context.Context) (mirrored.AntiEntropyReport, error)
+		if scanConfiguration := configuration.Scan; scanConfiguration != nil {
+			summarizerA, err := newDigestRangeSummarizer(grpcClientFactory, scanConfiguration.BackendADigestEnumerationClient, backendA)
+			if err != nil {
+				return util.StatusWrap(err, "Failed to create digest range summarizer for backend A")
+			}
+			summarizerB, err := newDigestRangeSummarizer(grpcClientFactory, scanConfiguration.BackendBDigestEnumerationClient, backendB)
+			if err != nil {
+				return util.StatusWrap(err, "Failed to create digest range summarizer for backend B")
+			}
+			instanceNamePrefix, err := digest.NewInstanceName(scanConfiguration.InstanceNamePrefix)
+			if err != nil {
+				return util.StatusWrapf(err, "Invalid instance name prefix %#v", scanConfiguration.InstanceNamePrefix)
+			}
+			rangeBits := int(scanConfiguration.RangeBits)
+			if err := blobstore.ValidateDigestRangeBits(rangeBits); err != nil {
+				return err
+			}
+			if scanConfiguration.MaximumBlobsPerListing == 0 {
+				return status.Error(codes.InvalidArgument, "Maximum number of blobs per listing must be positive")
+			}
+			scan = func(ctx context.Context) (mirrored.AntiEntropyReport, error) {
+				return repairer.RepairDigestRanges(ctx, summarizerA, summarizerB, instanceNamePrefix, rangeBits, scanConfiguration.MaximumBlobsPerListing)
+			}
+		}
+
+		if configuration.PassInterval == nil {
+			// Perform a single pass, terminating afterwards.
+			return performPass(ctx, repairer, configuration.DigestListPaths, scan)
+		}
+
+		// Repeatedly perform passes, waiting for the configured
+		// interval between them.
+		if err := configuration.PassInterval.CheckValid(); err != nil {
+			return util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid pass interval")
+		}
+		passInterval := configuration.PassInterval.AsDuration()
+		siblingsGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+			for {
+				if err := performPass(ctx, repairer, configuration.DigestListPaths, scan); err != nil {
+					if ctx.Err() != nil {
+						return nil
+					}
+					log.Print(err)
+				}
+
+				timer, t := clock.SystemClock.NewTimer(passInterval)
+				select {
+				case <-t:
+				case <-ctx.Done():
+					timer.Stop()
+					return nil
+				}
+			}
+		})
+
+		lifecycleState.MarkReadyAndWait(siblingsGroup)
+		return nil
+	})
+}
+
+// newDigestRangeSummarizer creates a DigestRangeSummarizer for one of
+// the backends of the mirrored pair. The contents of the backend are
+// either obtained through the DigestEnumeration service, or by
+// enumerating the contents of the backend directly.
+func newDigestRangeSummarizer(grpcClientFactory bb_grpc.ClientFactory, clientConfiguration *grpc_pb.ClientConfiguration, backend blobstore_configuration.BlobAccessInfo) (blobstore.DigestRangeSummarizer, error) {
+	if clientConfiguration != nil {
+		client, err := grpcClientFactory.NewClientFromConfiguration(clientConfiguration)
+		if err != nil {
+			return nil, util.StatusWrap(err, "Failed to create DigestEnumeration client")
+		}
+		return grpcclients.NewDigestRangeSummarizer(client, digestenumeration.StorageType_CONTENT_ADDRESSABLE_STORAGE), nil
+	}
+	if backend.DigestEnumerator == nil {
+		return nil, status.Error(codes.InvalidArgument, "No DigestEnumeration client is configured, and the backend is not capable of enumerating its contents")
+	}
+	return blobstore.NewEnumeratingDigestRangeSummarizer(backend.DigestEnumerator), nil
+}
+
+// performPass performs a single pass of repairing the backends for all
+// digests contained in the provided digest list files, followed by
+// comparing the contents of the backends if enabled.
+func performPass(ctx context.Context, repairer *mirrored.AntiEntropyRepairer, digestListPaths []string, scan func(ctx context.Context) (mirrored.AntiEntropyReport, error)) error {
+	var totalReport mirrored.AntiEntropyReport
+	for _, digestListPath := range digestListPaths {
+		f, err := os.Open(digestListPath)
+		if err != nil {
+			return util.StatusWrapf(err, "Failed to open digest list %#v", digestListPath)
+		}
+		var parseErr error
+		report, err := repairer.Repair(ctx, readDigestList(f, &parseErr))
+		f.Close()
+		totalReport.Add(report)
+		if err != nil {
+			return util.StatusWrapf(err, "Failed to repair blobs in digest list %#v", digestListPath)
+		}
+		if parseErr != nil {
+			return util.StatusWrapf(parseErr, "Failed to read digest list %#v", digestListPath)
+		}
+	}
+	if scan != nil {
+		report, err := scan(ctx)
+		totalReport.Add(report)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to compare contents of backends")
+		}
+	}
+	log.Printf(
+		"Pass completed: %d consistent, %d replicated from backend A to backend B, %d replicated from backend B to backend A, %d missing from both backends, %d failed",
+		totalReport.Consistent,
+		totalReport.ReplicatedAToB,
+		totalReport.ReplicatedBToA,
+		totalReport.MissingFromBoth,
+		totalReport.Failed)
+	return nil
+}
+
+// readDigestList yields the digests contained in a digest list file.
+// Every line contains a single digest in ByteStream read path
+// notation. Empty lines and lines starting with '#' are ignored. As
+// iteration cannot be interrupted with an error, parse errors are
+// stored in the provided error variable.
+func readDigestList(f *os.File, parseErr *error) iter.Seq[digest.Digest] {
+	return func(yield func(digest.Digest) bool) {
+		scanner := bufio.NewScanner(f)
+		for lineNumber := 1; scanner.Scan(); lineNumber++ {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			blobDigest, _, err := digest.NewDigestFromByteStreamReadPath(line)
+			if err != nil {
+				*parseErr = util.StatusWrapf(err, "Invalid digest on line %d", lineNumber)
+				return
+			}
+			if !yield(blobDigest) {
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			*parseErr = err
+		}
+	}
+}
@@ -0,0 +1,388 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/replication"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/program"
+	"github.com/buildbarn/bb-storage/pkg/proto/configuration/bb_copy"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// objectKey identifies an object that needs to be copied. The type of
+// the object is included, as copying a Directory object also copies
+// its children, while copying the same object as a blob does not.
+type objectKey struct {
+	objectType bb_copy.DigestSourceConfiguration_ObjectType
+	digest     digest.Digest
+}
+
+func (k objectKey) String() string {
+	return k.objectType.String() + " " + k.digest.GetByteStreamReadPath(remoteexecution.Compressor_IDENTITY)
+}
+
+// copier keeps track of objects that need to be copied. Individual
+// blobs are copied in batches, while Action, Directory and Tree
+// objects are enqueued in a NestedBlobReplicator. Entries in the
+// Action Cache are loaded, followed by enqueueing the objects they
+// reference in the NestedBlobReplicator. Objects that are copied
+// successfully are recorded in a checkpoint file, so that they can be
+// skipped when the copy is resumed.
+type copier struct {
+	source                  blobstore.BlobAccess
+	sink                    blobstore.BlobAccess
+	replicator              replication.BlobReplicator
+	nestedReplicator        *replication.NestedBlobReplicator
+	blobBatchSize           int
+	actionCacheSource       blobstore.BlobAccess
+	actionCacheSink         blobstore.BlobAccess
+	maximumMessageSizeBytes int
+
+	// Fields that are only accessed while reading digests.
+	checkpointedObjects map[objectKey]struct{}
+	blobsToCopy         digest.SetBuilder
+	actionResultsToCopy []digest.Digest
+
+	lock sync.Mutex
+	// Objects that have been scheduled to be copied, but for which
+	// copying has not finished yet. Entries are removed once
+	// copying finishes, so that memory usage does not grow with
+	// the total number of objects that are copied.
+	objectsInFlight  map[objectKey]struct{}
+	checkpointFile   *os.File
+	checkpointWriter *bufio.Writer
+	checkpointErr    error
+	copied           int
+	skipped          int
+	missing          int
+	failed           int
+}
+
+func newCopier(source, sink blobstore.BlobAccess, replicator replication.BlobReplicator, nestedReplicator *replication.NestedBlobReplicator, blobBatchSize int, actionCacheSource, actionCacheSink blobstore.BlobAccess, maximumMessageSizeBytes int) *copier {
+	return &copier{
+		source:                  source,
+		sink:                    sink,
+		replicator:              replicator,
+		nestedReplicator:        nestedReplicator,
+		blobBatchSize:           blobBatchSize,
+		actionCacheSource:       actionCacheSource,
+		actionCacheSink:         actionCacheSink,
+		maximumMessageSizeBytes: maximumMessageSizeBytes,
+
+		checkpointedObjects: map[objectKey]struct{}{},
+		blobsToCopy:         digest.NewSetBuilder(),
+
+		objectsInFlight: map[objectKey]struct{}{},
+	}
+}
+
+// openCheckpoint loads the set of objects that were copied during
+// previous runs from a checkpoint file, and opens it for appending
+// objects that are copied during this run.
+func (c *copier) openCheckpoint(path string) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o666)
+	if err != nil {
+		return util.StatusWrapf(err, "Failed to open checkpoint file %#v", path)
+	}
+
+	scanner := bufio.NewScanner(f)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		objectType, ok := bb_copy.DigestSourceConfiguration_ObjectType_value[fields[0]]
+		if !ok || len(fields) != 2 {
+			f.Close()
+			return status.Errorf(codes.InvalidArgument, "Invalid record on line %d of checkpoint file %#v", lineNumber, path)
+		}
+		blobDigest, _, err := digest.NewDigestFromByteStreamReadPath(fields[1])
+		if err != nil {
+			f.Close()
+			return util.StatusWrapf(err, "Invalid digest on line %d of checkpoint file %#v", lineNumber, path)
+		}
+		c.checkpointedObjects[objectKey{
+			objectType: bb_copy.DigestSourceConfiguration_ObjectType(objectType),
+			digest:     blobDigest,
+		}] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		f.Close()
+		return util.StatusWrapf(err, "Failed to read checkpoint file %#v", path)
+	}
+
+	c.checkpointFile = f
+	c.checkpointWriter = bufio.NewWriter(f)
+	return nil
+}
+
+// closeCheckpoint closes the checkpoint file, returning any error that
+// occurred while writing to it.
+func (c *copier) closeCheckpoint() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.checkpointFile == nil {
+		return nil
+	}
+	if err := c.checkpointWriter.Flush(); err != nil && c.checkpointErr == nil {
+		c.checkpointErr = err
+	}
+	if err := c.checkpointFile.Close(); err != nil && c.checkpointErr == nil {
+		c.checkpointErr = err
+	}
+	c.checkpointFile = nil
+	if c.checkpointErr != nil {
+		return util.StatusWrap(c.checkpointErr, "Failed to write to checkpoint file")
+	}
+	return nil
+}
+
+// recordCopiedLocked appends objects that have been copied to the
+// checkpoint file. The checkpoint file is flushed immediately, so that
+// no progress is lost if the process is terminated.
+func (c *copier) recordCopiedLocked(keys ...objectKey) {
+	if c.checkpointFile == nil || c.checkpointErr != nil {
+		return
+	}
+	for _, key := range keys {
+		if _, err := fmt.Fprintln(c.checkpointWriter, key); err != nil {
+			c.checkpointErr = err
+			return
+		}
+	}
+	if err := c.checkpointWriter.Flush(); err != nil {
+		c.checkpointErr = err
+	}
+}
+
+// addObject schedules an object to be copied, unless it was already
+// copied before or is currently being copied.
+func (c *copier) addObject(ctx context.Context, objectType bb_copy.DigestSourceConfiguration_ObjectType, blobDigest digest.Digest) error {
+	key := objectKey{
+		objectType: objectType,
+		digest:     blobDigest,
+	}
+	if _, ok := c.checkpointedObjects[key]; ok {
+		c.lock.Lock()
+		c.skipped++
+		c.lock.Unlock()
+		return nil
+	}
+	c.lock.Lock()
+	if _, ok := c.objectsInFlight[key]; ok {
+		c.lock.Unlock()
+		return nil
+	}
+	c.objectsInFlight[key] = struct{}{}
+	c.lock.Unlock()
+
+	switch objectType {
+	case bb_copy.DigestSourceConfiguration_BLOB:
+		c.blobsToCopy.Add(blobDigest)
+		if c.blobsToCopy.Length() >= c.blobBatchSize {
+			return c.flushBlobs(ctx)
+		}
+	case bb_copy.DigestSourceConfiguration_ACTION:
+		c.nestedReplicator.EnqueueAction(blobDigest, c.getCompletionFunc(key))
+	case bb_copy.DigestSourceConfiguration_DIRECTORY:
+		c.nestedReplicator.EnqueueDirectory(blobDigest, c.getCompletionFunc(key))
+	case bb_copy.DigestSourceConfiguration_TREE:
+		c.nestedReplicator.EnqueueTree(blobDigest, c.getCompletionFunc(key))
+	case bb_copy.DigestSourceConfiguration_ACTION_RESULT:
+		if c.actionCacheSource == nil {
+			return status.Error(codes.InvalidArgument, "Copying Action Cache entries requires an Action Cache to be configured")
+		}
+		c.actionResultsToCopy = append(c.actionResultsToCopy, blobDigest)
+	default:
+		return status.Error(codes.InvalidArgument, "Unknown object type")
+	}
+	return nil
+}
+
+// flushBlobs copies all individual blobs that have been added, but not
+// copied yet. Blobs that are already present in the sink are skipped.
+// Failures are logged and counted, as opposed to causing the copy to
+// stop. An error is only returned if the copy is interrupted.
+func (c *copier) flushBlobs(ctx context.Context) error {
+	blobs := c.blobsToCopy.Build()
+	c.blobsToCopy = digest.NewSetBuilder()
+	if blobs.Empty() {
+		return nil
+	}
+	defer c.finishBlobs(blobs)
+
+	missingFromSink, err := c.sink.FindMissing(ctx, blobs)
+	if err != nil {
+		return c.reportBlobsFailed(ctx, blobs, util.StatusWrap(err, "Failed to check for existence of blobs in sink"))
+	}
+	missingFromSource, err := c.source.FindMissing(ctx, missingFromSink)
+	if err != nil {
+		return c.reportBlobsFailed(ctx, blobs, util.StatusWrap(err, "Failed to check for existence of blobs in source"))
+	}
+	blobsToReplicate, _, _ := digest.GetDifferenceAndIntersection(missingFromSink, missingFromSource)
+	if err := c.replicator.ReplicateMultiple(ctx, blobsToReplicate); err != nil {
+		return c.reportBlobsFailed(ctx, blobs, util.StatusWrap(err, "Failed to replicate blobs"))
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.copied += blobsToReplicate.Length()
+	c.skipped += blobs.Length() - missingFromSink.Length()
+	c.missing += missingFromSource.Length()
+	for _, blobDigest := range missingFromSource.Items() {
+		log.Printf("Blob %s is not present in the source", blobDigest)
+	}
+
+	copiedBlobs, _, _ := digest.GetDifferenceAndIntersection(blobs, missingFromSource)
+	keys := make([]objectKey, 0, copiedBlobs.Length())
+	for _, blobDigest := range copiedBlobs.Items() {
+		keys = append(keys, objectKey{
+			objectType: bb_copy.DigestSourceConfiguration_BLOB,
+			digest:     blobDigest,
+		})
+	}
+	c.recordCopiedLocked(keys...)
+	return nil
+}
+
+// finishBlobs removes individual blobs from the set of objects that
+// are being copied.
+func (c *copier) finishBlobs(blobs digest.Set) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, blobDigest := range blobs.Items() {
+		delete(c.objectsInFlight, objectKey{
+			objectType: bb_copy.DigestSourceConfiguration_BLOB,
+			digest:     blobDigest,
+		})
+	}
+}
+
+// reportBlobsFailed logs and counts blobs that could not be copied.
+// If the copy is being interrupted, the error is returned instead, as
+// the blobs are not at fault.
+func (c *copier) reportBlobsFailed(ctx context.Context, blobs digest.Set, err error) error {
+	if ctx.Err() != nil {
+		return err
+	}
+	log.Printf("Failed to copy %d blobs: %s", blobs.Length(), err)
+
+	c.lock.Lock()
+	c.failed += blobs.Length()
+	c.lock.Unlock()
+	return nil
+}
+
+// enqueueActionResults loads all entries from the Action Cache that
+// have been added, and enqueues the objects they reference in the
+// NestedBlobReplicator. Entries are loaded concurrently.
+func (c *copier) enqueueActionResults(ctx context.Context, concurrency int) error {
+	actionDigests := make(chan digest.Digest)
+	if err := program.RunLocal(ctx, func(groupCtx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+		siblingsGroup.Go(func(groupCtx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+			defer close(actionDigests)
+			for _, actionDigest := range c.actionResultsToCopy {
+				select {
+				case actionDigests <- actionDigest:
+				case <-groupCtx.Done():
+					return util.StatusFromContext(groupCtx)
+				}
+			}
+			return nil
+		})
+		for i := 0; i < concurrency; i++ {
+			siblingsGroup.Go(func(groupCtx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+				// Use the outer context, as it is captured by
+				// completion functions that run after this
+				// group has terminated.
+				for actionDigest := range actionDigests {
+					c.enqueueActionResult(ctx, actionDigest)
+				}
+				return nil
+			})
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	c.actionResultsToCopy = nil
+	return nil
+}
+
+func (c *copier) enqueueActionResult(ctx context.Context, actionDigest digest.Digest) {
+	key := objectKey{
+		objectType: bb_copy.DigestSourceConfiguration_ACTION_RESULT,
+		digest:     actionDigest,
+	}
+	actionResultMessage, err := c.actionCacheSource.Get(ctx, actionDigest).ToProto(&remoteexecution.ActionResult{}, c.maximumMessageSizeBytes)
+	if err != nil {
+		c.getCompletionFunc(key)(util.StatusWrap(err, "Failed to load action result"))
+		return
+	}
+	actionResult := actionResultMessage.(*remoteexecution.ActionResult)
+
+	// Only store the ActionResult in the sink after all of the
+	// objects it references have been copied, so that the sink
+	// never contains entries that refer to missing objects.
+	completionFunc := c.getCompletionFunc(key)
+	c.nestedReplicator.EnqueueActionResult(actionDigest, actionResult, func(err error) {
+		if err == nil {
+			if err = c.actionCacheSink.Put(ctx, actionDigest, buffer.NewProtoBufferFromProto(actionResult, buffer.UserProvided)); err != nil {
+				err = util.StatusWrap(err, "Failed to store action result")
+			}
+		}
+		completionFunc(err)
+	})
+}
+
+// getCompletionFunc returns a completion function for an Action,
+// ActionResult, Directory or Tree object that is copied by
+// NestedBlobReplicator.
+func (c *copier) getCompletionFunc(key objectKey) replication.NestedObjectCompletionFunc {
+	return func(err error) {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		delete(c.objectsInFlight, key)
+		if err == nil {
+			c.copied++
+			c.recordCopiedLocked(key)
+		} else if status.Code(err) == codes.NotFound {
+			c.missing++
+			log.Printf("%s is not present in the source: %s", key, err)
+		} else {
+			c.failed++
+			log.Printf("Failed to copy %s: %s", key, err)
+		}
+	}
+}
+
+// printSummary prints the number of objects that were copied, skipped
+// and missing. An error is returned if copying any of the objects
+// failed.
+func (c *copier) printSummary() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	log.Printf("Copied %d objects, skipped %d objects that were already present, %d objects are missing from the source", c.copied, c.skipped, c.missing)
+	if c.failed > 0 {
+		return status.Errorf(codes.Unavailable, "Failed to copy %d objects", c.failed)
+	}
+	return nil
+}
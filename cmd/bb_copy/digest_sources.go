@@ -0,0 +1,248 @@
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/proto/configuration/bb_copy"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Field numbers of messages contained in Bazel's execution log, as
+// declared in src/main/protobuf/spawn.proto. These are decoded without
+// using generated message types, as this only requires a small subset
+// of the fields.
+const (
+	spawnExecInputsFieldNumber        protowire.Number = 4
+	spawnExecActualOutputsFieldNumber protowire.Number = 11
+	fileDigestFieldNumber             protowire.Number = 2
+	digestHashFieldNumber             protowire.Number = 1
+	digestSizeBytesFieldNumber        protowire.Number = 2
+)
+
+// addObjectFunc is called by readDigestSource for every object whose
+// digest is read.
+type addObjectFunc func(objectType bb_copy.DigestSourceConfiguration_ObjectType, blobDigest digest.Digest) error
+
+// readDigestSource reads digests of objects that need to be copied
+// from a file or standard input.
+func readDigestSource(configuration *bb_copy.DigestSourceConfiguration, digestFunction digest.Function, maximumMessageSizeBytes int, addObject addObjectFunc) error {
+	var r io.Reader
+	if configuration.Path == "-" {
+		r = os.Stdin
+	} else {
+		f, err := os.Open(configuration.Path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+	br := bufio.NewReader(r)
+
+	switch configuration.Format {
+	case bb_copy.DigestSourceConfiguration_NEWLINE_DELIMITED:
+		return readNewlineDelimitedDigests(br, digestFunction, configuration.ObjectType, addObject)
+	case bb_copy.DigestSourceConfiguration_BAZEL_EXECUTION_LOG_BINARY:
+		return readBazelExecutionLogBinary(br, digestFunction, maximumMessageSizeBytes, addObject)
+	case bb_copy.DigestSourceConfiguration_BUILD_EVENT_JSON:
+		return readBuildEventJSON(br, digestFunction, addObject)
+	default:
+		return status.Error(codes.InvalidArgument, "Unknown digest source format")
+	}
+}
+
+// parseDigest parses a digest in the form "${hash}-${size}" or
+// "${hash}/${size}".
+func parseDigest(s string, digestFunction digest.Function) (digest.Digest, error) {
+	separator := strings.LastIndexAny(s, "-/")
+	if separator < 0 {
+		return digest.BadDigest, status.Error(codes.InvalidArgument, "Digest does not contain a separator between the hash and the size")
+	}
+	sizeBytes, err := strconv.ParseInt(s[separator+1:], 10, 64)
+	if err != nil {
+		return digest.BadDigest, status.Errorf(codes.InvalidArgument, "Invalid size %#v", s[separator+1:])
+	}
+	return digestFunction.NewDigest(s[:separator], sizeBytes)
+}
+
+func readNewlineDelimitedDigests(r io.Reader, digestFunction digest.Function, objectType bb_copy.DigestSourceConfiguration_ObjectType, addObject addObjectFunc) error {
+	scanner := bufio.NewScanner(r)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		blobDigest, err := parseDigest(line, digestFunction)
+		if err != nil {
+			return util.StatusWrapf(err, "Invalid digest on line %d", lineNumber)
+		}
+		if err := addObject(objectType, blobDigest); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// visitProtoFields calls a function for every field contained in a
+// marshaled Protobuf message. For length-delimited fields, the value
+// excludes the length prefix.
+func visitProtoFields(b []byte, visitor func(fieldNumber protowire.Number, fieldType protowire.Type, value []byte) error) error {
+	for len(b) > 0 {
+		fieldNumber, fieldType, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return status.Error(codes.InvalidArgument, protowire.ParseError(n).Error())
+		}
+		b = b[n:]
+		n = protowire.ConsumeFieldValue(fieldNumber, fieldType, b)
+		if n < 0 {
+			return status.Errorf(codes.InvalidArgument, "Field %d: %s", fieldNumber, protowire.ParseError(n))
+		}
+		value := b[:n]
+		if fieldType == protowire.BytesType {
+			value, _ = protowire.ConsumeBytes(value)
+		}
+		if err := visitor(fieldNumber, fieldType, value); err != nil {
+			return err
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// parseSpawnExecFileDigest extracts the digest from a File message
+// contained in Bazel's execution log. Files that don't have a digest
+// (e.g., directories) are ignored.
+func parseSpawnExecFileDigest(file []byte, digestFunction digest.Function) (digest.Digest, bool, error) {
+	var hash string
+	var sizeBytes uint64
+	found := false
+	if err := visitProtoFields(file, func(fieldNumber protowire.Number, fieldType protowire.Type, value []byte) error {
+		if fieldNumber == fileDigestFieldNumber && fieldType == protowire.BytesType {
+			found = true
+			return visitProtoFields(value, func(fieldNumber protowire.Number, fieldType protowire.Type, value []byte) error {
+				switch {
+				case fieldNumber == digestHashFieldNumber && fieldType == protowire.BytesType:
+					hash = string(value)
+				case fieldNumber == digestSizeBytesFieldNumber && fieldType == protowire.VarintType:
+					sizeBytes, _ = protowire.ConsumeVarint(value)
+				}
+				return nil
+			})
+		}
+		return nil
+	}); err != nil {
+		return digest.BadDigest, false, err
+	}
+	if !found || hash == "" {
+		return digest.BadDigest, false, nil
+	}
+	blobDigest, err := digestFunction.NewDigest(hash, int64(sizeBytes))
+	if err != nil {
+		return digest.BadDigest, false, err
+	}
+	return blobDigest, true, nil
+}
+
+func readBazelExecutionLogBinary(r *bufio.Reader, digestFunction digest.Function, maximumMessageSizeBytes int, addObject addObjectFunc) error {
+	for spawnIndex := 0; ; spawnIndex++ {
+		// Every SpawnExec message is prefixed with its size.
+		size, err := binary.ReadUvarint(r)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return util.StatusWrapf(err, "Failed to read size of spawn at index %d", spawnIndex)
+		}
+		if size > uint64(maximumMessageSizeBytes) {
+			return status.Errorf(codes.InvalidArgument, "Spawn at index %d has size %d, which exceeds the maximum message size of %d bytes", spawnIndex, size, maximumMessageSizeBytes)
+		}
+		spawnExec := make([]byte, size)
+		if _, err := io.ReadFull(r, spawnExec); err != nil {
+			return util.StatusWrapf(err, "Failed to read spawn at index %d", spawnIndex)
+		}
+
+		if err := visitProtoFields(spawnExec, func(fieldNumber protowire.Number, fieldType protowire.Type, value []byte) error {
+			if (fieldNumber != spawnExecInputsFieldNumber && fieldNumber != spawnExecActualOutputsFieldNumber) || fieldType != protowire.BytesType {
+				return nil
+			}
+			blobDigest, ok, err := parseSpawnExecFileDigest(value, digestFunction)
+			if err != nil || !ok {
+				return err
+			}
+			return addObject(bb_copy.DigestSourceConfiguration_BLOB, blobDigest)
+		}); err != nil {
+			return util.StatusWrapf(err, "Spawn at index %d", spawnIndex)
+		}
+	}
+}
+
+// visitByteStreamURIs calls a function for every "uri" field
+// containing a "bytestream://" URI, contained anywhere in a decoded
+// JSON value.
+func visitByteStreamURIs(value any, visitor func(uri string) error) error {
+	switch v := value.(type) {
+	case map[string]any:
+		if uri, ok := v["uri"].(string); ok && strings.HasPrefix(uri, "bytestream://") {
+			if err := visitor(uri); err != nil {
+				return err
+			}
+		}
+		for _, child := range v {
+			if err := visitByteStreamURIs(child, visitor); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for _, child := range v {
+			if err := visitByteStreamURIs(child, visitor); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func readBuildEventJSON(r io.Reader, digestFunction digest.Function, addObject addObjectFunc) error {
+	decoder := json.NewDecoder(r)
+	for eventIndex := 0; ; eventIndex++ {
+		var event any
+		if err := decoder.Decode(&event); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return util.StatusWrapfWithCode(err, codes.InvalidArgument, "Failed to decode build event at index %d", eventIndex)
+		}
+
+		if err := visitByteStreamURIs(event, func(uri string) error {
+			// Strip the scheme and the host name, so that the
+			// remainder can be parsed as a ByteStream path.
+			// Only the hash and size of the resulting digest
+			// are used, as the instance name used by the
+			// client may differ from the configured one.
+			_, path, ok := strings.Cut(strings.TrimPrefix(uri, "bytestream://"), "/")
+			if !ok {
+				return status.Errorf(codes.InvalidArgument, "URI %#v does not contain a path", uri)
+			}
+			uriDigest, _, err := digest.NewDigestFromByteStreamReadPath(path)
+			if err != nil {
+				return util.StatusWrapf(err, "Invalid URI %#v", uri)
+			}
+			blobDigest, err := digestFunction.NewDigest(uriDigest.GetHashString(), uriDigest.GetSizeBytes())
+			if err != nil {
+				return util.StatusWrapf(err, "Invalid URI %#v", uri)
+			}
+			return addObject(bb_copy.DigestSourceConfiguration_BLOB, blobDigest)
+		}); err != nil {
+			return util.StatusWrapf(err, "Build event at index %d", eventIndex)
+		}
+	}
+}
@@ -3,13 +3,16 @@ package main
 import (
 	"context"
 	"os"
+	"strings"
 
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	blobstore_configuration "github.com/buildbarn/bb-storage/pkg/blobstore/configuration"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/replication"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/grpc"
 	"github.com/buildbarn/bb-storage/pkg/program"
 	"github.com/buildbarn/bb-storage/pkg/proto/configuration/bb_copy"
+	replicator_pb "github.com/buildbarn/bb-storage/pkg/proto/replicator"
 	"github.com/buildbarn/bb-storage/pkg/util"
 
 	"google.golang.org/grpc/codes"
@@ -22,8 +25,11 @@ import (
 //
 // The difference is that bb_replicator accepts requests of objects to
 // copy through gRPC, while this utility accepts a list of digests in
-// its configuration file, terminating as soon as replication is
-// completed.
+// its configuration file or in files referenced by it, terminating as
+// soon as replication is completed. Progress may be recorded in a
+// checkpoint file, so that an interrupted copy can be resumed. Entries
+// in the Action Cache may also be copied, either by listing them
+// explicitly or by enumerating them by instance name prefix.
 //
 // When used in combination with ZIPReadingBlobAccess and
 // ZIPWritingBlobAccess, this tool can also be used to backup and
@@ -39,6 +45,10 @@ func main() {
 			return util.StatusWrapf(err, "Failed to read configuration from %s", os.Args[1])
 		}
 
+		// Let replicators that limit concurrency give precedence
+		// to replication performed on behalf of clients.
+		ctx = replication.NewContextWithPriority(ctx, replicator_pb.Priority_BULK)
+
 		grpcClientFactory := grpc.NewBaseClientFactory(grpc.BaseClientDialer, nil, nil)
 
 		blobAccessCreator := blobstore_configuration.NewCASBlobAccessCreator(
@@ -59,6 +69,7 @@ func main() {
 			return util.StatusWrap(err, "Failed to create sink")
 		}
 		replicator, err := blobstore_configuration.NewBlobReplicatorFromConfiguration(
+			dependenciesGroup,
 			configuration.Replicator,
 			source.BlobAccess,
 			sink,
@@ -81,44 +92,127 @@ func main() {
 			return util.StatusWrap(err, "Invalid digest function")
 		}
 
-		// Enqueue objects for replication.
-		for i, action := range configuration.Actions {
-			actionDigest, err := digestFunction.NewDigestFromProto(action)
+		// Optionally copy entries in the Action Cache, together
+		// with the objects in the Content Addressable Storage that
+		// they reference.
+		var actionCacheSource blobstore_configuration.BlobAccessInfo
+		var actionCacheSink blobstore_configuration.BlobAccessInfo
+		if actionCacheConfiguration := configuration.ActionCache; actionCacheConfiguration != nil {
+			actionCacheSource, err = blobstore_configuration.NewBlobAccessFromConfiguration(
+				dependenciesGroup,
+				actionCacheConfiguration.Source,
+				blobstore_configuration.NewACBlobAccessCreator(
+					&source,
+					grpcClientFactory,
+					int(configuration.MaximumMessageSizeBytes)))
 			if err != nil {
-				return util.StatusWrapf(err, "Invalid action digest at index %d", i)
+				return util.StatusWrap(err, "Failed to create Action Cache source")
 			}
-			nestedReplicator.EnqueueAction(actionDigest)
-		}
-		for i, blob := range configuration.Blobs {
-			blobDigest, err := digestFunction.NewDigestFromProto(blob)
+			actionCacheSink, err = blobstore_configuration.NewBlobAccessFromConfiguration(
+				dependenciesGroup,
+				actionCacheConfiguration.Sink,
+				blobstore_configuration.NewACBlobAccessCreator(
+					&sink,
+					grpcClientFactory,
+					int(configuration.MaximumMessageSizeBytes)))
 			if err != nil {
-				return util.StatusWrapf(err, "Invalid blob digest at index %d", i)
+				return util.StatusWrap(err, "Failed to create Action Cache sink")
 			}
-			if err := replicator.ReplicateMultiple(ctx, blobDigest.ToSingletonSet()); err != nil {
-				return util.StatusWrapf(err, "Failed to schedule replication of blob with digest %#v", blobDigest.String())
+		}
+
+		c := newCopier(
+			source.BlobAccess,
+			sink.BlobAccess,
+			replicator,
+			nestedReplicator,
+			max(int(configuration.BlobBatchSize), 1),
+			actionCacheSource.BlobAccess,
+			actionCacheSink.BlobAccess,
+			int(configuration.MaximumMessageSizeBytes))
+		if configuration.CheckpointPath != "" {
+			if err := c.openCheckpoint(configuration.CheckpointPath); err != nil {
+				return err
 			}
 		}
-		for i, directory := range configuration.Directories {
-			directoryDigest, err := digestFunction.NewDigestFromProto(directory)
-			if err != nil {
-				return util.StatusWrapf(err, "Invalid directory digest at index %d", i)
+		defer c.closeCheckpoint()
+
+		// Enqueue objects listed in the configuration file for
+		// replication.
+		for _, objects := range []struct {
+			objectType bb_copy.DigestSourceConfiguration_ObjectType
+			digests    []*remoteexecution.Digest
+		}{
+			{bb_copy.DigestSourceConfiguration_ACTION, configuration.Actions},
+			{bb_copy.DigestSourceConfiguration_BLOB, configuration.Blobs},
+			{bb_copy.DigestSourceConfiguration_DIRECTORY, configuration.Directories},
+			{bb_copy.DigestSourceConfiguration_TREE, configuration.Trees},
+		} {
+			for i, objectDigest := range objects.digests {
+				d, err := digestFunction.NewDigestFromProto(objectDigest)
+				if err != nil {
+					return util.StatusWrapf(err, "Invalid %s digest at index %d", strings.ToLower(objects.objectType.String()), i)
+				}
+				if err := c.addObject(ctx, objects.objectType, d); err != nil {
+					return err
+				}
 			}
-			nestedReplicator.EnqueueDirectory(directoryDigest)
 		}
-		for i, tree := range configuration.Trees {
-			treeDigest, err := digestFunction.NewDigestFromProto(tree)
-			if err != nil {
-				return util.StatusWrapf(err, "Invalid tree digest at index %d", i)
+
+		// Enqueue objects listed in digest sources for replication.
+		for i, digestSource := range configuration.DigestSources {
+			if err := readDigestSource(
+				digestSource,
+				digestFunction,
+				int(configuration.MaximumMessageSizeBytes),
+				func(objectType bb_copy.DigestSourceConfiguration_ObjectType, blobDigest digest.Digest) error {
+					return c.addObject(ctx, objectType, blobDigest)
+				},
+			); err != nil {
+				return util.StatusWrapf(err, "Failed to read digest source at index %d with path %#v", i, digestSource.Path)
 			}
-			nestedReplicator.EnqueueTree(treeDigest)
+		}
+
+		// Enqueue entries in the Action Cache whose instance
+		// names match any of the configured prefixes.
+		if actionCacheConfiguration := configuration.ActionCache; actionCacheConfiguration != nil {
+			for _, prefix := range actionCacheConfiguration.EnumerateInstanceNamePrefixes {
+				instanceNamePrefix, err := digest.NewInstanceName(prefix)
+				if err != nil {
+					return util.StatusWrapf(err, "Invalid instance name prefix %#v", prefix)
+				}
+				if actionCacheSource.DigestEnumerator == nil {
+					return status.Error(codes.InvalidArgument, "Action Cache source does not support enumerating its contents. Enumeration is only supported by the \"zip_reading\" backend and by the \"local\" backend with a digest index, which must not be wrapped by backends that combine multiple backends")
+				}
+				if err := actionCacheSource.DigestEnumerator.EnumerateDigests(ctx, instanceNamePrefix, func(actionDigest digest.Digest) error {
+					return c.addObject(ctx, bb_copy.DigestSourceConfiguration_ACTION_RESULT, actionDigest)
+				}); err != nil {
+					return util.StatusWrapf(err, "Failed to enumerate Action Cache entries with instance name prefix %#v", prefix)
+				}
+			}
+		}
+
+		if err := c.flushBlobs(ctx); err != nil {
+			return err
+		}
+		if err := c.enqueueActionResults(ctx, max(int(configuration.TraversalConcurrency), 1)); err != nil {
+			return err
 		}
 
 		// Perform replication of nested objects.
-		for i := int32(0); i < configuration.TraversalConcurrency; i++ {
-			siblingsGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
-				return nestedReplicator.Replicate(ctx)
-			})
+		if err := program.RunLocal(ctx, func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+			for i := int32(0); i < configuration.TraversalConcurrency; i++ {
+				siblingsGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+					return nestedReplicator.Replicate(ctx)
+				})
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		if err := c.closeCheckpoint(); err != nil {
+			return err
 		}
-		return nil
+		return c.printSummary()
 	})
 }
@@ -0,0 +1,535 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	blobstore_configuration "github.com/buildbarn/bb-storage/pkg/blobstore/configuration"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/blockdevice"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bb-storage/pkg/program"
+	local_pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/proto/configuration/bb_local_inspect"
+	pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/klauspost/compress/zstd"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// A utility for inspecting the contents of a LocalBlobAccess whose
+// blocks and key-location map are stored on block devices. This can be
+// used to diagnose storage nodes that misbehave after a crash, without
+// having to wipe them.
+//
+// This utility opens the block devices for reading only, and walks
+// over all slots of the key-location map. For every slot it reports
+// whether it is empty, contains a valid record, or contains a record
+// that is invalid. Records are invalid if they refer to blocks that
+// have already been released, or if their checksum does not match.
+// The latter is the case for records that were corrupted, or that were
+// not flushed to storage prior to shutdown.
+//
+// For the Content Addressable Storage, the contents of blobs can also
+// be validated by recomputing their digests. Blobs that are valid may
+// optionally be exported to a ZIP archive, which can be accessed using
+// ZIPReadingBlobAccess. This makes it possible to salvage the contents
+// of a storage node, prior to wiping it.
+
+// blockStatistics contains the number of valid records pointing into a
+// single block, and the total size of the data they reference.
+type blockStatistics struct {
+	records   int
+	sizeBytes int64
+}
+
+// inspector holds the state of LocalBlobAccess that was reloaded from
+// disk.
+type inspector struct {
+	blocksBlockDevice          blockdevice.BlockDevice
+	keyLocationMapBlockDevice  blockdevice.BlockDevice
+	persistentState            *local_pb.PersistentState
+	blockList                  *local.PersistentBlockList
+	locationRecordArray        local.LocationRecordArray
+	locationRecordArraySize    int
+	maximumGetAttempts         uint32
+	cuckooHashing              bool
+	contentAddressableStorage  bool
+	zstdDecoder                *zstd.Decoder
+	maximumCompressedSizeBytes int64
+
+	// Digest functions that are considered when recovering the
+	// digests of blobs. For every digest function, the first
+	// entry uses the empty instance name, while the remaining
+	// entries correspond to the configured instance names.
+	digestFunctions [][]digest.Function
+}
+
+func newInspector(configuration *bb_local_inspect.ApplicationConfiguration) (*inspector, error) {
+	localConfiguration := configuration.Local
+	if localConfiguration == nil {
+		return nil, status.Error(codes.InvalidArgument, "No LocalBlobAccess configuration provided")
+	}
+	persistent := localConfiguration.Persistent
+	if persistent == nil {
+		return nil, status.Error(codes.InvalidArgument, "Persistency is not enabled, meaning LocalBlobAccess does not retain any data after shutdown")
+	}
+	blocksBackend, ok := localConfiguration.BlocksBackend.(*pb.LocalBlobAccessConfiguration_BlocksOnBlockDevice_)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "Blocks are not stored on a block device")
+	}
+	keyLocationMapBackend, ok := localConfiguration.KeyLocationMapBackend.(*pb.LocalBlobAccessConfiguration_KeyLocationMapOnBlockDevice)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "The key-location map is not stored on a block device")
+	}
+	if (configuration.ExportZipPath != "" || configuration.ExportDigestListPath != "") && !configuration.ContentAddressableStorage {
+		return nil, status.Error(codes.InvalidArgument, "Blobs can only be exported for the Content Addressable Storage, as digests can only be recovered by hashing blob contents")
+	}
+
+	in := &inspector{
+		maximumGetAttempts:        localConfiguration.KeyLocationMapMaximumGetAttempts,
+		cuckooHashing:             localConfiguration.KeyLocationMapCuckooHashing != nil,
+		contentAddressableStorage: configuration.ContentAddressableStorage,
+	}
+
+	// Objects may be stored in compressed form. Compressed objects
+	// are never larger than the maximum blob size.
+	if zstdCompression := localConfiguration.ZstdCompression; zstdCompression != nil {
+		var err error
+		in.zstdDecoder, err = zstd.NewReader(
+			nil,
+			zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderMaxMemory(uint64(zstdCompression.MaximumBlobSizeBytes)))
+		if err != nil {
+			return nil, util.StatusWrapWithCode(err, codes.Internal, "Failed to create Zstandard decoder")
+		}
+		in.maximumCompressedSizeBytes = zstdCompression.MaximumBlobSizeBytes
+	}
+
+	// Create the digest functions that are used to recover the
+	// digests of blobs.
+	instanceNames := []digest.InstanceName{digest.EmptyInstanceName}
+	for _, instanceNameStr := range configuration.InstanceNames {
+		instanceName, err := digest.NewInstanceName(instanceNameStr)
+		if err != nil {
+			return nil, util.StatusWrapf(err, "Invalid instance name %#v", instanceNameStr)
+		}
+		instanceNames = append(instanceNames, instanceName)
+	}
+	digestFunctionValues := configuration.DigestFunctions
+	if len(digestFunctionValues) == 0 {
+		digestFunctionValues = []remoteexecution.DigestFunction_Value{remoteexecution.DigestFunction_SHA256}
+	}
+	for _, digestFunctionValue := range digestFunctionValues {
+		digestFunctions := make([]digest.Function, 0, len(instanceNames))
+		for _, instanceName := range instanceNames {
+			digestFunction, err := instanceName.GetDigestFunction(digestFunctionValue, 0)
+			if err != nil {
+				return nil, util.StatusWrapf(err, "Invalid digest function %s", digestFunctionValue)
+			}
+			digestFunctions = append(digestFunctions, digestFunction)
+		}
+		in.digestFunctions = append(in.digestFunctions, digestFunctions)
+	}
+
+	// Reload persistent state from disk.
+	persistentStateDirectory, err := filesystem.NewLocalDirectory(path.LocalFormat.NewParser(persistent.StateDirectoryPath))
+	if err != nil {
+		return nil, util.StatusWrapf(err, "Failed to open persistent state directory %#v", persistent.StateDirectoryPath)
+	}
+	defer persistentStateDirectory.Close()
+	in.persistentState, err = local.NewDirectoryBackedPersistentStateStore(persistentStateDirectory).ReadPersistentState()
+	if err != nil {
+		return nil, util.StatusWrapf(err, "Failed to reload persistent state from %#v", persistent.StateDirectoryPath)
+	}
+
+	// Open the blocks block device. The size of blocks is computed
+	// in the same way as LocalBlobAccess does.
+	blocksOnBlockDevice := blocksBackend.BlocksOnBlockDevice
+	var sectorSizeBytes int
+	var sectorCount int64
+	in.blocksBlockDevice, sectorSizeBytes, sectorCount, err = blockdevice.NewReadOnlyBlockDeviceFromConfiguration(blocksOnBlockDevice.Source)
+	if err != nil {
+		return nil, util.StatusWrap(err, "Failed to open blocks block device")
+	}
+	blockSectorCount, blockCount, err := blobstore_configuration.ComputeLocalBlockGeometry(localConfiguration, sectorSizeBytes, sectorCount, in.persistentState)
+	if err != nil {
+		return nil, err
+	}
+	blockAllocator := local.NewBlockDeviceBackedBlockAllocator(
+		in.blocksBlockDevice,
+		blobstore.CASReadBufferFactory,
+		sectorSizeBytes,
+		blockSectorCount,
+		int(blockCount),
+		"bb_local_inspect")
+	var initialBlockCount int
+	in.blockList, initialBlockCount = local.NewPersistentBlockList(
+		blockAllocator,
+		in.persistentState.OldestEpochId,
+		in.persistentState.Blocks)
+	if initialBlockCount != len(in.persistentState.Blocks) {
+		fmt.Printf("Warning: only %d out of %d blocks in the persistent state could be reattached, as block %d has an unknown location\n", initialBlockCount, len(in.persistentState.Blocks), initialBlockCount)
+		in.persistentState.Blocks = in.persistentState.Blocks[:initialBlockCount]
+	}
+
+	// Open the key-location map block device. Use the number of
+	// entries stored in the persistent state, falling back to the
+	// same computation as LocalBlobAccess.
+	var keyLocationMapSectorSizeBytes int
+	var keyLocationMapSectorCount int64
+	in.keyLocationMapBlockDevice, keyLocationMapSectorSizeBytes, keyLocationMapSectorCount, err = blockdevice.NewReadOnlyBlockDeviceFromConfiguration(keyLocationMapBackend.KeyLocationMapOnBlockDevice)
+	if err != nil {
+		return nil, util.StatusWrap(err, "Failed to open key-location map block device")
+	}
+	locationRecordArrayCapacity := int((int64(keyLocationMapSectorSizeBytes) * keyLocationMapSectorCount) / local.BlockDeviceBackedLocationRecordSize)
+	if recordsCount := int(in.persistentState.KeyLocationMapRecordsCount); recordsCount > 0 && recordsCount <= locationRecordArrayCapacity {
+		in.locationRecordArraySize = recordsCount
+	} else {
+		bucketsCount, bucketSize, err := blobstore_configuration.ComputeLocalKeyLocationMapSize(localConfiguration, locationRecordArrayCapacity)
+		if err != nil {
+			return nil, err
+		}
+		in.locationRecordArraySize = bucketsCount * bucketSize
+	}
+	in.locationRecordArray = local.NewBlockDeviceBackedLocationRecordArray(in.keyLocationMapBlockDevice, in.blockList)
+	return in, nil
+}
+
+// getBlobReader returns a reader for the data referenced by a location
+// record, as stored on the blocks block device.
+func (in *inspector) getBlobReader(location local.Location) *io.SectionReader {
+	return io.NewSectionReader(
+		in.blocksBlockDevice,
+		in.persistentState.Blocks[location.BlockIndex].BlockLocation.OffsetBytes+location.OffsetBytes,
+		location.SizeBytes)
+}
+
+// matchesKey returns whether the key of a location record corresponds
+// to a given digest. FlatBlobAccess and the canonical entries of
+// HierarchicalCASBlobAccess use keys that don't contain an instance
+// name. Lookup entries of HierarchicalCASBlobAccess use keys that do.
+func matchesKey(digestFunctions []digest.Function, blobDigest digest.Digest, key local.Key) bool {
+	if local.NewKeyFromString(blobDigest.GetKey(digest.KeyWithoutInstance)) == key {
+		return true
+	}
+	for _, digestFunction := range digestFunctions {
+		instanceDigest, err := digestFunction.NewDigest(blobDigest.GetHashString(), blobDigest.GetSizeBytes())
+		if err == nil && local.NewKeyFromString(instanceDigest.GetKey(digest.KeyWithInstance)) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// recoverDigest computes the digests of a blob using all configured
+// digest functions, returning the one that corresponds to the key of
+// the location record.
+func (in *inspector) recoverDigest(r io.Reader, sizeBytes int64, key local.Key) (digest.Digest, bool, error) {
+	generators := make([]*digest.Generator, 0, len(in.digestFunctions))
+	writers := make([]io.Writer, 0, len(in.digestFunctions))
+	for _, digestFunctions := range in.digestFunctions {
+		generator := digestFunctions[0].NewGenerator(sizeBytes)
+		generators = append(generators, generator)
+		writers = append(writers, generator)
+	}
+	if _, err := io.Copy(io.MultiWriter(writers...), r); err != nil {
+		return digest.BadDigest, false, err
+	}
+	for i, generator := range generators {
+		if blobDigest := generator.Sum(); matchesKey(in.digestFunctions[i], blobDigest, key) {
+			return blobDigest, true, nil
+		}
+	}
+	return digest.BadDigest, false, nil
+}
+
+// recoveredBlob contains the digest of a blob whose contents have been
+// validated.
+type recoveredBlob struct {
+	digest digest.Digest
+	// The contents of the blob if it is stored in compressed form,
+	// or nil if it is stored as is.
+	decompressedData []byte
+}
+
+// validateBlob validates the contents of a blob in the Content
+// Addressable Storage by recovering its digest.
+func (in *inspector) validateBlob(record local.LocationRecord) (recoveredBlob, bool, error) {
+	blobDigest, ok, err := in.recoverDigest(in.getBlobReader(record.Location), record.Location.SizeBytes, record.RecordKey.Key)
+	if err != nil || ok {
+		return recoveredBlob{digest: blobDigest}, ok, err
+	}
+
+	// The blob may have been stored in compressed form.
+	if in.zstdDecoder == nil || record.Location.SizeBytes > in.maximumCompressedSizeBytes {
+		return recoveredBlob{}, false, nil
+	}
+	compressedData := make([]byte, record.Location.SizeBytes)
+	if _, err := io.ReadFull(in.getBlobReader(record.Location), compressedData); err != nil {
+		return recoveredBlob{}, false, err
+	}
+	decompressedData, err := in.zstdDecoder.DecodeAll(compressedData, nil)
+	if err != nil {
+		return recoveredBlob{}, false, nil
+	}
+	blobDigest, ok, err = in.recoverDigest(bytes.NewReader(decompressedData), int64(len(decompressedData)), record.RecordKey.Key)
+	return recoveredBlob{
+		digest:           blobDigest,
+		decompressedData: decompressedData,
+	}, ok, err
+}
+
+// getRecordProblems checks whether a valid location record is
+// consistent with the rest of the persistent state.
+func (in *inspector) getRecordProblems(index int, record local.LocationRecord) []string {
+	var problems []string
+	if in.cuckooHashing {
+		// Records are stored in one of two buckets.
+		if record.RecordKey.Attempt >= 2 {
+			problems = append(problems, "bucket number exceeds the maximum of 1")
+		}
+	} else {
+		if expectedIndex := int(record.RecordKey.Hash(in.persistentState.KeyLocationMapHashInitialization) % uint64(in.locationRecordArraySize)); expectedIndex != index {
+			problems = append(problems, fmt.Sprintf("record belongs at index %d", expectedIndex))
+		}
+		if record.RecordKey.Attempt >= in.maximumGetAttempts {
+			problems = append(problems, fmt.Sprintf("probing attempt exceeds the maximum of %d", in.maximumGetAttempts))
+		}
+	}
+	location := record.Location
+	if writeOffsetBytes := in.persistentState.Blocks[location.BlockIndex].WriteOffsetBytes; location.OffsetBytes < 0 || location.SizeBytes < 0 || location.OffsetBytes > writeOffsetBytes-location.SizeBytes {
+		problems = append(problems, fmt.Sprintf("data extends beyond the write offset of the block (%d bytes)", writeOffsetBytes))
+	}
+	return problems
+}
+
+func printCounts(title string, counts map[uint32]int) {
+	keys := make([]uint32, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	fmt.Printf("%s:\n", title)
+	for _, key := range keys {
+		fmt.Printf("  %d: %d\n", key, counts[key])
+	}
+}
+
+func (in *inspector) run(ctx context.Context, listBlobs bool, exportZIPPath, exportDigestListPath string) error {
+	// Create the ZIP archive to which blobs are exported.
+	var zipFile *os.File
+	var zipWriter *blobstore.ZIPWritingBlobAccess
+	exportedDigests := map[digest.Digest]struct{}{}
+	if exportZIPPath != "" {
+		var err error
+		zipFile, err = os.OpenFile(exportZIPPath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0o666)
+		if err != nil {
+			return util.StatusWrapf(err, "Failed to create ZIP archive %#v", exportZIPPath)
+		}
+		defer zipFile.Close()
+		zipWriter = blobstore.NewZIPWritingBlobAccess(
+			/* capabilitiesProvider = */ nil,
+			blobstore.CASReadBufferFactory,
+			digest.KeyWithoutInstance,
+			zipFile)
+	}
+
+	// Create the text file to which digests are exported.
+	var digestListFile *os.File
+	var digestListWriter *bufio.Writer
+	listedDigests := map[digest.Digest]struct{}{}
+	if exportDigestListPath != "" {
+		var err error
+		digestListFile, err = os.OpenFile(exportDigestListPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o666)
+		if err != nil {
+			return util.StatusWrapf(err, "Failed to create digest list %#v", exportDigestListPath)
+		}
+		defer digestListFile.Close()
+		digestListWriter = bufio.NewWriter(digestListFile)
+	}
+
+	var emptySlots, validSlots, staleSlots, corruptSlots, inconsistentSlots, validatedBlobs, invalidBlobs int
+	recordsPerEpoch := map[uint32]int{}
+	recordsPerAttempt := map[uint32]int{}
+	blocks := make([]blockStatistics, len(in.persistentState.Blocks))
+	for index := 0; index < in.locationRecordArraySize; index++ {
+		var rawRecord [local.BlockDeviceBackedLocationRecordSize]byte
+		if _, err := in.keyLocationMapBlockDevice.ReadAt(rawRecord[:], int64(index)*local.BlockDeviceBackedLocationRecordSize); err != nil {
+			return util.StatusWrapf(err, "Failed to read location record at index %d", index)
+		}
+		if rawRecord == [local.BlockDeviceBackedLocationRecordSize]byte{} {
+			emptySlots++
+			continue
+		}
+
+		// Distinguish records that refer to blocks that have
+		// been released from ones that are corrupted.
+		blockReference := local.BlockReference{
+			EpochID:        binary.LittleEndian.Uint32(rawRecord[:]),
+			BlocksFromLast: binary.LittleEndian.Uint16(rawRecord[4:]),
+		}
+		record, err := in.locationRecordArray.Get(index)
+		if err == local.ErrLocationRecordInvalid {
+			if _, _, found := in.blockList.BlockReferenceToBlockIndex(blockReference); found {
+				corruptSlots++
+				fmt.Printf("Index %d: record has an invalid checksum\n", index)
+			} else {
+				staleSlots++
+			}
+			continue
+		} else if err != nil {
+			return util.StatusWrapf(err, "Failed to read location record at index %d", index)
+		}
+		validSlots++
+		recordsPerEpoch[blockReference.EpochID]++
+		recordsPerAttempt[record.RecordKey.Attempt]++
+
+		location := record.Location
+		problems := in.getRecordProblems(index, record)
+		if len(problems) > 0 {
+			inconsistentSlots++
+		} else {
+			blocks[location.BlockIndex].records++
+			blocks[location.BlockIndex].sizeBytes += location.SizeBytes
+		}
+
+		// Recover the digest of the blob by hashing its
+		// contents, and export it if requested.
+		description := ""
+		if len(problems) == 0 && in.contentAddressableStorage {
+			blob, ok, err := in.validateBlob(record)
+			if err != nil {
+				return util.StatusWrapf(err, "Failed to read blob referenced by location record at index %d", index)
+			}
+			if ok {
+				validatedBlobs++
+				description = blob.digest.String()
+				if _, ok := exportedDigests[blob.digest]; zipWriter != nil && !ok {
+					var b buffer.Buffer
+					if blob.decompressedData == nil {
+						b = buffer.NewCASBufferFromReader(blob.digest, io.NopCloser(in.getBlobReader(location)), buffer.UserProvided)
+					} else {
+						b = buffer.NewCASBufferFromByteSlice(blob.digest, blob.decompressedData, buffer.UserProvided)
+					}
+					if err := zipWriter.Put(ctx, blob.digest, b); err != nil {
+						return util.StatusWrapf(err, "Failed to export blob with digest %#v", blob.digest.String())
+					}
+					exportedDigests[blob.digest] = struct{}{}
+				}
+				if _, ok := listedDigests[blob.digest]; digestListWriter != nil && !ok {
+					if _, err := fmt.Fprintln(digestListWriter, blob.digest.GetByteStreamReadPath(remoteexecution.Compressor_IDENTITY)); err != nil {
+						return util.StatusWrapf(err, "Failed to write to digest list %#v", exportDigestListPath)
+					}
+					listedDigests[blob.digest] = struct{}{}
+				}
+			} else {
+				invalidBlobs++
+				problems = append(problems, "contents don't match the key for any of the configured digest functions and instance names")
+			}
+		}
+
+		if listBlobs || len(problems) > 0 {
+			line := fmt.Sprintf(
+				"Index %d: epoch %d, block %d, offset %d, size %d, attempt %d",
+				index,
+				blockReference.EpochID,
+				location.BlockIndex,
+				location.OffsetBytes,
+				location.SizeBytes,
+				record.RecordKey.Attempt)
+			if description != "" {
+				line += ", digest " + description
+			}
+			if len(problems) > 0 {
+				line += ": " + strings.Join(problems, ", ")
+			}
+			fmt.Println(line)
+		}
+	}
+
+	// Print a summary.
+	percentage := func(n int) float64 {
+		return float64(n) * 100 / float64(in.locationRecordArraySize)
+	}
+	fmt.Printf("Key-location map: %d slots, hash initialization %#x\n", in.locationRecordArraySize, in.persistentState.KeyLocationMapHashInitialization)
+	fmt.Printf("  Empty:                                    %d (%.2f%%)\n", emptySlots, percentage(emptySlots))
+	fmt.Printf("  Valid:                                    %d (%.2f%%)\n", validSlots, percentage(validSlots))
+	fmt.Printf("  Valid, but inconsistent:                  %d (%.2f%%)\n", inconsistentSlots, percentage(inconsistentSlots))
+	fmt.Printf("  Referring to blocks that were released:   %d (%.2f%%)\n", staleSlots, percentage(staleSlots))
+	fmt.Printf("  Invalid checksum:                         %d (%.2f%%)\n", corruptSlots, percentage(corruptSlots))
+	printCounts("Valid records per probing attempt", recordsPerAttempt)
+	printCounts("Valid records per epoch", recordsPerEpoch)
+
+	epochCount := 0
+	for _, blockState := range in.persistentState.Blocks {
+		epochCount += len(blockState.EpochHashSeeds)
+	}
+	fmt.Printf("Blocks: %d, retaining epochs %d to %d\n", len(in.persistentState.Blocks), in.persistentState.OldestEpochId, int64(in.persistentState.OldestEpochId)+int64(epochCount)-1)
+	for i, blockState := range in.persistentState.Blocks {
+		fmt.Printf(
+			"  Block %d: device offset %d, size %d, write offset %d, %d epochs, %d records referencing %d bytes\n",
+			i,
+			blockState.BlockLocation.OffsetBytes,
+			blockState.BlockLocation.SizeBytes,
+			blockState.WriteOffsetBytes,
+			len(blockState.EpochHashSeeds),
+			blocks[i].records,
+			blocks[i].sizeBytes)
+	}
+	if in.contentAddressableStorage {
+		fmt.Printf("Blob contents: %d valid, %d invalid\n", validatedBlobs, invalidBlobs)
+	}
+
+	if zipWriter != nil {
+		if err := zipWriter.Finalize(); err != nil {
+			return util.StatusWrapf(err, "Failed to finalize ZIP archive %#v", exportZIPPath)
+		}
+		if err := zipFile.Sync(); err != nil {
+			return util.StatusWrapf(err, "Failed to synchronize ZIP archive %#v", exportZIPPath)
+		}
+		fmt.Printf("Exported %d blobs to %#v\n", len(exportedDigests), exportZIPPath)
+	}
+	if digestListWriter != nil {
+		if err := digestListWriter.Flush(); err != nil {
+			return util.StatusWrapf(err, "Failed to write to digest list %#v", exportDigestListPath)
+		}
+		if err := digestListFile.Sync(); err != nil {
+			return util.StatusWrapf(err, "Failed to synchronize digest list %#v", exportDigestListPath)
+		}
+		fmt.Printf("Exported %d digests to %#v\n", len(listedDigests), exportDigestListPath)
+	}
+	return nil
+}
+
+func main() {
+	program.RunMain(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+		if len(os.Args) != 2 {
+			return status.Error(codes.InvalidArgument, "Usage: bb_local_inspect bb_local_inspect.jsonnet")
+		}
+		var configuration bb_local_inspect.ApplicationConfiguration
+		if err := util.UnmarshalConfigurationFromFile(os.Args[1], &configuration); err != nil {
+			return util.StatusWrapf(err, "Failed to read configuration from %s", os.Args[1])
+		}
+
+		in, err := newInspector(&configuration)
+		if err != nil {
+			return err
+		}
+		return in.run(ctx, configuration.ListBlobs, configuration.ExportZipPath, configuration.ExportDigestListPath)
+	})
+}
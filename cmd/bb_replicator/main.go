@@ -6,6 +6,7 @@ import (
 
 	blobstore_configuration "github.com/buildbarn/bb-storage/pkg/blobstore/configuration"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/replication"
+	"github.com/buildbarn/bb-storage/pkg/clock"
 	"github.com/buildbarn/bb-storage/pkg/global"
 	bb_grpc "github.com/buildbarn/bb-storage/pkg/grpc"
 	"github.com/buildbarn/bb-storage/pkg/program"
@@ -49,14 +50,45 @@ func main() {
 		if err != nil {
 			return util.StatusWrap(err, "Failed to create sink")
 		}
-		replicator, err := blobstore_configuration.NewBlobReplicatorFromConfiguration(
-			configuration.Replicator,
-			source.BlobAccess,
-			sink,
-			blobstore_configuration.NewCASBlobReplicatorCreator(grpcClientFactory),
-		)
-		if err != nil {
-			return util.StatusWrap(err, "Failed to create replicator")
+		blobReplicatorCreator := blobstore_configuration.NewCASBlobReplicatorCreator(grpcClientFactory)
+		var replicator replication.BlobReplicator
+		if len(configuration.ReplicationQueueAdminGrpcServers) > 0 {
+			// Expose the ReplicationQueue service, so that
+			// administrators can list and purge pending
+			// replications.
+			journalingConfiguration := configuration.Replicator.GetJournaling()
+			if journalingConfiguration == nil {
+				return status.Error(codes.InvalidArgument, "Exposing the ReplicationQueue service requires the replicator to be of type \"journaling\"")
+			}
+			journalingReplicator, err := blobstore_configuration.NewJournalingBlobReplicatorFromConfiguration(
+				dependenciesGroup,
+				journalingConfiguration,
+				source.BlobAccess,
+				sink,
+				blobReplicatorCreator)
+			if err != nil {
+				return util.StatusWrap(err, "Failed to create replicator")
+			}
+			if err := bb_grpc.NewServersFromConfigurationAndServe(
+				configuration.ReplicationQueueAdminGrpcServers,
+				func(s grpc.ServiceRegistrar) {
+					replicator_pb.RegisterReplicationQueueServer(s, replication.NewReplicationQueueServer(journalingReplicator))
+				},
+				siblingsGroup,
+			); err != nil {
+				return util.StatusWrap(err, "Replication queue admin gRPC server failure")
+			}
+			replicator = replication.NewMetricsBlobReplicator(journalingReplicator, clock.SystemClock, blobReplicatorCreator.GetStorageTypeName())
+		} else {
+			replicator, err = blobstore_configuration.NewBlobReplicatorFromConfiguration(
+				dependenciesGroup,
+				configuration.Replicator,
+				source.BlobAccess,
+				sink,
+				blobReplicatorCreator)
+			if err != nil {
+				return util.StatusWrap(err, "Failed to create replicator")
+			}
 		}
 
 		if err := bb_grpc.NewServersFromConfigurationAndServe(
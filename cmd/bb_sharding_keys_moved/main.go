@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	blobstore_configuration "github.com/buildbarn/bb-storage/pkg/blobstore/configuration"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/sharding"
+	"github.com/buildbarn/bb-storage/pkg/program"
+	pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// A utility for estimating the impact of changes to the configuration
+// of ShardingBlobAccess. It takes two configuration files, each
+// containing a ShardingBlobAccessConfiguration message, and reports
+// the fraction of keys that would be stored on another shard after
+// switching from the old to the new configuration.
+//
+// In addition to that, it reports the fraction of keys that needs to
+// be moved at a minimum to obtain the new distribution of weights. An
+// estimate that is considerably higher than this lower bound indicates
+// that the change causes unnecessary repartitioning of data. This is,
+// for example, the case when adding shards to a configuration that
+// does not use rendezvous hashing or consistent hashing.
+//
+// The sharding configuration can be extracted from an existing storage
+// configuration using Jsonnet, as follows:
+//
+//     (import 'storage.jsonnet').contentAddressableStorage.backend.sharding
+
+const samplesCount = 1000000
+
+func getWeightFractions(configuration *pb.ShardingBlobAccessConfiguration, topology *sharding.Topology) map[string]float64 {
+	totalWeight := 0.0
+	for i, shard := range configuration.Shards {
+		if topology.ShardKeys[i] != "" {
+			totalWeight += float64(shard.Weight)
+		}
+	}
+	fractions := map[string]float64{}
+	for i, shard := range configuration.Shards {
+		if key := topology.ShardKeys[i]; key != "" {
+			fractions[key] = float64(shard.Weight) / totalWeight
+		}
+	}
+	return fractions
+}
+
+func main() {
+	program.RunMain(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+		if len(os.Args) != 3 {
+			return status.Error(codes.InvalidArgument, "Usage: bb_sharding_keys_moved old.jsonnet new.jsonnet")
+		}
+
+		var configurations [2]pb.ShardingBlobAccessConfiguration
+		var topologies [2]sharding.Topology
+		for i, path := range os.Args[1:] {
+			if err := util.UnmarshalConfigurationFromFile(path, &configurations[i]); err != nil {
+				return util.StatusWrapf(err, "Failed to read configuration from %s", path)
+			}
+			topology, err := blobstore_configuration.NewShardingTopologyFromConfiguration(&configurations[i])
+			if err != nil {
+				return util.StatusWrapf(err, "Invalid configuration in %s", path)
+			}
+			topologies[i] = topology
+		}
+
+		// Each shard needs to receive keys proportional to the
+		// growth of its share of the total weight.
+		oldFractions := getWeightFractions(&configurations[0], &topologies[0])
+		newFractions := getWeightFractions(&configurations[1], &topologies[1])
+		minimumFractionMoved := 0.0
+		for key, newFraction := range newFractions {
+			if newFraction > oldFractions[key] {
+				minimumFractionMoved += newFraction - oldFractions[key]
+			}
+		}
+
+		fmt.Printf(
+			"Estimated fraction of keys moved: %.2f%%\nMinimum fraction of keys moved: %.2f%%\n",
+			100*sharding.EstimateFractionOfKeysMoved(&topologies[0], &topologies[1], samplesCount),
+			100*minimumFractionMoved)
+		return nil
+	})
+}
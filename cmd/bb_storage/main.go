@@ -7,17 +7,23 @@ import (
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/auth"
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/chunking"
 	blobstore_configuration "github.com/buildbarn/bb-storage/pkg/blobstore/configuration"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/grpcservers"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/pinning"
 	"github.com/buildbarn/bb-storage/pkg/builder"
 	"github.com/buildbarn/bb-storage/pkg/capabilities"
 	"github.com/buildbarn/bb-storage/pkg/global"
 	bb_grpc "github.com/buildbarn/bb-storage/pkg/grpc"
 	"github.com/buildbarn/bb-storage/pkg/program"
+	"github.com/buildbarn/bb-storage/pkg/proto/cdc"
 	"github.com/buildbarn/bb-storage/pkg/proto/configuration/bb_storage"
+	"github.com/buildbarn/bb-storage/pkg/proto/digestenumeration"
 	"github.com/buildbarn/bb-storage/pkg/proto/fsac"
 	"github.com/buildbarn/bb-storage/pkg/proto/icas"
 	"github.com/buildbarn/bb-storage/pkg/proto/iscc"
+	pinning_pb "github.com/buildbarn/bb-storage/pkg/proto/pinning"
 	"github.com/buildbarn/bb-storage/pkg/util"
 
 	"google.golang.org/genproto/googleapis/bytestream"
@@ -60,14 +66,55 @@ func main() {
 			if err != nil {
 				return util.StatusWrap(err, "Failed to create Content Addressable Storage")
 			}
-			cacheCapabilitiesProviders = append(cacheCapabilitiesProviders, info.BlobAccess)
+			// The ByteStream service is capable of compressing
+			// and decompressing data on the fly, regardless of
+			// the storage backend that is used.
+			cacheCapabilitiesProviders = append(
+				cacheCapabilitiesProviders,
+				capabilities.NewSupportedCompressorsSettingProvider(info.BlobAccess, buffer.SupportedCompressors))
 			cacheCapabilitiesAuthorizers = append(cacheCapabilitiesAuthorizers, allAuthorizers...)
 			contentAddressableStorageInfo = &info
 			contentAddressableStorage = authorizedBackend
 		}
 
+		// Buildbarn extension: content-defined chunking of CAS
+		// objects.
+		var contentDefinedChunkingServer cdc.ContentDefinedChunkingServer
+		if chunkingConfiguration := configuration.ContentDefinedChunking; chunkingConfiguration != nil {
+			if contentAddressableStorage == nil {
+				return status.Error(codes.InvalidArgument, "Content-defined chunking requires a Content Addressable Storage to be configured")
+			}
+			chunker, err := chunking.NewFastCDCChunker(
+				int(chunkingConfiguration.MinimumChunkSizeBytes),
+				int(chunkingConfiguration.AverageChunkSizeBytes),
+				int(chunkingConfiguration.MaximumChunkSizeBytes))
+			if err != nil {
+				return util.StatusWrap(err, "Failed to create content-defined chunker")
+			}
+			contentDefinedChunkingServer = grpcservers.NewContentDefinedChunkingServer(contentAddressableStorage, chunker)
+		}
+
+		// Buildbarn extension: administrative service for pinning
+		// objects in the CAS, so that they are not evicted.
+		if len(configuration.PinningAdminGrpcServers) > 0 {
+			if contentAddressableStorageInfo == nil || len(contentAddressableStorageInfo.PinningBlobAccesses) != 1 {
+				return status.Error(codes.InvalidArgument, "Exposing the Pinning service requires the Content Addressable Storage to contain exactly one backend of type \"pinning\"")
+			}
+			pinningServer := pinning.NewPinningServer(contentAddressableStorageInfo.PinningBlobAccesses[0])
+			if err := bb_grpc.NewServersFromConfigurationAndServe(
+				configuration.PinningAdminGrpcServers,
+				func(s grpc.ServiceRegistrar) {
+					pinning_pb.RegisterPinningServer(s, pinningServer)
+				},
+				siblingsGroup,
+			); err != nil {
+				return util.StatusWrap(err, "Pinning admin gRPC server failure")
+			}
+		}
+
 		// Action Cache (AC).
 		var actionCache blobstore.BlobAccess
+		var actionCacheDigestEnumerator blobstore.DigestEnumerator
 		if configuration.ActionCache != nil {
 			info, authorizedBackend, allAuthorizers, putAuthorizer, err := newNonScannableBlobAccess(
 				dependenciesGroup,
@@ -84,6 +131,34 @@ func main() {
 				capabilities.NewActionCacheUpdateEnabledClearingProvider(info.BlobAccess, putAuthorizer))
 			cacheCapabilitiesAuthorizers = append(cacheCapabilitiesAuthorizers, allAuthorizers...)
 			actionCache = authorizedBackend
+			actionCacheDigestEnumerator = info.DigestEnumerator
+		}
+
+		// Buildbarn extension: administrative service for
+		// enumerating the contents of the CAS and AC, so that
+		// bb_anti_entropy can compare the backends of a mirrored
+		// pair.
+		if len(configuration.DigestEnumerationGrpcServers) > 0 {
+			var contentAddressableStorageSummarizer, actionCacheSummarizer blobstore.DigestRangeSummarizer
+			if contentAddressableStorageInfo != nil && contentAddressableStorageInfo.DigestEnumerator != nil {
+				contentAddressableStorageSummarizer = blobstore.NewEnumeratingDigestRangeSummarizer(contentAddressableStorageInfo.DigestEnumerator)
+			}
+			if actionCacheDigestEnumerator != nil {
+				actionCacheSummarizer = blobstore.NewEnumeratingDigestRangeSummarizer(actionCacheDigestEnumerator)
+			}
+			if contentAddressableStorageSummarizer == nil && actionCacheSummarizer == nil {
+				return status.Error(codes.InvalidArgument, "Exposing the DigestEnumeration service requires the Content Addressable Storage or the Action Cache to be capable of enumerating its contents")
+			}
+			digestEnumerationServer := grpcservers.NewDigestEnumerationServer(contentAddressableStorageSummarizer, actionCacheSummarizer)
+			if err := bb_grpc.NewServersFromConfigurationAndServe(
+				configuration.DigestEnumerationGrpcServers,
+				func(s grpc.ServiceRegistrar) {
+					digestenumeration.RegisterDigestEnumerationServer(s, digestEnumerationServer)
+				},
+				siblingsGroup,
+			); err != nil {
+				return util.StatusWrap(err, "Digest enumeration gRPC server failure")
+			}
 		}
 
 		// Buildbarn extension: Indirect Content Addressable Storage (ICAS).
@@ -171,6 +246,9 @@ func main() {
 							contentAddressableStorage,
 							1<<16))
 				}
+				if contentDefinedChunkingServer != nil {
+					cdc.RegisterContentDefinedChunkingServer(s, contentDefinedChunkingServer)
+				}
 				if actionCache != nil {
 					remoteexecution.RegisterActionCacheServer(
 						s,
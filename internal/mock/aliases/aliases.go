@@ -30,5 +30,8 @@ type ResponseWriter = http.ResponseWriter
 // RoundTripper is an alias of http.RoundTripper.
 type RoundTripper = http.RoundTripper
 
+// WriteCloser is an alias of io.WriteCloser.
+type WriteCloser = io.WriteCloser
+
 // Writer is an alias of io.Writer.
 type Writer = io.Writer
@@ -2,6 +2,7 @@ package blobstore
 
 import (
 	"context"
+	"iter"
 
 	"github.com/buildbarn/bb-storage/pkg/auth"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
@@ -70,3 +71,32 @@ func (ba *authorizingBlobAccess) FindMissing(ctx context.Context, digests digest
 	}
 	return ba.BlobAccess.FindMissing(ctx, digests)
 }
+
+func (ba *authorizingBlobAccess) FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+	// Authorize instance names as they are observed, so that the
+	// stream of digests does not need to be buffered.
+	authorizedInstanceNames := map[digest.InstanceName]struct{}{}
+	var authorizationErr error
+	err := ba.BlobAccess.FindMissingStream(
+		ctx,
+		func(yield func(digest.Digest) bool) {
+			for blobDigest := range digests {
+				instanceName := blobDigest.GetInstanceName()
+				if _, ok := authorizedInstanceNames[instanceName]; !ok {
+					if err := auth.AuthorizeSingleInstanceName(ctx, ba.findMissingAuthorizer, instanceName); err != nil {
+						authorizationErr = util.StatusWrapf(err, "Authorization of instance name %#v", instanceName.String())
+						return
+					}
+					authorizedInstanceNames[instanceName] = struct{}{}
+				}
+				if !yield(blobDigest) {
+					return
+				}
+			}
+		},
+		reportMissing)
+	if authorizationErr != nil {
+		return authorizationErr
+	}
+	return err
+}
@@ -2,6 +2,7 @@ package blobstore
 
 import (
 	"context"
+	"iter"
 
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
@@ -19,6 +20,21 @@ type BlobAccess interface {
 	GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer
 	Put(ctx context.Context, digest digest.Digest, b buffer.Buffer) error
 	FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error)
+
+	// FindMissingStream is a streaming counterpart of FindMissing().
+	// Instead of requiring that all digests are provided and
+	// returned at once, digests are obtained from an iterator and
+	// missing digests are reported through a callback as soon as
+	// they become available. This permits implementations to
+	// batch and pipeline requests in whichever way is most suitable
+	// for the backend, regardless of the number of digests.
+	//
+	// The iterator is consumed from a single goroutine. The
+	// reportMissing callback may be invoked from other goroutines,
+	// but never concurrently. If the iterator yields the same
+	// digest multiple times, it may be reported as missing more
+	// than once.
+	FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error
 }
 
 // RecommendedFindMissingDigestsCount corresponds to the maximum number
@@ -31,12 +47,12 @@ type BlobAccess interface {
 // implementations limit the maximum message size to a small number of
 // megabytes (4 MB for Java, 16 MB for Go).
 //
-// TODO: Would it make sense to replace BlobAccess.FindMissing() with a
-// streaming API that abstracts away the maximum digests count? That way
-// we can ensure proper batching of digests, even when sharding is used,
-// ExistenceCachingBlobAccess has a high hit rate, etc..
+// Callers that need to check the existence of an arbitrary number of
+// digests should use BlobAccess.FindMissingStream() instead, which
+// abstracts away this limit.
 //
-// It would be nice if such an API also supported decomposition of large
-// objects natively. See the "Future work" section in ADR#3 for details:
+// TODO: It would be nice if BlobAccess.FindMissingStream() also
+// supported decomposition of large objects natively. See the "Future
+// work" section in ADR#3 for details:
 // https://github.com/buildbarn/bb-adrs/blob/master/0003-cas-decomposition.md#future-work
 const RecommendedFindMissingDigestsCount = 10000
@@ -3,6 +3,8 @@ package buffer
 import (
 	"io"
 
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+
 	"google.golang.org/protobuf/proto"
 )
 
@@ -56,6 +58,12 @@ type Buffer interface {
 	// as a stream of byte slices. Normally used by the Content
 	// Addressable Storage.
 	ToChunkReader(off int64, maximumChunkSizeBytes int) ChunkReader
+	// Read the full contents of the buffer as a stream of byte
+	// slices, compressed using a given compression algorithm.
+	// Buffers that are backed by data that is already compressed
+	// using the same algorithm may return this data without
+	// recompressing it.
+	ToCompressedChunkReader(compressor remoteexecution.Compressor_Value, maximumChunkSizeBytes int) ChunkReader
 	// Obtain a reader that returns the entire contents of the
 	// buffer.
 	ToReader() io.ReadCloser
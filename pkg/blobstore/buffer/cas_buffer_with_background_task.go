@@ -3,6 +3,7 @@ package buffer
 import (
 	"io"
 
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 
 	"google.golang.org/protobuf/proto"
@@ -110,6 +111,10 @@ func (b *casBufferWithBackgroundTask) ToChunkReader(off int64, maximumChunkSizeB
 	return b.decorateChunkReader(b.base.ToChunkReader(off, maximumChunkSizeBytes))
 }
 
+func (b *casBufferWithBackgroundTask) ToCompressedChunkReader(compressor remoteexecution.Compressor_Value, maximumChunkSizeBytes int) ChunkReader {
+	return toCompressedChunkReaderViaChunkReader(b, compressor, maximumChunkSizeBytes)
+}
+
 func (b *casBufferWithBackgroundTask) ToReader() io.ReadCloser {
 	return b.decorateReader(b.base.ToReader())
 }
@@ -3,6 +3,7 @@ package buffer
 import (
 	"io"
 
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 
 	"google.golang.org/protobuf/proto"
@@ -56,6 +57,10 @@ func (b *casChunkReaderBuffer) ToChunkReader(off int64, maximumChunkSizeBytes in
 	return newNormalizingChunkReader(newOffsetChunkReader(b.toValidatedChunkReader(), off), maximumChunkSizeBytes)
 }
 
+func (b *casChunkReaderBuffer) ToCompressedChunkReader(compressor remoteexecution.Compressor_Value, maximumChunkSizeBytes int) ChunkReader {
+	return toCompressedChunkReaderViaChunkReader(b, compressor, maximumChunkSizeBytes)
+}
+
 func (b *casChunkReaderBuffer) ToReader() io.ReadCloser {
 	return newChunkReaderBackedReader(b.toValidatedChunkReader())
 }
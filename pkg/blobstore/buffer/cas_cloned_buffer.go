@@ -4,6 +4,7 @@ import (
 	"io"
 	"sync"
 
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 
 	"google.golang.org/protobuf/proto"
@@ -107,6 +108,10 @@ func (b *casClonedBuffer) ToChunkReader(off int64, maximumChunkSizeBytes int) Ch
 	return newOffsetChunkReader(b.toChunkReader(true, maximumChunkSizeBytes), off)
 }
 
+func (b *casClonedBuffer) ToCompressedChunkReader(compressor remoteexecution.Compressor_Value, maximumChunkSizeBytes int) ChunkReader {
+	return toCompressedChunkReaderViaChunkReader(b, compressor, maximumChunkSizeBytes)
+}
+
 func (b *casClonedBuffer) ToReader() io.ReadCloser {
 	return newChunkReaderBackedReader(b.toChunkReader(true, defaultChunkSizeBytes))
 }
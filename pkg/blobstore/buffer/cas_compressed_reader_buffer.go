@@ -0,0 +1,235 @@
+package buffer
+
+import (
+	"io"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type casCompressedReaderBuffer struct {
+	digest     digest.Digest
+	r          io.ReadCloser
+	compressor remoteexecution.Compressor_Value
+	source     Source
+}
+
+// NewCASBufferFromCompressedReader creates a buffer for an object
+// stored in the Content Addressable Storage, whose contents may be
+// obtained through a ReadCloser in compressed form.
+//
+// Data is decompressed and validated upon access. When the buffer is
+// converted to a ChunkReader that uses the same compression algorithm
+// as the one used to store the object, the compressed data is returned
+// as is, while still being decompressed and validated on the fly.
+func NewCASBufferFromCompressedReader(digest digest.Digest, r io.ReadCloser, compressor remoteexecution.Compressor_Value, source Source) Buffer {
+	return &casCompressedReaderBuffer{
+		digest:     digest,
+		r:          r,
+		compressor: compressor,
+		source:     source,
+	}
+}
+
+func (b *casCompressedReaderBuffer) GetSizeBytes() (int64, error) {
+	return b.digest.GetSizeBytes(), nil
+}
+
+func (b *casCompressedReaderBuffer) toDecompressedReader(compressedReader io.ReadCloser) io.ReadCloser {
+	r, err := newDecompressingReader(compressedReader, b.compressor, b.source.notifyCASDecompressionFailure)
+	if err != nil {
+		return newErrorReader(err)
+	}
+	return r
+}
+
+func (b *casCompressedReaderBuffer) toValidatedReader() io.ReadCloser {
+	return newCASValidatingReader(b.toDecompressedReader(b.r), b.digest, b.source)
+}
+
+func (b *casCompressedReaderBuffer) IntoWriter(w io.Writer) error {
+	r := b.toValidatedReader()
+	defer r.Close()
+
+	_, err := io.Copy(w, r)
+	return err
+}
+
+func (b *casCompressedReaderBuffer) ReadAt(p []byte, off int64) (int, error) {
+	r := b.toValidatedReader()
+	defer r.Close()
+
+	// Discard the part leading up to the correct offset.
+	if err := discardFromReader(r, off); err != nil {
+		return 0, err
+	}
+
+	// Read the part of data at the correct offset.
+	n, err := io.ReadFull(r, p)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return n, io.EOF
+	} else if err != nil {
+		return 0, err
+	}
+
+	// Continue reading the rest of the chunk to force checksum
+	// validation.
+	if _, err := io.Copy(io.Discard, r); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+func (b *casCompressedReaderBuffer) ToProto(m proto.Message, maximumSizeBytes int) (proto.Message, error) {
+	return toProtoViaByteSlice(b, m, maximumSizeBytes)
+}
+
+func (b *casCompressedReaderBuffer) ToByteSlice(maximumSizeBytes int) ([]byte, error) {
+	expectedSizeBytes := b.digest.GetSizeBytes()
+	if expectedSizeBytes > int64(maximumSizeBytes) {
+		b.r.Close()
+		return nil, status.Errorf(codes.InvalidArgument, "Buffer is %d bytes in size, while a maximum of %d bytes is permitted", expectedSizeBytes, maximumSizeBytes)
+	}
+
+	r := b.toValidatedReader()
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+func (b *casCompressedReaderBuffer) ToChunkReader(off int64, maximumChunkSizeBytes int) ChunkReader {
+	if err := validateReaderOffset(b.digest.GetSizeBytes(), off); err != nil {
+		b.r.Close()
+		return newErrorChunkReader(err)
+	}
+
+	r := b.toValidatedReader()
+	if err := discardFromReader(r, off); err != nil {
+		r.Close()
+		return newErrorChunkReader(err)
+	}
+	return newReaderBackedChunkReader(r, maximumChunkSizeBytes)
+}
+
+func (b *casCompressedReaderBuffer) ToCompressedChunkReader(compressor remoteexecution.Compressor_Value, maximumChunkSizeBytes int) ChunkReader {
+	if compressor != b.compressor {
+		return toCompressedChunkReaderViaChunkReader(b, compressor, maximumChunkSizeBytes)
+	}
+
+	// Return the compressed data as is. Still decompress the data
+	// and validate its checksum, so that corrupted objects are not
+	// returned in their entirety.
+	teeReader := &compressedDataRecordingReader{r: b.r}
+	return newNormalizingChunkReader(
+		&compressedPassthroughChunkReader{
+			validatedChunkReader: newCASValidatingChunkReader(
+				newReaderBackedChunkReader(b.toDecompressedReader(teeReader), maximumChunkSizeBytes),
+				b.digest,
+				b.source),
+			teeReader: teeReader,
+		},
+		maximumChunkSizeBytes)
+}
+
+func (b *casCompressedReaderBuffer) ToReader() io.ReadCloser {
+	return b.toValidatedReader()
+}
+
+func (b *casCompressedReaderBuffer) CloneCopy(maximumSizeBytes int) (Buffer, Buffer) {
+	return cloneCopyViaByteSlice(b, maximumSizeBytes)
+}
+
+func (b *casCompressedReaderBuffer) CloneStream() (Buffer, Buffer) {
+	return newCASClonedBuffer(b, b.digest, b.source).CloneStream()
+}
+
+func (b *casCompressedReaderBuffer) WithTask(task func() error) Buffer {
+	return newCASBufferWithBackgroundTask(b, b.digest, b.source, task)
+}
+
+func (b *casCompressedReaderBuffer) Discard() {
+	b.r.Close()
+}
+
+func (b *casCompressedReaderBuffer) applyErrorHandler(errorHandler ErrorHandler) (Buffer, bool) {
+	// For stream-backed buffers, it is not yet known whether they
+	// may be read successfully. Wrap the buffer into one that
+	// handles I/O errors upon access.
+	return newCASErrorHandlingBuffer(b, errorHandler, b.digest, b.source), false
+}
+
+func (b *casCompressedReaderBuffer) toUnvalidatedChunkReader(off int64, maximumChunkSizeBytes int) ChunkReader {
+	return newReaderBackedChunkReader(b.toUnvalidatedReader(off), maximumChunkSizeBytes)
+}
+
+func (b *casCompressedReaderBuffer) toUnvalidatedReader(off int64) io.ReadCloser {
+	r := b.toDecompressedReader(b.r)
+	if err := discardFromReader(r, off); err != nil {
+		r.Close()
+		return newErrorReader(err)
+	}
+	return r
+}
+
+// compressedDataRecordingReader is a decorator for ReadCloser that
+// retains a copy of all data that is read, so that it can be returned
+// to the caller after the decompressor has consumed it.
+type compressedDataRecordingReader struct {
+	r       io.ReadCloser
+	pending []byte
+}
+
+func (r *compressedDataRecordingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.pending = append(r.pending, p[:n]...)
+	return n, err
+}
+
+func (r *compressedDataRecordingReader) Close() error {
+	return r.r.Close()
+}
+
+// compressedPassthroughChunkReader is a ChunkReader that returns the
+// compressed data that was consumed by a decompressing and validating
+// ChunkReader. The final byte of compressed data is only returned
+// after the checksum of the decompressed data has been validated. This
+// prevents the full compressed stream from being returned in case of
+// data corruption.
+type compressedPassthroughChunkReader struct {
+	validatedChunkReader ChunkReader
+	teeReader            *compressedDataRecordingReader
+	finalized            bool
+}
+
+func (r *compressedPassthroughChunkReader) Read() ([]byte, error) {
+	for {
+		pending := r.teeReader.pending
+		if r.finalized {
+			if len(pending) == 0 {
+				return nil, io.EOF
+			}
+			r.teeReader.pending = nil
+			return pending, nil
+		}
+		if len(pending) > 1 {
+			// Hold on to the last byte until validation
+			// has completed.
+			r.teeReader.pending = []byte{pending[len(pending)-1]}
+			return pending[:len(pending)-1], nil
+		}
+
+		if _, err := r.validatedChunkReader.Read(); err == io.EOF {
+			r.finalized = true
+		} else if err != nil {
+			r.teeReader.pending = nil
+			return nil, err
+		}
+	}
+}
+
+func (r *compressedPassthroughChunkReader) Close() {
+	r.validatedChunkReader.Close()
+}
@@ -3,6 +3,7 @@ package buffer
 import (
 	"io"
 
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 
 	"google.golang.org/protobuf/proto"
@@ -103,6 +104,10 @@ func (b *casErrorHandlingBuffer) ToChunkReader(off int64, maximumChunkSizeBytes
 	return newOffsetChunkReader(b.toValidatedChunkReader(maximumChunkSizeBytes), off)
 }
 
+func (b *casErrorHandlingBuffer) ToCompressedChunkReader(compressor remoteexecution.Compressor_Value, maximumChunkSizeBytes int) ChunkReader {
+	return newCompressedErrorHandlingChunkReader(b.base, b.errorHandler, compressor, maximumChunkSizeBytes)
+}
+
 func (b *casErrorHandlingBuffer) ToReader() io.ReadCloser {
 	return newCASValidatingReader(b.toUnvalidatedReader(0), b.digest, b.source)
 }
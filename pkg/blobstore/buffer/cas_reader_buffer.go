@@ -3,6 +3,7 @@ package buffer
 import (
 	"io"
 
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 
 	"google.golang.org/grpc/codes"
@@ -97,6 +98,10 @@ func (b *casReaderBuffer) ToChunkReader(off int64, maximumChunkSizeBytes int) Ch
 	return newReaderBackedChunkReader(r, maximumChunkSizeBytes)
 }
 
+func (b *casReaderBuffer) ToCompressedChunkReader(compressor remoteexecution.Compressor_Value, maximumChunkSizeBytes int) ChunkReader {
+	return toCompressedChunkReaderViaChunkReader(b, compressor, maximumChunkSizeBytes)
+}
+
 func (b *casReaderBuffer) ToReader() io.ReadCloser {
 	return b.toValidatedReader()
 }
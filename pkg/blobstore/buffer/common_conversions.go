@@ -3,6 +3,7 @@ package buffer
 import (
 	"io"
 
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/util"
 
@@ -27,6 +28,14 @@ func intoWriterViaChunkReader(r ChunkReader, w io.Writer) error {
 	}
 }
 
+func toCompressedChunkReaderViaChunkReader(b Buffer, compressor remoteexecution.Compressor_Value, maximumChunkSizeBytes int) ChunkReader {
+	r, err := NewCompressingChunkReader(b.ToChunkReader(0, maximumChunkSizeBytes), compressor, maximumChunkSizeBytes)
+	if err != nil {
+		return newErrorChunkReader(err)
+	}
+	return r
+}
+
 func readAtViaChunkReader(r ChunkReader, p []byte, off int64) (int, error) {
 	r = newOffsetChunkReader(r, off)
 	defer r.Close()
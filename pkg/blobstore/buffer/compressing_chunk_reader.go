@@ -0,0 +1,104 @@
+package buffer
+
+import (
+	"bytes"
+	"compress/flate"
+	"io"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/klauspost/compress/zstd"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SupportedCompressors is the list of compression algorithms that are
+// supported by NewCompressingChunkReader() and
+// NewDecompressingChunkReader(). Compressor_IDENTITY is not part of
+// this list, as REv2 requires that it is always supported.
+var SupportedCompressors = []remoteexecution.Compressor_Value{
+	remoteexecution.Compressor_ZSTD,
+	remoteexecution.Compressor_DEFLATE,
+}
+
+type compressingChunkReader struct {
+	r                     ChunkReader
+	maximumChunkSizeBytes int
+	compressor            io.WriteCloser
+	compressorClosed      bool
+	compressedData        bytes.Buffer
+	err                   error
+}
+
+// NewCompressingChunkReader creates a decorator for ChunkReader that
+// compresses all of the data returned by the underlying ChunkReader,
+// using one of the compression algorithms that can be used as part of
+// REv2 ByteStream resource names. Chunks returned by Read() do not
+// exceed a provided maximum size.
+func NewCompressingChunkReader(r ChunkReader, compressor remoteexecution.Compressor_Value, maximumChunkSizeBytes int) (ChunkReader, error) {
+	cr := &compressingChunkReader{
+		r:                     r,
+		maximumChunkSizeBytes: maximumChunkSizeBytes,
+	}
+	switch compressor {
+	case remoteexecution.Compressor_ZSTD:
+		// Disable concurrency, as many ByteStream operations
+		// may run in parallel. This also causes compression to
+		// take place synchronously as part of Write().
+		encoder, err := zstd.NewWriter(&cr.compressedData, zstd.WithEncoderConcurrency(1), zstd.WithLowerEncoderMem(true))
+		if err != nil {
+			r.Close()
+			return nil, util.StatusWrapWithCode(err, codes.Internal, "Failed to create Zstandard encoder")
+		}
+		cr.compressor = encoder
+	case remoteexecution.Compressor_DEFLATE:
+		encoder, err := flate.NewWriter(&cr.compressedData, flate.DefaultCompression)
+		if err != nil {
+			r.Close()
+			return nil, util.StatusWrapWithCode(err, codes.Internal, "Failed to create DEFLATE encoder")
+		}
+		cr.compressor = encoder
+	default:
+		r.Close()
+		return nil, status.Errorf(codes.Unimplemented, "Unsupported compressor %s", compressor)
+	}
+	return cr, nil
+}
+
+func (r *compressingChunkReader) closeCompressor() error {
+	r.compressorClosed = true
+	return r.compressor.Close()
+}
+
+func (r *compressingChunkReader) Read() ([]byte, error) {
+	// Feed data into the compressor until it yields output.
+	for r.compressedData.Len() == 0 {
+		if r.err != nil {
+			return nil, r.err
+		}
+		if chunk, err := r.r.Read(); err == io.EOF {
+			// Flush any data buffered by the compressor.
+			if err := r.closeCompressor(); err != nil {
+				r.err = util.StatusWrapWithCode(err, codes.Internal, "Failed to compress data")
+			} else {
+				r.err = io.EOF
+			}
+		} else if err != nil {
+			r.err = err
+		} else if _, err := r.compressor.Write(chunk); err != nil {
+			r.err = util.StatusWrapWithCode(err, codes.Internal, "Failed to compress data")
+		}
+	}
+
+	chunk := make([]byte, min(r.compressedData.Len(), r.maximumChunkSizeBytes))
+	r.compressedData.Read(chunk)
+	return chunk, nil
+}
+
+func (r *compressingChunkReader) Close() {
+	if !r.compressorClosed {
+		r.closeCompressor()
+	}
+	r.r.Close()
+}
@@ -0,0 +1,141 @@
+package buffer_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+// readAllChunks reads all chunks from a ChunkReader, while checking
+// that none of them exceed the maximum chunk size.
+func readAllChunks(t *testing.T, r buffer.ChunkReader, maximumChunkSizeBytes int) ([]byte, error) {
+	var data []byte
+	for {
+		chunk, err := r.Read()
+		if err == io.EOF {
+			return data, nil
+		} else if err != nil {
+			return data, err
+		}
+		require.NotEmpty(t, chunk)
+		require.LessOrEqual(t, len(chunk), maximumChunkSizeBytes)
+		data = append(data, chunk...)
+	}
+}
+
+func TestCompressingChunkReaderRoundTrip(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// Highly compressible data, followed by data that doesn't
+	// compress at all.
+	original := bytes.Repeat([]byte("Hello world! "), 10000)
+	for i := 0; i < 10000; i++ {
+		original = append(original, byte(i*7919>>3))
+	}
+
+	for _, compressor := range buffer.SupportedCompressors {
+		t.Run(compressor.String(), func(t *testing.T) {
+			chunkReader := mock.NewMockChunkReader(ctrl)
+			for i := 0; i < len(original); i += 1000 {
+				chunkReader.EXPECT().Read().Return(original[i:min(i+1000, len(original))], nil)
+			}
+			chunkReader.EXPECT().Read().Return(nil, io.EOF)
+			chunkReader.EXPECT().Close()
+
+			compressingChunkReader, err := buffer.NewCompressingChunkReader(chunkReader, compressor, 100)
+			require.NoError(t, err)
+			compressed, err := readAllChunks(t, compressingChunkReader, 100)
+			require.NoError(t, err)
+			compressingChunkReader.Close()
+			require.Less(t, len(compressed), len(original))
+
+			compressedChunkReader := mock.NewMockChunkReader(ctrl)
+			for i := 0; i < len(compressed); i += 7 {
+				compressedChunkReader.EXPECT().Read().Return(compressed[i:min(i+7, len(compressed))], nil)
+			}
+			compressedChunkReader.EXPECT().Read().Return(nil, io.EOF).AnyTimes()
+			compressedChunkReader.EXPECT().Close()
+
+			decompressingChunkReader, err := buffer.NewDecompressingChunkReader(compressedChunkReader, compressor, 1000)
+			require.NoError(t, err)
+			decompressed, err := readAllChunks(t, decompressingChunkReader, 1000)
+			require.NoError(t, err)
+			decompressingChunkReader.Close()
+			require.Equal(t, original, decompressed)
+		})
+	}
+}
+
+func TestCompressingChunkReaderIOError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// Errors returned by the underlying ChunkReader should be
+	// propagated.
+	chunkReader := mock.NewMockChunkReader(ctrl)
+	chunkReader.EXPECT().Read().Return([]byte("Hello"), nil)
+	chunkReader.EXPECT().Read().Return(nil, status.Error(codes.Internal, "Storage backend on fire"))
+	chunkReader.EXPECT().Close()
+
+	r, err := buffer.NewCompressingChunkReader(chunkReader, remoteexecution.Compressor_ZSTD, 100)
+	require.NoError(t, err)
+	_, err = readAllChunks(t, r, 100)
+	testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Storage backend on fire"), err)
+	r.Close()
+}
+
+func TestCompressingChunkReaderUnsupportedCompressor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	chunkReader := mock.NewMockChunkReader(ctrl)
+	chunkReader.EXPECT().Close()
+
+	_, err := buffer.NewCompressingChunkReader(chunkReader, remoteexecution.Compressor_BROTLI, 100)
+	testutil.RequireEqualStatus(t, status.Error(codes.Unimplemented, "Unsupported compressor BROTLI"), err)
+}
+
+func TestDecompressingChunkReaderMalformedData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	for _, compressor := range buffer.SupportedCompressors {
+		t.Run(compressor.String(), func(t *testing.T) {
+			chunkReader := mock.NewMockChunkReader(ctrl)
+			chunkReader.EXPECT().Read().Return([]byte("This is not compressed data"), nil).AnyTimes()
+			chunkReader.EXPECT().Close()
+
+			r, err := buffer.NewDecompressingChunkReader(chunkReader, compressor, 100)
+			require.NoError(t, err)
+			_, err = readAllChunks(t, r, 100)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+			r.Close()
+		})
+	}
+}
+
+func TestDecompressingChunkReaderIOError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	for _, compressor := range buffer.SupportedCompressors {
+		t.Run(compressor.String(), func(t *testing.T) {
+			chunkReader := mock.NewMockChunkReader(ctrl)
+			chunkReader.EXPECT().Read().Return(nil, status.Error(codes.Internal, "Storage backend on fire"))
+			chunkReader.EXPECT().Close()
+
+			r, err := buffer.NewDecompressingChunkReader(chunkReader, compressor, 100)
+			require.NoError(t, err)
+			_, err = readAllChunks(t, r, 100)
+			testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Storage backend on fire"), err)
+			r.Close()
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package buffer
+
+import (
+	"compress/flate"
+	"io"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/klauspost/compress/zstd"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewDecompressingChunkReader creates a decorator for ChunkReader that
+// decompresses all of the data returned by the underlying ChunkReader.
+// It is the inverse of NewCompressingChunkReader(). Chunks returned by
+// Read() do not exceed a provided maximum size.
+//
+// Malformed compressed data causes Read() to fail with
+// INVALID_ARGUMENT. Errors returned by the underlying ChunkReader are
+// propagated as is.
+func NewDecompressingChunkReader(r ChunkReader, compressor remoteexecution.Compressor_Value, maximumChunkSizeBytes int) (ChunkReader, error) {
+	decompressedReader, err := newDecompressingReader(
+		newChunkReaderBackedReader(r),
+		compressor,
+		func(err error) error {
+			return util.StatusWrapWithCode(err, codes.InvalidArgument, "Failed to decompress data")
+		})
+	if err != nil {
+		return nil, err
+	}
+	return newReaderBackedChunkReader(decompressedReader, maximumChunkSizeBytes), nil
+}
+
+// newDecompressingReader creates a decorator for io.ReadCloser that
+// decompresses all of the data returned by the underlying reader.
+// Errors returned by the decompressor that are not gRPC style status
+// errors (i.e., ones not returned by the underlying reader) are passed
+// to a conversion function.
+func newDecompressingReader(compressedReader io.ReadCloser, compressor remoteexecution.Compressor_Value, convertDecompressionError func(error) error) (io.ReadCloser, error) {
+	var decompressedReader io.ReadCloser
+	switch compressor {
+	case remoteexecution.Compressor_ZSTD:
+		// Disable concurrency, as many ByteStream operations
+		// may run in parallel. This also causes decompression
+		// to take place synchronously as part of Read().
+		decoder, err := zstd.NewReader(compressedReader, zstd.WithDecoderConcurrency(1), zstd.WithDecoderLowmem(true))
+		if err != nil {
+			compressedReader.Close()
+			return nil, util.StatusWrapWithCode(err, codes.Internal, "Failed to create Zstandard decoder")
+		}
+		decompressedReader = &zstdDecoderReadCloser{
+			Decoder:          decoder,
+			compressedReader: compressedReader,
+		}
+	case remoteexecution.Compressor_DEFLATE:
+		decompressedReader = struct {
+			io.Reader
+			io.Closer
+		}{
+			Reader: flate.NewReader(compressedReader),
+			Closer: compressedReader,
+		}
+	default:
+		compressedReader.Close()
+		return nil, status.Errorf(codes.Unimplemented, "Unsupported compressor %s", compressor)
+	}
+	return &decompressionErrorConvertingReader{
+		ReadCloser:                decompressedReader,
+		convertDecompressionError: convertDecompressionError,
+	}, nil
+}
+
+// zstdDecoderReadCloser is a decorator for zstd.Decoder that ensures
+// both the decoder and the underlying stream are closed upon
+// completion.
+type zstdDecoderReadCloser struct {
+	*zstd.Decoder
+	compressedReader io.Closer
+}
+
+func (r *zstdDecoderReadCloser) Close() error {
+	r.Decoder.Close()
+	return r.compressedReader.Close()
+}
+
+// decompressionErrorConvertingReader is a decorator for ReadCloser
+// that converts errors returned by decompressors to gRPC style status.
+// Errors that already are gRPC style status are returned by the
+// underlying reader, and are left intact.
+type decompressionErrorConvertingReader struct {
+	io.ReadCloser
+	convertDecompressionError func(error) error
+	err                       error
+}
+
+func (r *decompressionErrorConvertingReader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	n, err := r.ReadCloser.Read(p)
+	if err != nil && err != io.EOF {
+		if _, ok := status.FromError(err); !ok {
+			// Only convert the error once, so that data
+			// integrity callbacks are not invoked
+			// repeatedly.
+			err = r.convertDecompressionError(err)
+			r.err = err
+		}
+	}
+	return n, err
+}
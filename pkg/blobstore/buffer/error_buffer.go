@@ -3,6 +3,8 @@ package buffer
 import (
 	"io"
 
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+
 	"google.golang.org/protobuf/proto"
 )
 
@@ -42,6 +44,10 @@ func (b errorBuffer) ToChunkReader(off int64, maximumChunkSizeBytes int) ChunkRe
 	return newErrorChunkReader(b.err)
 }
 
+func (b errorBuffer) ToCompressedChunkReader(compressor remoteexecution.Compressor_Value, maximumChunkSizeBytes int) ChunkReader {
+	return newErrorChunkReader(b.err)
+}
+
 func (b errorBuffer) ToReader() io.ReadCloser {
 	return newErrorReader(b.err)
 }
@@ -2,6 +2,8 @@ package buffer
 
 import (
 	"io"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 )
 
 type errorHandlingChunkReader struct {
@@ -45,3 +47,57 @@ func (r *errorHandlingChunkReader) Close() {
 	r.errorHandler.Done()
 	r.r.Close()
 }
+
+type compressedErrorHandlingChunkReader struct {
+	r                     ChunkReader
+	errorHandler          ErrorHandler
+	compressor            remoteexecution.Compressor_Value
+	maximumChunkSizeBytes int
+	dataReturned          bool
+}
+
+// newCompressedErrorHandlingChunkReader returns a ChunkReader that
+// forwards calls to a compressed reader obtained from a Buffer. Upon
+// I/O failure, it calls into an ErrorHandler to request a new Buffer.
+//
+// Unlike newErrorHandlingChunkReader(), compressed streams cannot be
+// resumed at an arbitrary offset. Switching to another Buffer is
+// therefore only possible if no data has been returned yet.
+func newCompressedErrorHandlingChunkReader(b Buffer, errorHandler ErrorHandler, compressor remoteexecution.Compressor_Value, maximumChunkSizeBytes int) ChunkReader {
+	return &compressedErrorHandlingChunkReader{
+		r:                     b.ToCompressedChunkReader(compressor, maximumChunkSizeBytes),
+		errorHandler:          errorHandler,
+		compressor:            compressor,
+		maximumChunkSizeBytes: maximumChunkSizeBytes,
+	}
+}
+
+func (r *compressedErrorHandlingChunkReader) Read() ([]byte, error) {
+	for {
+		chunk, originalErr := r.r.Read()
+		if originalErr == nil {
+			r.dataReturned = true
+			return chunk, nil
+		} else if originalErr == io.EOF {
+			return nil, io.EOF
+		}
+		if errorHandler, ok := r.errorHandler.(NonResumableErrorHandler); ok && r.dataReturned {
+			return nil, errorHandler.OnNonResumableError(originalErr)
+		}
+		b, translatedErr := r.errorHandler.OnError(originalErr)
+		if translatedErr != nil {
+			return nil, translatedErr
+		}
+		if r.dataReturned {
+			b.Discard()
+			return nil, originalErr
+		}
+		r.r.Close()
+		r.r = b.ToCompressedChunkReader(r.compressor, r.maximumChunkSizeBytes)
+	}
+}
+
+func (r *compressedErrorHandlingChunkReader) Close() {
+	r.errorHandler.Done()
+	r.r.Close()
+}
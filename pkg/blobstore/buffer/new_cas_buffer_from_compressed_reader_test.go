@@ -0,0 +1,137 @@
+package buffer_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewCASBufferFromCompressedReader(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	original := bytes.Repeat([]byte("Hello world, "), 1000)
+	originalDigest := digest.MustNewDigest("foo", remoteexecution.DigestFunction_SHA256, "d2c6e29a9856db7ea156f125021ffc985bbd91c38ebaa65f2e91bc73d0ff491f", int64(len(original)))
+	encoder, err := zstd.NewWriter(nil)
+	require.NoError(t, err)
+	compressed := encoder.EncodeAll(original, nil)
+	require.Less(t, len(compressed), len(original))
+
+	t.Run("ToByteSlice", func(t *testing.T) {
+		dataIntegrityCallback := mock.NewMockDataIntegrityCallback(ctrl)
+		dataIntegrityCallback.EXPECT().Call(true)
+
+		data, err := buffer.NewCASBufferFromCompressedReader(
+			originalDigest,
+			io.NopCloser(bytes.NewBuffer(compressed)),
+			remoteexecution.Compressor_ZSTD,
+			buffer.BackendProvided(dataIntegrityCallback.Call)).ToByteSlice(len(original))
+		require.NoError(t, err)
+		require.Equal(t, original, data)
+	})
+
+	t.Run("ToChunkReaderOffset", func(t *testing.T) {
+		dataIntegrityCallback := mock.NewMockDataIntegrityCallback(ctrl)
+		dataIntegrityCallback.EXPECT().Call(true)
+
+		r := buffer.NewCASBufferFromCompressedReader(
+			originalDigest,
+			io.NopCloser(bytes.NewBuffer(compressed)),
+			remoteexecution.Compressor_ZSTD,
+			buffer.BackendProvided(dataIntegrityCallback.Call)).ToChunkReader(7, 100)
+		data, err := readAllChunks(t, r, 100)
+		require.NoError(t, err)
+		require.Equal(t, original[7:], data)
+		r.Close()
+	})
+
+	t.Run("DecompressionFailure", func(t *testing.T) {
+		dataIntegrityCallback := mock.NewMockDataIntegrityCallback(ctrl)
+		dataIntegrityCallback.EXPECT().Call(false)
+
+		_, err := buffer.NewCASBufferFromCompressedReader(
+			originalDigest,
+			io.NopCloser(bytes.NewBufferString("This is not Zstandard compressed data")),
+			remoteexecution.Compressor_ZSTD,
+			buffer.BackendProvided(dataIntegrityCallback.Call)).ToByteSlice(len(original))
+		testutil.RequirePrefixedStatus(t, status.Error(codes.Internal, "Failed to decompress data: "), err)
+	})
+
+	t.Run("ToCompressedChunkReaderPassthrough", func(t *testing.T) {
+		// When requesting data using the same compression
+		// algorithm, the stored data should be returned as is.
+		dataIntegrityCallback := mock.NewMockDataIntegrityCallback(ctrl)
+		dataIntegrityCallback.EXPECT().Call(true)
+
+		r := buffer.NewCASBufferFromCompressedReader(
+			originalDigest,
+			io.NopCloser(bytes.NewBuffer(compressed)),
+			remoteexecution.Compressor_ZSTD,
+			buffer.BackendProvided(dataIntegrityCallback.Call)).ToCompressedChunkReader(remoteexecution.Compressor_ZSTD, 10)
+		data, err := readAllChunks(t, r, 10)
+		require.NoError(t, err)
+		require.Equal(t, compressed, data)
+		r.Close()
+	})
+
+	t.Run("ToCompressedChunkReaderPassthroughChecksumFailure", func(t *testing.T) {
+		// Even though data is passed through as is, it should
+		// still be validated. The final byte of the compressed
+		// stream may not be returned in case of corruption.
+		corruptedOriginal := bytes.Clone(original)
+		corruptedOriginal[500] ^= 1
+		corruptedCompressed := encoder.EncodeAll(corruptedOriginal, nil)
+
+		dataIntegrityCallback := mock.NewMockDataIntegrityCallback(ctrl)
+		dataIntegrityCallback.EXPECT().Call(false)
+
+		r := buffer.NewCASBufferFromCompressedReader(
+			originalDigest,
+			io.NopCloser(bytes.NewBuffer(corruptedCompressed)),
+			remoteexecution.Compressor_ZSTD,
+			buffer.BackendProvided(dataIntegrityCallback.Call)).ToCompressedChunkReader(remoteexecution.Compressor_ZSTD, 10)
+		data, err := readAllChunks(t, r, 10)
+		testutil.RequirePrefixedStatus(t, status.Error(codes.Internal, "Buffer has checksum "), err)
+		require.Less(t, len(data), len(corruptedCompressed))
+		r.Close()
+	})
+
+	t.Run("ToCompressedChunkReaderRecompress", func(t *testing.T) {
+		// Requesting data using a different compression
+		// algorithm requires the data to be recompressed.
+		dataIntegrityCallback := mock.NewMockDataIntegrityCallback(ctrl)
+		dataIntegrityCallback.EXPECT().Call(true)
+
+		r := buffer.NewCASBufferFromCompressedReader(
+			originalDigest,
+			io.NopCloser(bytes.NewBuffer(compressed)),
+			remoteexecution.Compressor_ZSTD,
+			buffer.BackendProvided(dataIntegrityCallback.Call)).ToCompressedChunkReader(remoteexecution.Compressor_DEFLATE, 100)
+		compressedChunkReader := mock.NewMockChunkReader(ctrl)
+		deflated, err := readAllChunks(t, r, 100)
+		require.NoError(t, err)
+		r.Close()
+
+		compressedChunkReader.EXPECT().Read().Return(deflated, nil)
+		compressedChunkReader.EXPECT().Read().Return(nil, io.EOF).AnyTimes()
+		compressedChunkReader.EXPECT().Close()
+		decompressingChunkReader, err := buffer.NewDecompressingChunkReader(compressedChunkReader, remoteexecution.Compressor_DEFLATE, 100)
+		require.NoError(t, err)
+		data, err := readAllChunks(t, decompressingChunkReader, 100)
+		require.NoError(t, err)
+		require.Equal(t, original, data)
+		decompressingChunkReader.Close()
+	})
+}
@@ -96,6 +96,14 @@ func (s Source) notifyCASHashMismatch(hashExpected, hashObserved []byte) error {
 		hex.EncodeToString(hashExpected))
 }
 
+// notifyCASDecompressionFailure triggers a repair due to a Content
+// Addressable Storage object being stored in a compressed form that
+// cannot be decompressed.
+func (s Source) notifyCASDecompressionFailure(decompressionErr error) error {
+	s.dataIntegrityCallback(false)
+	return util.StatusWrapWithCode(decompressionErr, s.errorCode, "Failed to decompress data")
+}
+
 // UserProvided indicates that the buffer did not come from storage.
 // Instead, it is an artifact that is currently being uploaded by a user
 // or automated process. When data consistency errors occur, no data
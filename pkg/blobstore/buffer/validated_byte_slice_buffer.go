@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io"
 
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 
 	"google.golang.org/grpc/codes"
@@ -85,6 +86,10 @@ func (b validatedByteSliceBuffer) ToChunkReader(off int64, maximumChunkSizeBytes
 	return b.toUnvalidatedChunkReader(off, maximumChunkSizeBytes)
 }
 
+func (b validatedByteSliceBuffer) ToCompressedChunkReader(compressor remoteexecution.Compressor_Value, maximumChunkSizeBytes int) ChunkReader {
+	return toCompressedChunkReaderViaChunkReader(b, compressor, maximumChunkSizeBytes)
+}
+
 func (b validatedByteSliceBuffer) ToReader() io.ReadCloser {
 	return b.toUnvalidatedReader(0)
 }
@@ -4,6 +4,8 @@ import (
 	"io"
 	"sync/atomic"
 
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
@@ -74,6 +76,10 @@ func (b *validatedReaderBuffer) ToChunkReader(off int64, maximumChunkSizeBytes i
 	return b.toUnvalidatedChunkReader(off, maximumChunkSizeBytes)
 }
 
+func (b *validatedReaderBuffer) ToCompressedChunkReader(compressor remoteexecution.Compressor_Value, maximumChunkSizeBytes int) ChunkReader {
+	return toCompressedChunkReaderViaChunkReader(b, compressor, maximumChunkSizeBytes)
+}
+
 func (b *validatedReaderBuffer) ToReader() io.ReadCloser {
 	return &validatedReaderAtReader{
 		SectionReader: *io.NewSectionReader(b.r, 0, b.sizeBytes),
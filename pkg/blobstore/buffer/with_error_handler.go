@@ -32,6 +32,16 @@ type ErrorHandler interface {
 	Done()
 }
 
+// NonResumableErrorHandler may optionally be implemented by
+// ErrorHandler. If implemented, OnNonResumableError() is called
+// instead of OnError() for errors that occur on streams that cannot be
+// resumed, such as compressed streams that have already returned data.
+// This allows ErrorHandlers that obtain replacement buffers to forgo
+// doing so, as the replacement buffer would be discarded.
+type NonResumableErrorHandler interface {
+	OnNonResumableError(err error) error
+}
+
 // WithErrorHandler attaches an ErrorHandler to a Buffer. If the
 // provided Buffer is already in a guaranteed success/failure state, the
 // ErrorHandler may be applied immediately.
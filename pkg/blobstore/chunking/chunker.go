@@ -0,0 +1,13 @@
+package chunking
+
+import (
+	"io"
+)
+
+// Chunker is used to decompose a stream of data into chunks.
+type Chunker interface {
+	// Split the data returned by a reader into chunks, invoking a
+	// callback for every chunk. The byte slice provided to the
+	// callback is only valid for the duration of the call.
+	Split(r io.Reader, chunkHandler func(chunk []byte) error) error
+}
@@ -0,0 +1,126 @@
+package chunking
+
+import (
+	"io"
+	"math/bits"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fastCDCGearTable contains the random values that are used by the
+// Gear rolling hash function. The values are generated using a fixed
+// seed, as changing them would cause chunk boundaries to change.
+var fastCDCGearTable = func() (table [256]uint64) {
+	// SplitMix64.
+	state := uint64(0x6275696c64626172)
+	for i := range table {
+		state += 0x9e3779b97f4a7c15
+		z := state
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		table[i] = z ^ (z >> 31)
+	}
+	return
+}()
+
+type fastCDCChunker struct {
+	minimumSizeBytes int
+	averageSizeBytes int
+	maximumSizeBytes int
+	maskSmall        uint64
+	maskLarge        uint64
+}
+
+// NewFastCDCChunker creates a Chunker that uses the FastCDC algorithm
+// to determine chunk boundaries. Boundaries depend on the contents of
+// the data, meaning that inserting or removing data only affects the
+// chunks surrounding the modification.
+//
+// Normalized chunking is applied, causing the size of chunks to be
+// distributed closely around the average size. The minimum and
+// maximum size are hard limits, except for the final chunk, which may
+// be smaller than the minimum size.
+func NewFastCDCChunker(minimumSizeBytes, averageSizeBytes, maximumSizeBytes int) (Chunker, error) {
+	if minimumSizeBytes <= 0 || minimumSizeBytes > averageSizeBytes || averageSizeBytes > maximumSizeBytes {
+		return nil, status.Errorf(codes.InvalidArgument, "Chunk sizes must satisfy 0 < minimum (%d) <= average (%d) <= maximum (%d)", minimumSizeBytes, averageSizeBytes, maximumSizeBytes)
+	}
+	averageBits := bits.Len(uint(averageSizeBytes)) - 1
+	if averageSizeBytes != 1<<averageBits || averageBits < 8 || averageBits > 48 {
+		return nil, status.Errorf(codes.InvalidArgument, "Average chunk size must be a power of two between 256 B and 256 TiB, not %d bytes", averageSizeBytes)
+	}
+
+	// The Gear hash function causes the high order bits of the
+	// fingerprint to depend on the most bytes, so use those.
+	// Normalization level 2 is applied, meaning the probability of
+	// placing a boundary is 4 times lower before the average size
+	// and 4 times higher afterwards.
+	return &fastCDCChunker{
+		minimumSizeBytes: minimumSizeBytes,
+		averageSizeBytes: averageSizeBytes,
+		maximumSizeBytes: maximumSizeBytes,
+		maskSmall:        ^uint64(0) << (64 - (averageBits + 2)),
+		maskLarge:        ^uint64(0) << (64 - (averageBits - 2)),
+	}, nil
+}
+
+// getChunkSizeBytes returns the size of the chunk at the start of a
+// piece of data. The data provided must either be at least as large as
+// the maximum chunk size, or contain the final part of the stream.
+func (c *fastCDCChunker) getChunkSizeBytes(data []byte) int {
+	n := len(data)
+	if n <= c.minimumSizeBytes {
+		return n
+	}
+	if n > c.maximumSizeBytes {
+		n = c.maximumSizeBytes
+	}
+	normalSizeBytes := c.averageSizeBytes
+	if normalSizeBytes > n {
+		normalSizeBytes = n
+	}
+
+	var fingerprint uint64
+	i := c.minimumSizeBytes
+	for ; i < normalSizeBytes; i++ {
+		fingerprint = (fingerprint << 1) + fastCDCGearTable[data[i]]
+		if fingerprint&c.maskSmall == 0 {
+			return i + 1
+		}
+	}
+	for ; i < n; i++ {
+		fingerprint = (fingerprint << 1) + fastCDCGearTable[data[i]]
+		if fingerprint&c.maskLarge == 0 {
+			return i + 1
+		}
+	}
+	return n
+}
+
+func (c *fastCDCChunker) Split(r io.Reader, chunkHandler func(chunk []byte) error) error {
+	buf := make([]byte, c.maximumSizeBytes)
+	bufferedBytes := 0
+	atEOF := false
+	for {
+		// Fill the buffer, so that the next chunk boundary can
+		// be determined.
+		if !atEOF {
+			n, err := io.ReadFull(r, buf[bufferedBytes:])
+			bufferedBytes += n
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				atEOF = true
+			} else if err != nil {
+				return err
+			}
+		}
+		if bufferedBytes == 0 {
+			return nil
+		}
+
+		chunkSizeBytes := c.getChunkSizeBytes(buf[:bufferedBytes])
+		if err := chunkHandler(buf[:chunkSizeBytes]); err != nil {
+			return err
+		}
+		bufferedBytes = copy(buf, buf[chunkSizeBytes:bufferedBytes])
+	}
+}
@@ -0,0 +1,89 @@
+package chunking_test
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/chunking"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func splitIntoChunks(t *testing.T, chunker chunking.Chunker, data []byte) [][]byte {
+	var chunks [][]byte
+	require.NoError(t, chunker.Split(bytes.NewReader(data), func(chunk []byte) error {
+		chunks = append(chunks, append([]byte(nil), chunk...))
+		return nil
+	}))
+	return chunks
+}
+
+func TestFastCDCChunker(t *testing.T) {
+	chunker, err := chunking.NewFastCDCChunker(1024, 4096, 16384)
+	require.NoError(t, err)
+
+	data := make([]byte, 1<<20)
+	rand.New(rand.NewSource(123)).Read(data)
+
+	t.Run("InvalidSizes", func(t *testing.T) {
+		_, err := chunking.NewFastCDCChunker(8192, 4096, 16384)
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Chunk sizes must satisfy 0 < minimum (8192) <= average (4096) <= maximum (16384)"), err)
+
+		_, err = chunking.NewFastCDCChunker(1024, 5000, 16384)
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Average chunk size must be a power of two between 256 B and 256 TiB, not 5000 bytes"), err)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		require.Empty(t, splitIntoChunks(t, chunker, nil))
+	})
+
+	t.Run("Small", func(t *testing.T) {
+		// Data that is smaller than the minimum chunk size
+		// should yield a single chunk.
+		require.Equal(t, [][]byte{[]byte("Hello")}, splitIntoChunks(t, chunker, []byte("Hello")))
+	})
+
+	t.Run("Boundaries", func(t *testing.T) {
+		// Concatenating all chunks should yield the original
+		// data. All chunks except the last one should respect
+		// the size limits.
+		chunks := splitIntoChunks(t, chunker, data)
+		require.Equal(t, data, bytes.Join(chunks, nil))
+		for _, chunk := range chunks[:len(chunks)-1] {
+			require.GreaterOrEqual(t, len(chunk), 1024)
+			require.LessOrEqual(t, len(chunk), 16384)
+		}
+
+		// On average, chunks should be close to the average
+		// chunk size.
+		require.Greater(t, len(chunks), len(data)/8192)
+		require.Less(t, len(chunks), len(data)/2048)
+
+		// Chunking must be deterministic.
+		require.Equal(t, chunks, splitIntoChunks(t, chunker, data))
+	})
+
+	t.Run("Insertion", func(t *testing.T) {
+		// Inserting data close to the start should only affect
+		// the chunks surrounding the insertion point.
+		originalChunks := splitIntoChunks(t, chunker, data)
+		modifiedData := append(append(append([]byte(nil), data[:5000]...), []byte("Hello world")...), data[5000:]...)
+		modifiedChunks := splitIntoChunks(t, chunker, modifiedData)
+
+		originalChunkSet := map[string]struct{}{}
+		for _, chunk := range originalChunks {
+			originalChunkSet[string(chunk)] = struct{}{}
+		}
+		changedChunks := 0
+		for _, chunk := range modifiedChunks {
+			if _, ok := originalChunkSet[string(chunk)]; !ok {
+				changedChunks++
+			}
+		}
+		require.LessOrEqual(t, changedChunks, 3)
+	})
+}
@@ -0,0 +1,53 @@
+package blobstore
+
+import (
+	"bytes"
+	"io"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+)
+
+type compressedCASReadBufferFactory struct {
+	base       ReadBufferFactory
+	compressor remoteexecution.Compressor_Value
+}
+
+// NewCompressedCASReadBufferFactory creates a decorator for
+// ReadBufferFactory that is capable of creating buffers for objects in
+// the Content Addressable Storage (CAS) that are stored in compressed
+// form. Objects whose stored size differs from the size in the digest
+// are assumed to be compressed. All other objects are assumed to be
+// stored as is, and are forwarded to the base ReadBufferFactory.
+//
+// This can be used by storage backends like LocalBlobAccess to
+// transparently store objects in compressed form, without requiring
+// any changes to the format in which they record the location of
+// objects.
+func NewCompressedCASReadBufferFactory(base ReadBufferFactory, compressor remoteexecution.Compressor_Value) ReadBufferFactory {
+	return &compressedCASReadBufferFactory{
+		base:       base,
+		compressor: compressor,
+	}
+}
+
+func (f *compressedCASReadBufferFactory) NewBufferFromByteSlice(blobDigest digest.Digest, data []byte, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer {
+	if int64(len(data)) == blobDigest.GetSizeBytes() {
+		return f.base.NewBufferFromByteSlice(blobDigest, data, dataIntegrityCallback)
+	}
+	return buffer.NewCASBufferFromCompressedReader(blobDigest, io.NopCloser(bytes.NewReader(data)), f.compressor, buffer.BackendProvided(dataIntegrityCallback))
+}
+
+func (f *compressedCASReadBufferFactory) NewBufferFromReader(blobDigest digest.Digest, r io.ReadCloser, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer {
+	// The size of the stored object is not known, meaning we can't
+	// determine whether the object is compressed.
+	return f.base.NewBufferFromReader(blobDigest, r, dataIntegrityCallback)
+}
+
+func (f *compressedCASReadBufferFactory) NewBufferFromReaderAt(blobDigest digest.Digest, r buffer.ReadAtCloser, sizeBytes int64, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer {
+	if sizeBytes == blobDigest.GetSizeBytes() {
+		return f.base.NewBufferFromReaderAt(blobDigest, r, sizeBytes, dataIntegrityCallback)
+	}
+	return buffer.NewCASBufferFromCompressedReader(blobDigest, newReaderFromReaderAt(r), f.compressor, buffer.BackendProvided(dataIntegrityCallback))
+}
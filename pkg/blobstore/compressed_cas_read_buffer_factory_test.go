@@ -0,0 +1,58 @@
+package blobstore_test
+
+import (
+	"bytes"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestCompressedCASReadBufferFactoryNewBufferFromReaderAt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	baseReadBufferFactory := mock.NewMockReadBufferFactory(ctrl)
+	readBufferFactory := blobstore.NewCompressedCASReadBufferFactory(baseReadBufferFactory, remoteexecution.Compressor_ZSTD)
+
+	t.Run("Uncompressed", func(t *testing.T) {
+		// Objects whose stored size is equal to the size in
+		// the digest should be forwarded to the base
+		// ReadBufferFactory.
+		helloDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+		reader := mock.NewMockReadAtCloser(ctrl)
+		dataIntegrityCallback := mock.NewMockDataIntegrityCallback(ctrl)
+		baseReadBufferFactory.EXPECT().NewBufferFromReaderAt(helloDigest, reader, int64(5), gomock.Any()).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := readBufferFactory.NewBufferFromReaderAt(helloDigest, reader, 5, dataIntegrityCallback.Call).ToByteSlice(10)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("Compressed", func(t *testing.T) {
+		// Objects whose stored size differs from the size in
+		// the digest should be decompressed.
+		original := bytes.Repeat([]byte("Hello world, "), 1000)
+		originalDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA256, "d2c6e29a9856db7ea156f125021ffc985bbd91c38ebaa65f2e91bc73d0ff491f", int64(len(original)))
+		encoder, err := zstd.NewWriter(nil)
+		require.NoError(t, err)
+		compressed := encoder.EncodeAll(original, nil)
+
+		reader := mock.NewMockReadAtCloser(ctrl)
+		reader.EXPECT().ReadAt(gomock.Any(), gomock.Any()).DoAndReturn(bytes.NewReader(compressed).ReadAt).AnyTimes()
+		reader.EXPECT().Close()
+		dataIntegrityCallback := mock.NewMockDataIntegrityCallback(ctrl)
+		dataIntegrityCallback.EXPECT().Call(true)
+
+		data, err := readBufferFactory.NewBufferFromReaderAt(originalDigest, reader, int64(len(compressed)), dataIntegrityCallback.Call).ToByteSlice(len(original))
+		require.NoError(t, err)
+		require.Equal(t, original, data)
+	})
+}
@@ -85,7 +85,8 @@ func (bac *acBlobAccessCreator) NewCustomBlobAccess(configuration *pb.BlobAccess
 				minimumTimestamp.AsTime(),
 				minimumValidity.AsDuration(),
 				maximumValidityJitter.AsDuration()),
-			DigestKeyFormat: base.DigestKeyFormat,
+			DigestKeyFormat:  base.DigestKeyFormat,
+			DigestEnumerator: base.DigestEnumerator,
 		}, "action_result_expiring", nil
 	case *pb.BlobAccessConfiguration_CompletenessChecking:
 		if bac.contentAddressableStorage == nil {
@@ -102,7 +103,8 @@ func (bac *acBlobAccessCreator) NewCustomBlobAccess(configuration *pb.BlobAccess
 				blobstore.RecommendedFindMissingDigestsCount,
 				bac.maximumMessageSizeBytes,
 				backend.CompletenessChecking.MaximumTotalTreeSizeBytes),
-			DigestKeyFormat: base.DigestKeyFormat.Combine(bac.contentAddressableStorage.DigestKeyFormat),
+			DigestKeyFormat:  base.DigestKeyFormat.Combine(bac.contentAddressableStorage.DigestKeyFormat),
+			DigestEnumerator: base.DigestEnumerator,
 		}, "completeness_checking", nil
 	case *pb.BlobAccessConfiguration_Grpc:
 		client, err := bac.grpcClientFactory.NewClientFromConfiguration(backend.Grpc)
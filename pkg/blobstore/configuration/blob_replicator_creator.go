@@ -3,6 +3,7 @@ package configuration
 import (
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/replication"
+	"github.com/buildbarn/bb-storage/pkg/program"
 	pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/blobstore"
 )
 
@@ -15,7 +16,7 @@ type BlobReplicatorCreator interface {
 	// BlobReplicator instances that only apply to this storage
 	// type. For example, sending replication requests over gRPC is
 	// only supported for the Content Addressable Storage.
-	NewCustomBlobReplicator(configuration *pb.BlobReplicatorConfiguration, source blobstore.BlobAccess, sink BlobAccessInfo) (replication.BlobReplicator, error)
+	NewCustomBlobReplicator(terminationGroup program.Group, configuration *pb.BlobReplicatorConfiguration, source blobstore.BlobAccess, sink BlobAccessInfo) (replication.BlobReplicator, error)
 
 	// GetStorageTypeName returns the name of the storage type that
 	// this BlobReplicatorCreator is able to create BlobReplicators for.
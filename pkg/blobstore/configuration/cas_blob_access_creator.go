@@ -3,11 +3,13 @@ package configuration
 import (
 	"context"
 	"net/http"
+	"slices"
 	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/grpcclients"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
 	"github.com/buildbarn/bb-storage/pkg/capabilities"
@@ -84,8 +86,9 @@ func (bac *casBlobAccessCreator) NewCustomBlobAccess(configuration *pb.BlobAcces
 			return BlobAccessInfo{}, "", err
 		}
 		return BlobAccessInfo{
-			BlobAccess:      blobstore.NewExistenceCachingBlobAccess(base.BlobAccess, existenceCache),
-			DigestKeyFormat: base.DigestKeyFormat,
+			BlobAccess:       blobstore.NewExistenceCachingBlobAccess(base.BlobAccess, existenceCache),
+			DigestKeyFormat:  base.DigestKeyFormat,
+			DigestEnumerator: base.DigestEnumerator,
 		}, "existence_caching", nil
 	case *pb.BlobAccessConfiguration_Grpc:
 		client, err := bac.grpcClientFactory.NewClientFromConfiguration(backend.Grpc)
@@ -95,9 +98,22 @@ func (bac *casBlobAccessCreator) NewCustomBlobAccess(configuration *pb.BlobAcces
 		// TODO: Should we provide a configuration option, so
 		// that digest.KeyWithoutInstance can be used?
 		return BlobAccessInfo{
-			BlobAccess:      grpcclients.NewCASBlobAccess(client, uuid.NewRandom, 65536),
+			BlobAccess:      grpcclients.NewCASBlobAccess(client, uuid.NewRandom, 65536, remoteexecution.Compressor_IDENTITY, 0),
 			DigestKeyFormat: digest.KeyWithInstance,
 		}, "grpc", nil
+	case *pb.BlobAccessConfiguration_CompressingGrpc:
+		compressor := backend.CompressingGrpc.Compressor
+		if !slices.Contains(buffer.SupportedCompressors, compressor) {
+			return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Unsupported compressor %s", compressor)
+		}
+		client, err := bac.grpcClientFactory.NewClientFromConfiguration(backend.CompressingGrpc.Client)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		return BlobAccessInfo{
+			BlobAccess:      grpcclients.NewCASBlobAccess(client, uuid.NewRandom, 65536, compressor, backend.CompressingGrpc.CompressionThresholdBytes),
+			DigestKeyFormat: digest.KeyWithInstance,
+		}, "compressing_grpc", nil
 	case *pb.BlobAccessConfiguration_ReferenceExpanding:
 		// The backend used by ReferenceExpandingBlobAccess is
 		// an Indirect Content Addressable Storage (ICAS). This
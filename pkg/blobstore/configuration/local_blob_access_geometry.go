@@ -0,0 +1,91 @@
+package configuration
+
+import (
+	local_pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/local"
+	pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/blobstore"
+	"github.com/fxtlabs/primes"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ComputeLocalBlockGeometry computes the size of blocks and the total
+// number of blocks that LocalBlobAccess stores on a block device, based
+// on its configuration and the dimensions of the block device.
+//
+// This function is used by NewBlobAccessFromConfiguration(), but is
+// also exposed, so that tools that inspect the contents of
+// LocalBlobAccess (e.g., bb_local_inspect) can locate data in exactly
+// the same way. The persistent state may be nil if persistency is
+// disabled.
+func ComputeLocalBlockGeometry(configuration *pb.LocalBlobAccessConfiguration, sectorSizeBytes int, sectorCount int64, persistentState *local_pb.PersistentState) (int64, int32, error) {
+	blocksOnBlockDevice := configuration.GetBlocksOnBlockDevice()
+	blockCount := blocksOnBlockDevice.GetSpareBlocks() + configuration.OldBlocks + configuration.CurrentBlocks + configuration.NewBlocks
+	blockSectorCount := sectorCount / int64(blockCount)
+	if blockSectorCount <= 0 {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "Block device only has %d sectors (%d bytes each), which is less than the total number of blocks (%d), meaning this backend would be incapable of storing any data", sectorCount, sectorSizeBytes, blockCount)
+	}
+	striped := blocksOnBlockDevice.GetSource().GetStriped()
+	var stripeSectorCount int64
+	if striped != nil {
+		// Reduce the block size, so that every stripe contains
+		// a whole number of blocks. This ensures that every
+		// block is stored on a single device.
+		stripeSectorCount = striped.StripeSizeBytes / int64(sectorSizeBytes)
+		if blockSectorCount > stripeSectorCount {
+			return 0, 0, status.Errorf(codes.InvalidArgument, "Blocks of %d bytes are larger than the stripe size of %d bytes, meaning they would be spread across multiple devices", blockSectorCount*int64(sectorSizeBytes), striped.StripeSizeBytes)
+		}
+		blocksPerStripe := (stripeSectorCount + blockSectorCount - 1) / blockSectorCount
+		for stripeSectorCount%blocksPerStripe != 0 {
+			blocksPerStripe++
+		}
+		blockSectorCount = stripeSectorCount / blocksPerStripe
+	}
+	if persistentState != nil && configuration.Persistent.GetRetainBlockSize() && len(persistentState.Blocks) > 0 {
+		// Use the size of the blocks that were persisted, so
+		// that they can be reattached. Use any remaining space
+		// for spare blocks.
+		retainedBlockSizeBytes := persistentState.Blocks[0].BlockLocation.GetSizeBytes()
+		if retainedBlockSizeBytes <= 0 || retainedBlockSizeBytes%int64(sectorSizeBytes) != 0 {
+			return 0, 0, status.Errorf(codes.InvalidArgument, "Persistent state contains blocks of %d bytes, which is not a multiple of the sector size of %d bytes", retainedBlockSizeBytes, sectorSizeBytes)
+		}
+		blockSectorCount = retainedBlockSizeBytes / int64(sectorSizeBytes)
+		if striped != nil && stripeSectorCount%blockSectorCount != 0 {
+			return 0, 0, status.Errorf(codes.InvalidArgument, "Persistent state contains blocks of %d bytes, which would be spread across multiple devices, as the stripe size of %d bytes is not a multiple of it", retainedBlockSizeBytes, striped.StripeSizeBytes)
+		}
+		retainedBlockCount := sectorCount / blockSectorCount
+		if retainedBlockCount < int64(blockCount) {
+			return 0, 0, status.Errorf(codes.InvalidArgument, "Block device only has space for %d blocks of %d bytes, which is less than the total number of blocks (%d)", retainedBlockCount, retainedBlockSizeBytes, blockCount)
+		}
+		blockCount = int32(retainedBlockCount)
+	}
+	return blockSectorCount, blockCount, nil
+}
+
+// ComputeLocalKeyLocationMapSize computes the number of buckets and the
+// number of records per bucket of the key-location map used by
+// LocalBlobAccess, given the number of records that the location
+// record array is capable of storing. When cuckoo hashing is disabled,
+// every bucket contains a single record.
+//
+// Considering that FNV-1a is used to compute keys and the key-location
+// maps use simple modulo arithmetic to store entries in the location
+// record array, the number of buckets is prime. This causes the best
+// dispersion of hash table entries.
+func ComputeLocalKeyLocationMapSize(configuration *pb.LocalBlobAccessConfiguration, locationRecordArrayCapacity int) (int, int, error) {
+	bucketSize := 1
+	if cuckooHashing := configuration.KeyLocationMapCuckooHashing; cuckooHashing != nil {
+		bucketSize = int(cuckooHashing.BucketSize)
+		if bucketSize < 2 {
+			return 0, 0, status.Error(codes.InvalidArgument, "The key-location map bucket size must be at least 2")
+		}
+	}
+	bucketsCount := locationRecordArrayCapacity / bucketSize
+	for bucketsCount > 3 && !primes.IsPrime(bucketsCount) {
+		bucketsCount--
+	}
+	if bucketsCount < 1 {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "The key-location map needs to have at least %d entries", bucketSize)
+	}
+	return bucketsCount, bucketSize, nil
+}
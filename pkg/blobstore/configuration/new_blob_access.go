@@ -3,32 +3,40 @@ package configuration
 import (
 	"archive/zip"
 	"context"
+	"log"
 	"os"
 	"sync"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/mirrored"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/pinning"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/readcaching"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/readfallback"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/sharding"
 	"github.com/buildbarn/bb-storage/pkg/blockdevice"
 	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/cloud/aws"
+	"github.com/buildbarn/bb-storage/pkg/cloud/gcp"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/eviction"
 	"github.com/buildbarn/bb-storage/pkg/filesystem"
 	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
 	"github.com/buildbarn/bb-storage/pkg/grpc"
 	"github.com/buildbarn/bb-storage/pkg/program"
+	local_pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/local"
 	pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/blobstore"
 	digest_pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/digest"
 	"github.com/buildbarn/bb-storage/pkg/random"
 	"github.com/buildbarn/bb-storage/pkg/util"
-	"github.com/fxtlabs/primes"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	"cloud.google.com/go/storage"
 )
 
 // BlobAccessInfo contains an instance of BlobAccess and information
@@ -37,6 +45,19 @@ import (
 type BlobAccessInfo struct {
 	BlobAccess      blobstore.BlobAccess
 	DigestKeyFormat digest.KeyFormat
+
+	// If the storage backend is capable of enumerating its
+	// contents, an implementation of DigestEnumerator. This field
+	// is nil otherwise. Decorators that forward all requests to a
+	// single backend propagate the DigestEnumerator of that
+	// backend.
+	DigestEnumerator blobstore.DigestEnumerator
+
+	// Instances of PinningBlobAccess that are contained in the
+	// storage backend, so that the caller may expose the Pinning
+	// service for them. This field is only set on values returned
+	// by NewBlobAccessFromConfiguration().
+	PinningBlobAccesses []*pinning.PinningBlobAccess
 }
 
 func newCachedReadBufferFactory(cacheConfiguration *digest_pb.ExistenceCacheConfiguration, baseReadBufferFactory blobstore.ReadBufferFactory, digestKeyFormat digest.KeyFormat) (blobstore.ReadBufferFactory, error) {
@@ -53,9 +74,28 @@ func newCachedReadBufferFactory(cacheConfiguration *digest_pb.ExistenceCacheConf
 		dataIntegrityCheckingCache), nil
 }
 
+// loadLocationRecordArraySnapshot reloads the contents of an in-memory
+// key-location map from a snapshot that was written by PeriodicSyncer.
+func loadLocationRecordArraySnapshot(persistentStateStore local.PersistentStateStore, resolver local.BlockReferenceResolver, keyLocationMap local.KeyLocationMap) error {
+	r, err := persistentStateStore.ReadLocationRecordArraySnapshot()
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			// Snapshotting was not enabled previously.
+			return nil
+		}
+		return err
+	}
+	defer r.Close()
+
+	reinsertedRecordsCount, err := local.LoadLocationRecordArraySnapshot(r, resolver, keyLocationMap)
+	log.Printf("Reinserted %d records into the key-location map from snapshot", reinsertedRecordsCount)
+	return err
+}
+
 type simpleNestedBlobAccessCreator struct {
-	terminationGroup program.Group
-	labels           map[string]BlobAccessInfo
+	terminationGroup    program.Group
+	labels              map[string]BlobAccessInfo
+	pinningBlobAccesses *[]*pinning.PinningBlobAccess
 }
 
 func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *pb.BlobAccessConfiguration, creator BlobAccessCreator) (BlobAccessInfo, string, error) {
@@ -76,7 +116,7 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 		if err != nil {
 			return BlobAccessInfo{}, "", err
 		}
-		replicator, err := NewBlobReplicatorFromConfiguration(backend.ReadCaching.Replicator, slow.BlobAccess, fast, creator)
+		replicator, err := NewBlobReplicatorFromConfiguration(nc.terminationGroup, backend.ReadCaching.Replicator, slow.BlobAccess, fast, creator)
 		if err != nil {
 			return BlobAccessInfo{}, "", err
 		}
@@ -85,43 +125,34 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 			DigestKeyFormat: slow.DigestKeyFormat,
 		}, "read_caching", nil
 	case *pb.BlobAccessConfiguration_Sharding:
-		backends := make([]blobstore.BlobAccess, 0, len(backend.Sharding.Shards))
-		weights := make([]uint32, 0, len(backend.Sharding.Shards))
-		var combinedDigestKeyFormat *digest.KeyFormat
-		for _, shard := range backend.Sharding.Shards {
-			if shard.Backend == nil {
-				// Drained backend.
-				backends = append(backends, nil)
-			} else {
-				// Undrained backend.
-				backend, err := nc.NewNestedBlobAccess(shard.Backend, creator)
-				if err != nil {
-					return BlobAccessInfo{}, "", err
-				}
-				backends = append(backends, backend.BlobAccess)
-				if combinedDigestKeyFormat == nil {
-					combinedDigestKeyFormat = &backend.DigestKeyFormat
-				} else {
-					newDigestKeyFormat := combinedDigestKeyFormat.Combine(backend.DigestKeyFormat)
-					combinedDigestKeyFormat = &newDigestKeyFormat
-				}
-			}
+		current, currentTopology, err := nc.newShardingBlobAccess(backend.Sharding, creator, "current")
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		resharding := backend.Sharding.Resharding
+		if resharding == nil {
+			return current, "sharding", nil
+		}
 
-			if shard.Weight == 0 {
-				return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Shards must have positive weights")
-			}
-			weights = append(weights, shard.Weight)
+		// Migrate data from a previous sharding configuration.
+		if resharding.Previous == nil {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "No previous sharding configuration provided")
+		}
+		if resharding.Previous.Resharding != nil {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "The previous sharding configuration cannot have resharding enabled")
 		}
-		if combinedDigestKeyFormat == nil {
-			return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Cannot create sharding blob access without any undrained backends")
+		previous, previousTopology, err := nc.newShardingBlobAccess(resharding.Previous, creator, "previous")
+		if err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrap(err, "Previous sharding configuration")
+		}
+		replicator, err := NewBlobReplicatorFromConfiguration(nc.terminationGroup, resharding.Replicator, previous.BlobAccess, current, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
 		}
 		return BlobAccessInfo{
-			BlobAccess: sharding.NewShardingBlobAccess(
-				backends,
-				sharding.NewWeightedShardPermuter(weights),
-				backend.Sharding.HashInitialization),
-			DigestKeyFormat: *combinedDigestKeyFormat,
-		}, "sharding", nil
+			BlobAccess:      sharding.NewReshardingBlobAccess(previous.BlobAccess, current.BlobAccess, &previousTopology, &currentTopology, replicator, storageTypeName),
+			DigestKeyFormat: current.DigestKeyFormat.Combine(previous.DigestKeyFormat),
+		}, "resharding", nil
 	case *pb.BlobAccessConfiguration_Mirrored:
 		backendA, err := nc.NewNestedBlobAccess(backend.Mirrored.BackendA, creator)
 		if err != nil {
@@ -131,11 +162,11 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 		if err != nil {
 			return BlobAccessInfo{}, "", err
 		}
-		replicatorAToB, err := NewBlobReplicatorFromConfiguration(backend.Mirrored.ReplicatorAToB, backendA.BlobAccess, backendB, creator)
+		replicatorAToB, err := NewBlobReplicatorFromConfiguration(nc.terminationGroup, backend.Mirrored.ReplicatorAToB, backendA.BlobAccess, backendB, creator)
 		if err != nil {
 			return BlobAccessInfo{}, "", err
 		}
-		replicatorBToA, err := NewBlobReplicatorFromConfiguration(backend.Mirrored.ReplicatorBToA, backendB.BlobAccess, backendA, creator)
+		replicatorBToA, err := NewBlobReplicatorFromConfiguration(nc.terminationGroup, backend.Mirrored.ReplicatorBToA, backendB.BlobAccess, backendA, creator)
 		if err != nil {
 			return BlobAccessInfo{}, "", err
 		}
@@ -150,7 +181,43 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 		}
 		persistent := backend.Local.Persistent
 
+		// Reload previous persistent state from disk, if enabled.
+		var persistentStateStore local.PersistentStateStore
+		var persistentState *local_pb.PersistentState
+		if persistent != nil {
+			persistentStateDirectory, err := filesystem.NewLocalDirectory(path.LocalFormat.NewParser(persistent.StateDirectoryPath))
+			if err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrapf(err, "Failed to open persistent state directory %#v", persistent.StateDirectoryPath)
+			}
+			if err := persistent.MinimumEpochInterval.CheckValid(); err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrap(err, "Failed to obtain minimum epoch duration")
+			}
+			persistentStateStore = local.NewDirectoryBackedPersistentStateStore(persistentStateDirectory)
+			persistentState, err = persistentStateStore.ReadPersistentState()
+			if err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrapf(err, "Failed to reload persistent state from %#v", persistent.StateDirectoryPath)
+			}
+		}
+
+		// Objects may optionally be stored in compressed form.
+		blobCompressor := local.IdentityBlobCompressor
+		zstdCompression := backend.Local.ZstdCompression
+		if zstdCompression != nil {
+			if storageTypeName != "cas" {
+				return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Compression is only supported for the Content Addressable Storage")
+			}
+			if backend.Local.HierarchicalInstanceNames {
+				return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Compression cannot be combined with hierarchical instance names")
+			}
+			var err error
+			blobCompressor, err = local.NewZstdBlobCompressor(zstdCompression.MaximumBlobSizeBytes)
+			if err != nil {
+				return BlobAccessInfo{}, "", err
+			}
+		}
+
 		// Create the backing store for blocks of data.
+		var globalLock sync.RWMutex
 		var backendType string
 		var sectorSizeBytes int
 		var blockSectorCount int64
@@ -158,6 +225,9 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 		dataSyncer := func() error { return nil }
 		switch blocksBackend := backend.Local.BlocksBackend.(type) {
 		case *pb.LocalBlobAccessConfiguration_BlocksInMemory_:
+			if zstdCompression != nil {
+				return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Compression is only supported when blocks are stored on a block device")
+			}
 			backendType = "local_in_memory"
 			// All data must be stored in memory. Because we
 			// are not dealing with physical storage, there
@@ -184,16 +254,19 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 				return BlobAccessInfo{}, "", util.StatusWrap(err, "Failed to open blocks block device")
 			}
 			dataSyncer = blockDevice.Sync
-			blockCount := blocksOnBlockDevice.SpareBlocks + backend.Local.OldBlocks + backend.Local.CurrentBlocks + backend.Local.NewBlocks
-			blockSectorCount = sectorCount / int64(blockCount)
-			if blockSectorCount <= 0 {
-				return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Block device only has %d sectors (%d bytes each), which is less than the total number of blocks (%d), meaning this backend would be incapable of storing any data", sectorCount, sectorSizeBytes, blockCount)
+			var blockCount int32
+			blockSectorCount, blockCount, err = ComputeLocalBlockGeometry(backend.Local, sectorSizeBytes, sectorCount, persistentState)
+			if err != nil {
+				return BlobAccessInfo{}, "", err
 			}
 
 			cachedReadBufferFactory, err := newCachedReadBufferFactory(blocksOnBlockDevice.DataIntegrityValidationCache, readBufferFactory, digestKeyFormat)
 			if err != nil {
 				return BlobAccessInfo{}, "", err
 			}
+			if zstdCompression != nil {
+				cachedReadBufferFactory = blobstore.NewCompressedCASReadBufferFactory(cachedReadBufferFactory, remoteexecution.Compressor_ZSTD)
+			}
 
 			blockAllocator = local.NewBlockDeviceBackedBlockAllocator(
 				blockDevice,
@@ -202,12 +275,28 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 				blockSectorCount,
 				int(blockCount),
 				storageTypeName)
+
+			// Optionally store the most recently allocated
+			// blocks in memory, only writing them to the
+			// block device once they become older.
+			if hotBlocksInMemory := blocksOnBlockDevice.HotBlocksInMemory; hotBlocksInMemory > 0 {
+				if persistent != nil {
+					return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Storing blocks in memory cannot be combined with persistency")
+				}
+				blockAllocator = local.NewTieredBlockAllocator(
+					blockAllocator,
+					cachedReadBufferFactory,
+					int64(sectorSizeBytes)*blockSectorCount,
+					int(hotBlocksInMemory),
+					&globalLock,
+					storageTypeName)
+			}
 		default:
 			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Blocks backend not specified")
 		}
 
-		var globalLock sync.RWMutex
 		var blockList local.BlockList
+		var persistentBlockList *local.PersistentBlockList
 		var keyLocationMapHashInitialization uint64
 		initialBlockCount := 0
 		if persistent == nil {
@@ -216,63 +305,18 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 			blockList = local.NewVolatileBlockList(blockAllocator)
 			keyLocationMapHashInitialization = random.CryptoThreadSafeGenerator.Uint64()
 		} else {
-			// Persistency is enabled. Reload previous
-			// persistent state from disk.
-			persistentStateDirectory, err := filesystem.NewLocalDirectory(path.LocalFormat.NewParser(persistent.StateDirectoryPath))
-			if err != nil {
-				return BlobAccessInfo{}, "", util.StatusWrapf(err, "Failed to open persistent state directory %#v", persistent.StateDirectoryPath)
-			}
-			persistentStateStore := local.NewDirectoryBackedPersistentStateStore(persistentStateDirectory)
-			persistentState, err := persistentStateStore.ReadPersistentState()
-			if err != nil {
-				return BlobAccessInfo{}, "", util.StatusWrapf(err, "Failed to reload persistent state from %#v", persistent.StateDirectoryPath)
-			}
+			// Persistency is enabled. Create a persistent
+			// BlockList. This will attempt to reattach the
+			// old blocks. The number of valid blocks is
+			// returned, so that the dimensions of the
+			// OldNewCurrentLocationBlobMap can be set
+			// properly.
 			keyLocationMapHashInitialization = persistentState.KeyLocationMapHashInitialization
-
-			// Create a persistent BlockList. This will
-			// attempt to reattach the old blocks. The
-			// number of valid blocks is returned, so that
-			// the dimensions of the OldNewCurrentLocationBlobMap
-			// can be set properly.
-			var persistentBlockList *local.PersistentBlockList
 			persistentBlockList, initialBlockCount = local.NewPersistentBlockList(
 				blockAllocator,
 				persistentState.OldestEpochId,
 				persistentState.Blocks)
 			blockList = persistentBlockList
-
-			// Start goroutines that update the persistent
-			// state file when writes and block releases
-			// occur.
-			if err := persistent.MinimumEpochInterval.CheckValid(); err != nil {
-				return BlobAccessInfo{}, "", util.StatusWrap(err, "Failed to obtain minimum epoch duration")
-			}
-			minimumEpochInterval := persistent.MinimumEpochInterval.AsDuration()
-			periodicSyncer := local.NewPeriodicSyncer(
-				persistentBlockList,
-				&globalLock,
-				persistentStateStore,
-				clock.SystemClock,
-				util.DefaultErrorLogger,
-				10*time.Second,
-				minimumEpochInterval,
-				keyLocationMapHashInitialization,
-				dataSyncer)
-			// TODO: Run this as part of the program.Group,
-			// so that it gets cleaned up upon shutdown.
-			go func() {
-				for {
-					periodicSyncer.ProcessBlockRelease()
-				}
-			}()
-			nc.terminationGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
-				for periodicSyncer.ProcessBlockPut(ctx) {
-				}
-				// TODO: Let PeriodicSyncer propagate errors
-				// upwards in case they occur after the context
-				// has been cancelled.
-				return nil
-			})
 		}
 
 		blockListGrowthPolicy, err := creator.NewBlockListGrowthPolicy(
@@ -294,21 +338,31 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 
 		// Create the backing store for the key-location map.
 		var locationRecordArraySize int
+		var locationRecordArrayCapacity int
 		var locationRecordArray local.LocationRecordArray
+		var snapshottableLocationRecordArray local.SnapshottableLocationRecordArray
 		switch keyLocationMapBackend := backend.Local.KeyLocationMapBackend.(type) {
 		case *pb.LocalBlobAccessConfiguration_KeyLocationMapInMemory_:
 			locationRecordArraySize = int(keyLocationMapBackend.KeyLocationMapInMemory.Entries)
-			locationRecordArray = local.NewInMemoryLocationRecordArray(
+			inMemoryLocationRecordArray := local.NewInMemoryLocationRecordArray(
 				locationRecordArraySize,
 				locationBlobMap)
+			locationRecordArray = inMemoryLocationRecordArray
+			if persistent != nil && persistent.SnapshotKeyLocationMap {
+				snapshottableLocationRecordArray = inMemoryLocationRecordArray
+			}
 		case *pb.LocalBlobAccessConfiguration_KeyLocationMapOnBlockDevice:
+			if persistent != nil && persistent.SnapshotKeyLocationMap {
+				return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Snapshotting the key-location map is only supported if it is stored in memory")
+			}
 			blockDevice, sectorSizeBytes, sectorCount, err := blockdevice.NewBlockDeviceFromConfiguration(
 				keyLocationMapBackend.KeyLocationMapOnBlockDevice,
 				persistent == nil)
 			if err != nil {
 				return BlobAccessInfo{}, "", util.StatusWrap(err, "Failed to open key-location map block device")
 			}
-			locationRecordArraySize = int((int64(sectorSizeBytes) * sectorCount) / local.BlockDeviceBackedLocationRecordSize)
+			locationRecordArrayCapacity = int((int64(sectorSizeBytes) * sectorCount) / local.BlockDeviceBackedLocationRecordSize)
+			locationRecordArraySize = locationRecordArrayCapacity
 			locationRecordArray = local.NewBlockDeviceBackedLocationRecordArray(
 				blockDevice,
 				locationBlobMap)
@@ -316,22 +370,180 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 			return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Key-location map backend not specified")
 		}
 
-		// Considering that FNV-1a is used to compute keys and
-		// HashingKeyLocationMap uses simple modulo arithmetic
-		// to store entries in the location record array, ensure
-		// that the size that is used is prime. This causes the
-		// best dispersion of hash table entries.
-		for locationRecordArraySize > 3 && !primes.IsPrime(locationRecordArraySize) {
-			locationRecordArraySize--
+		bucketsCount, bucketSize, err := ComputeLocalKeyLocationMapSize(backend.Local, locationRecordArraySize)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		locationRecordArraySize = bucketsCount * bucketSize
+		var keyLocationMap local.KeyLocationMap
+		if backend.Local.KeyLocationMapCuckooHashing != nil {
+			keyLocationMap = local.NewCuckooKeyLocationMap(
+				locationRecordArray,
+				bucketsCount,
+				bucketSize,
+				keyLocationMapHashInitialization,
+				int(backend.Local.KeyLocationMapMaximumPutAttempts),
+				storageTypeName)
+		} else {
+			keyLocationMap = local.NewHashingKeyLocationMap(
+				locationRecordArray,
+				locationRecordArraySize,
+				keyLocationMapHashInitialization,
+				backend.Local.KeyLocationMapMaximumGetAttempts,
+				int(backend.Local.KeyLocationMapMaximumPutAttempts),
+				storageTypeName)
 		}
 
-		keyLocationMap := local.NewHashingKeyLocationMap(
-			locationRecordArray,
-			locationRecordArraySize,
-			keyLocationMapHashInitialization,
-			backend.Local.KeyLocationMapMaximumGetAttempts,
-			int(backend.Local.KeyLocationMapMaximumPutAttempts),
-			storageTypeName)
+		// Optionally keep track of the keys of objects that are
+		// written, so that the digests of objects stored can be
+		// enumerated.
+		var digestIndex *local.DigestIndex
+		if digestIndexDirectoryPath := backend.Local.DigestIndexDirectoryPath; digestIndexDirectoryPath != "" {
+			if backend.Local.HierarchicalInstanceNames {
+				return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Digest indexes cannot be combined with hierarchical instance names")
+			}
+			digestIndexDirectory, err := filesystem.NewLocalDirectory(path.LocalFormat.NewParser(digestIndexDirectoryPath))
+			if err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrapf(err, "Failed to open digest index directory %#v", digestIndexDirectoryPath)
+			}
+			digestIndex, err = local.NewDigestIndex(
+				digestIndexDirectory,
+				keyLocationMap,
+				&globalLock,
+				digestKeyFormat,
+				util.DefaultErrorLogger,
+				// Don't compact the index in the
+				// background before it has become
+				// sufficiently large.
+				1<<20)
+			if err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrapf(err, "Failed to open digest index in %#v", digestIndexDirectoryPath)
+			}
+			nc.terminationGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+				for digestIndex.ProcessCompaction(ctx) {
+				}
+				return nil
+			})
+
+			// Ensure that objects referenced by the
+			// persistent state are present in the index.
+			blocksDataSyncer := dataSyncer
+			dataSyncer = func() error {
+				if err := blocksDataSyncer(); err != nil {
+					return err
+				}
+				return digestIndex.Sync()
+			}
+		}
+
+		if persistentBlockList != nil {
+			// If the size of the key-location map changed
+			// since the persistent state was written, records
+			// created by previous invocations are no longer
+			// stored in the right slots. Rehash them.
+			//
+			// If we crashed after rehashing, but before the
+			// persistent state was updated, records may be
+			// stored in slots beyond the previous size. Scan
+			// all slots that fit on the block device.
+			if previousRecordsCount := int(persistentState.KeyLocationMapRecordsCount); locationRecordArrayCapacity > 0 && previousRecordsCount > 0 && previousRecordsCount != locationRecordArraySize {
+				log.Printf("Rehashing key-location map, as its size changed from %d to %d records", previousRecordsCount, locationRecordArraySize)
+				reinsertedRecordsCount, err := local.RehashLocationRecordArray(locationRecordArray, locationRecordArrayCapacity, keyLocationMap, persistentStateStore, locationBlobMap)
+				if err != nil {
+					return BlobAccessInfo{}, "", util.StatusWrap(err, "Failed to rehash key-location map")
+				}
+				log.Printf("Reinserted %d records into the key-location map", reinsertedRecordsCount)
+			}
+
+			// If the key-location map is stored in memory, its
+			// contents can only be recovered by reloading the
+			// snapshot that was written prior to shutting down.
+			if snapshottableLocationRecordArray != nil {
+				if err := loadLocationRecordArraySnapshot(persistentStateStore, locationBlobMap, keyLocationMap); err != nil {
+					log.Print("Failed to reload key-location map snapshot: ", err)
+				}
+			}
+
+			// Start goroutines that update the persistent
+			// state file when writes and block releases
+			// occur.
+			periodicSyncer := local.NewPeriodicSyncer(
+				persistentBlockList,
+				&globalLock,
+				persistentStateStore,
+				clock.SystemClock,
+				util.DefaultErrorLogger,
+				10*time.Second,
+				persistent.MinimumEpochInterval.AsDuration(),
+				keyLocationMapHashInitialization,
+				locationRecordArraySize,
+				snapshottableLocationRecordArray,
+				dataSyncer)
+			// TODO: Run this as part of the program.Group,
+			// so that it gets cleaned up upon shutdown.
+			go func() {
+				for {
+					periodicSyncer.ProcessBlockRelease()
+				}
+			}()
+			nc.terminationGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+				for periodicSyncer.ProcessBlockPut(ctx) {
+				}
+				// TODO: Let PeriodicSyncer propagate errors
+				// upwards in case they occur after the context
+				// has been cancelled.
+				return nil
+			})
+		}
+
+		// Optionally validate the contents of stored objects in
+		// the background.
+		if scrubbing := backend.Local.Scrubbing; scrubbing != nil {
+			if storageTypeName != "cas" {
+				return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Scrubbing is only supported for the Content Addressable Storage")
+			}
+			if backend.Local.HierarchicalInstanceNames {
+				return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Scrubbing cannot be combined with hierarchical instance names")
+			}
+			if scrubbing.MaximumBytesPerSecond <= 0 {
+				return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Scrubbing requires a positive maximum number of bytes per second")
+			}
+			digestFunctionValues := scrubbing.DigestFunctions
+			if len(digestFunctionValues) == 0 {
+				digestFunctionValues = digest.SupportedDigestFunctions
+			}
+			digestFunctions := make([]digest.Function, 0, len(digestFunctionValues))
+			for _, digestFunctionValue := range digestFunctionValues {
+				digestFunction, err := digest.EmptyInstanceName.GetDigestFunction(digestFunctionValue, 0)
+				if err != nil {
+					return BlobAccessInfo{}, "", util.StatusWrapf(err, "Invalid scrubbing digest function %s", digestFunctionValue)
+				}
+				digestFunctions = append(digestFunctions, digestFunction)
+			}
+			var maximumCompressedSizeBytes int64
+			if zstdCompression != nil {
+				maximumCompressedSizeBytes = zstdCompression.MaximumBlobSizeBytes
+			}
+			scrubber, err := local.NewScrubber(
+				locationRecordArray,
+				locationRecordArraySize,
+				blockList,
+				&globalLock,
+				digestFunctions,
+				maximumCompressedSizeBytes,
+				clock.SystemClock,
+				util.DefaultErrorLogger,
+				scrubbing.MaximumBytesPerSecond,
+				storageTypeName)
+			if err != nil {
+				return BlobAccessInfo{}, "", err
+			}
+			nc.terminationGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+				for scrubber.ProcessRecord(ctx) {
+				}
+				return nil
+			})
+		}
 
 		var localBlobAccess blobstore.BlobAccess
 		if backend.Local.HierarchicalInstanceNames {
@@ -346,14 +558,63 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 			localBlobAccess = local.NewFlatBlobAccess(
 				keyLocationMap,
 				locationBlobMap,
+				blobCompressor,
 				digestKeyFormat,
 				&globalLock,
 				storageTypeName,
 				creator.GetDefaultCapabilitiesProvider())
 		}
+		var digestEnumerator blobstore.DigestEnumerator
+		if digestIndex != nil {
+			localBlobAccess = local.NewDigestIndexingBlobAccess(localBlobAccess, digestIndex)
+			digestEnumerator = digestIndex
+		}
+
+		// Optionally only store objects that are permitted by
+		// an admission policy.
+		if admissionPolicy := backend.Local.AdmissionPolicy; admissionPolicy != nil {
+			if storageTypeName != "cas" {
+				// Admission policies make decisions based on
+				// the size in the digest, which only
+				// corresponds to the size of the object for
+				// the Content Addressable Storage.
+				return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Admission policies are only supported for the Content Addressable Storage")
+			}
+			var admissionPolicies []local.AdmissionPolicy
+			maximumSizeBytes := admissionPolicy.MaximumBlobSizeBytes
+			if fraction := admissionPolicy.MaximumBlockSizeFraction; fraction != 0 {
+				if fraction < 0 || fraction > 1 {
+					return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Maximum block size fraction of the admission policy must be between 0 and 1")
+				}
+				blockFractionSizeBytes := int64(fraction * float64(int64(sectorSizeBytes)*blockSectorCount))
+				if maximumSizeBytes == 0 || blockFractionSizeBytes < maximumSizeBytes {
+					maximumSizeBytes = blockFractionSizeBytes
+				}
+			}
+			if maximumSizeBytes < 0 {
+				return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Maximum blob size of the admission policy cannot be negative")
+			}
+			if maximumSizeBytes > 0 {
+				admissionPolicies = append(admissionPolicies, local.NewMaximumSizeAdmissionPolicy(maximumSizeBytes, storageTypeName))
+			}
+			if frequencySketch := admissionPolicy.FrequencySketch; frequencySketch != nil {
+				if frequencySketch.CountersCount <= 0 {
+					return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Frequency sketch of the admission policy requires a positive number of counters")
+				}
+				admissionPolicies = append(
+					admissionPolicies,
+					local.NewFrequencySketchAdmissionPolicy(
+						int(frequencySketch.CountersCount),
+						int(frequencySketch.MinimumFrequency),
+						frequencySketch.MinimumSizeBytes,
+						storageTypeName))
+			}
+			localBlobAccess = local.NewAdmissionControllingBlobAccess(localBlobAccess, admissionPolicies)
+		}
 		return BlobAccessInfo{
-			BlobAccess:      localBlobAccess,
-			DigestKeyFormat: digestKeyFormat,
+			BlobAccess:       localBlobAccess,
+			DigestKeyFormat:  digestKeyFormat,
+			DigestEnumerator: digestEnumerator,
 		}, backendType, nil
 	case *pb.BlobAccessConfiguration_ReadFallback:
 		primary, err := nc.NewNestedBlobAccess(backend.ReadFallback.Primary, creator)
@@ -364,7 +625,7 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 		if err != nil {
 			return BlobAccessInfo{}, "", err
 		}
-		replicator, err := NewBlobReplicatorFromConfiguration(backend.ReadFallback.Replicator, secondary.BlobAccess, primary, creator)
+		replicator, err := NewBlobReplicatorFromConfiguration(nc.terminationGroup, backend.ReadFallback.Replicator, secondary.BlobAccess, primary, creator)
 		if err != nil {
 			return BlobAccessInfo{}, "", err
 		}
@@ -460,13 +721,15 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 			return BlobAccessInfo{}, "", err
 		}
 
+		blobAccess := blobstore.NewZIPReadingBlobAccess(
+			creator.GetDefaultCapabilitiesProvider(),
+			cachedReadBufferFactory,
+			digestKeyFormat,
+			zipReader.File)
 		return BlobAccessInfo{
-			BlobAccess: blobstore.NewZIPReadingBlobAccess(
-				creator.GetDefaultCapabilitiesProvider(),
-				cachedReadBufferFactory,
-				digestKeyFormat,
-				zipReader.File),
-			DigestKeyFormat: digestKeyFormat,
+			BlobAccess:       blobAccess,
+			DigestKeyFormat:  digestKeyFormat,
+			DigestEnumerator: blobAccess.(blobstore.DigestEnumerator),
 		}, "zip_reading", nil
 	case *pb.BlobAccessConfiguration_ZipWriting:
 		config := backend.ZipWriting
@@ -502,10 +765,269 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 			BlobAccess:      blobAccess,
 			DigestKeyFormat: digestKeyFormat,
 		}, "zip_writing", nil
+	case *pb.BlobAccessConfiguration_S3:
+		config := backend.S3
+		if config.MultipartUploadPartSizeBytes < blobstore.S3MinimumMultipartUploadPartSizeBytes {
+			return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Multipart upload part size must be at least %d bytes", blobstore.S3MinimumMultipartUploadPartSizeBytes)
+		}
+		if config.MaximumConcurrentRequests <= 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Maximum number of concurrent requests must be positive")
+		}
+		awsConfig, err := aws.NewConfigFromConfiguration(config.AwsSession, "S3BlobAccess")
+		if err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrap(err, "Failed to create AWS config")
+		}
+		s3Client := s3.NewFromConfig(awsConfig, func(o *s3.Options) {
+			if endpointURL := config.EndpointUrl; endpointURL != "" {
+				o.BaseEndpoint = &endpointURL
+			}
+			o.UsePathStyle = config.UsePathStyle
+		})
+
+		digestKeyFormat := creator.GetBaseDigestKeyFormat()
+		cachedReadBufferFactory, err := newCachedReadBufferFactory(config.DataIntegrityValidationCache, readBufferFactory, digestKeyFormat)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		return BlobAccessInfo{
+			BlobAccess: blobstore.NewS3BlobAccess(
+				creator.GetDefaultCapabilitiesProvider(),
+				cachedReadBufferFactory,
+				digestKeyFormat,
+				s3Client,
+				config.Bucket,
+				config.KeyPrefix,
+				config.MultipartUploadPartSizeBytes,
+				int(config.MaximumConcurrentRequests)),
+			DigestKeyFormat: digestKeyFormat,
+		}, "s3", nil
+	case *pb.BlobAccessConfiguration_Gcs:
+		config := backend.Gcs
+		if config.MaximumConcurrentRequests <= 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Maximum number of concurrent requests must be positive")
+		}
+		clientOptions, err := gcp.NewClientOptionsFromConfiguration(config.ClientOptions, "GCSBlobAccess")
+		if err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrap(err, "Failed to create GCP client options")
+		}
+		client, err := storage.NewClient(context.Background(), clientOptions...)
+		if err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrap(err, "Failed to create GCS client")
+		}
+
+		digestKeyFormat := creator.GetBaseDigestKeyFormat()
+		cachedReadBufferFactory, err := newCachedReadBufferFactory(config.DataIntegrityValidationCache, readBufferFactory, digestKeyFormat)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		return BlobAccessInfo{
+			BlobAccess: blobstore.NewGCSBlobAccess(
+				creator.GetDefaultCapabilitiesProvider(),
+				cachedReadBufferFactory,
+				digestKeyFormat,
+				gcp.NewWrappedStorageClient(client).Bucket(config.Bucket),
+				config.KeyPrefix,
+				int(config.MaximumConcurrentRequests)),
+			DigestKeyFormat: digestKeyFormat,
+		}, "gcs", nil
+	case *pb.BlobAccessConfiguration_Retrying:
+		config := backend.Retrying
+		base, err := nc.NewNestedBlobAccess(config.Backend, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		if config.MaximumAttempts == 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Maximum number of attempts must be positive")
+		}
+		if err := config.InitialBackoff.CheckValid(); err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid initial backoff")
+		}
+		if err := config.MaximumBackoff.CheckValid(); err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid maximum backoff")
+		}
+		return BlobAccessInfo{
+			BlobAccess: blobstore.NewRetryingBlobAccess(
+				base.BlobAccess,
+				clock.SystemClock,
+				int(config.MaximumAttempts),
+				config.InitialBackoff.AsDuration(),
+				config.MaximumBackoff.AsDuration(),
+				storageTypeName),
+			DigestKeyFormat:  base.DigestKeyFormat,
+			DigestEnumerator: base.DigestEnumerator,
+		}, "retrying", nil
+	case *pb.BlobAccessConfiguration_Hedging:
+		config := backend.Hedging
+		primary, err := nc.NewNestedBlobAccess(config.Primary, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		alternate, err := nc.NewNestedBlobAccess(config.Alternate, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		if config.LatencyPercentile <= 0 || config.LatencyPercentile >= 1 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Latency percentile must be in range (0, 1)")
+		}
+		if config.MaximumSizeBytes <= 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Maximum size must be positive")
+		}
+		return BlobAccessInfo{
+			BlobAccess: blobstore.NewHedgingBlobAccess(
+				primary.BlobAccess,
+				alternate.BlobAccess,
+				readBufferFactory,
+				clock.SystemClock,
+				config.LatencyPercentile,
+				config.MaximumSizeBytes,
+				storageTypeName),
+			DigestKeyFormat: primary.DigestKeyFormat.Combine(alternate.DigestKeyFormat),
+		}, "hedging", nil
+	case *pb.BlobAccessConfiguration_Pinning:
+		config := backend.Pinning
+		base, err := nc.NewNestedBlobAccess(config.Backend, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		instanceNamePrefixes := digest.NewInstanceNameTrie()
+		for i, instanceNamePrefixStr := range config.InstanceNamePrefixes {
+			instanceNamePrefix, err := digest.NewInstanceName(instanceNamePrefixStr)
+			if err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrapf(err, "Invalid instance name prefix %#v", instanceNamePrefixStr)
+			}
+			instanceNamePrefixes.Set(instanceNamePrefix, i)
+		}
+		if err := config.RefreshInterval.CheckValid(); err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid refresh interval")
+		}
+		refreshInterval := config.RefreshInterval.AsDuration()
+		if refreshInterval <= 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Refresh interval must be positive")
+		}
+
+		// Reload the set of pinned blobs from disk, if enabled.
+		var store pinning.PinnedBlobsStore
+		initialPinnedBlobs := digest.EmptySet
+		if config.StateDirectoryPath != "" {
+			stateDirectory, err := filesystem.NewLocalDirectory(path.LocalFormat.NewParser(config.StateDirectoryPath))
+			if err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrapf(err, "Failed to open state directory %#v", config.StateDirectoryPath)
+			}
+			store = pinning.NewDirectoryBackedPinnedBlobsStore(stateDirectory)
+			initialPinnedBlobs, err = store.ReadPinnedBlobs()
+			if err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrapf(err, "Failed to reload pinned blobs from %#v", config.StateDirectoryPath)
+			}
+		}
+
+		pinningBlobAccess := pinning.NewPinningBlobAccess(
+			base.BlobAccess,
+			base.DigestKeyFormat,
+			instanceNamePrefixes,
+			store,
+			initialPinnedBlobs,
+			config.MaximumPinnedSizeBytes,
+			clock.SystemClock,
+			storageTypeName)
+		nc.terminationGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+			pinningBlobAccess.RefreshPinnedBlobsPeriodically(ctx, clock.SystemClock, refreshInterval, util.DefaultErrorLogger)
+			return nil
+		})
+		*nc.pinningBlobAccesses = append(*nc.pinningBlobAccesses, pinningBlobAccess)
+		return BlobAccessInfo{
+			BlobAccess:       pinningBlobAccess,
+			DigestKeyFormat:  base.DigestKeyFormat,
+			DigestEnumerator: base.DigestEnumerator,
+		}, "pinning", nil
 	}
 	return creator.NewCustomBlobAccess(configuration, nc)
 }
 
+// newShardingBlobAccess creates a ShardingBlobAccess and the Topology
+// that describes how blobs are assigned to its shards. The name of the
+// topology is used to label metrics.
+func (nc *simpleNestedBlobAccessCreator) newShardingBlobAccess(configuration *pb.ShardingBlobAccessConfiguration, creator BlobAccessCreator, topologyName string) (BlobAccessInfo, sharding.Topology, error) {
+	topology, err := NewShardingTopologyFromConfiguration(configuration)
+	if err != nil {
+		return BlobAccessInfo{}, sharding.Topology{}, err
+	}
+	backends := make([]blobstore.BlobAccess, 0, len(configuration.Shards))
+	var combinedDigestKeyFormat *digest.KeyFormat
+	for _, shard := range configuration.Shards {
+		if shard.Backend == nil {
+			// Drained backend.
+			backends = append(backends, nil)
+		} else {
+			// Undrained backend.
+			backend, err := nc.NewNestedBlobAccess(shard.Backend, creator)
+			if err != nil {
+				return BlobAccessInfo{}, sharding.Topology{}, err
+			}
+			backends = append(backends, backend.BlobAccess)
+			if combinedDigestKeyFormat == nil {
+				combinedDigestKeyFormat = &backend.DigestKeyFormat
+			} else {
+				newDigestKeyFormat := combinedDigestKeyFormat.Combine(backend.DigestKeyFormat)
+				combinedDigestKeyFormat = &newDigestKeyFormat
+			}
+		}
+	}
+	if combinedDigestKeyFormat == nil {
+		return BlobAccessInfo{}, sharding.Topology{}, status.Errorf(codes.InvalidArgument, "Cannot create sharding blob access without any undrained backends")
+	}
+
+	var healthTrackers []sharding.ShardHealthTracker
+	var healthyShards *sharding.HealthyShardsCounter
+	if healthChecking := configuration.HealthChecking; healthChecking != nil {
+		if healthChecking.ConsecutiveFailuresThreshold == 0 {
+			return BlobAccessInfo{}, sharding.Topology{}, status.Error(codes.InvalidArgument, "Health checking requires a positive consecutive failures threshold")
+		}
+		if err := healthChecking.ProbeInterval.CheckValid(); err != nil {
+			return BlobAccessInfo{}, sharding.Topology{}, util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid probe interval")
+		}
+		if err := healthChecking.ProbeTimeout.CheckValid(); err != nil {
+			return BlobAccessInfo{}, sharding.Topology{}, util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid probe timeout")
+		}
+
+		// Create a health tracker for every undrained shard,
+		// and launch goroutines that probe unhealthy shards.
+		healthTrackers = make([]sharding.ShardHealthTracker, 0, len(backends))
+		healthyShards = &sharding.HealthyShardsCounter{}
+		for i, backend := range backends {
+			if backend == nil {
+				healthTrackers = append(healthTrackers, nil)
+				continue
+			}
+			healthTracker := sharding.NewConsecutiveFailuresShardHealthTracker(
+				clock.SystemClock,
+				creator.GetStorageTypeName(),
+				topologyName,
+				topology.ShardKeys[i],
+				sharding.NewFindMissingShardProbe(backend),
+				healthChecking.ConsecutiveFailuresThreshold,
+				healthChecking.ProbeInterval.AsDuration(),
+				healthChecking.ProbeTimeout.AsDuration(),
+				healthyShards)
+			nc.terminationGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+				for healthTracker.ProcessProbe(ctx) {
+				}
+				return nil
+			})
+			healthTrackers = append(healthTrackers, healthTracker)
+		}
+	}
+
+	return BlobAccessInfo{
+		BlobAccess: sharding.NewShardingBlobAccess(
+			backends,
+			healthTrackers,
+			healthyShards,
+			topology.ShardPermuter,
+			topology.HashInitialization),
+		DigestKeyFormat: *combinedDigestKeyFormat,
+	}, topology, nil
+}
+
 // NewNestedBlobAccess may be called by
 // BlobAccessCreator.NewCustomBlobAccess() to create BlobAccess
 // objects for instances nested inside the configuration.
@@ -541,8 +1063,9 @@ func (nc *simpleNestedBlobAccessCreator) NewNestedBlobAccess(configuration *pb.B
 		}
 
 		return (&simpleNestedBlobAccessCreator{
-			terminationGroup: nc.terminationGroup,
-			labels:           labels,
+			terminationGroup:    nc.terminationGroup,
+			labels:              labels,
+			pinningBlobAccesses: nc.pinningBlobAccesses,
 		}).NewNestedBlobAccess(config.Backend, creator)
 	case *pb.BlobAccessConfiguration_Label:
 		if labelBackend, ok := nc.labels[backend.Label]; ok {
@@ -556,24 +1079,29 @@ func (nc *simpleNestedBlobAccessCreator) NewNestedBlobAccess(configuration *pb.B
 		return BlobAccessInfo{}, err
 	}
 	return BlobAccessInfo{
-		BlobAccess:      blobstore.NewMetricsBlobAccess(backend.BlobAccess, clock.SystemClock, creator.GetStorageTypeName(), backendType),
-		DigestKeyFormat: backend.DigestKeyFormat,
+		BlobAccess:       blobstore.NewMetricsBlobAccess(backend.BlobAccess, clock.SystemClock, creator.GetStorageTypeName(), backendType),
+		DigestKeyFormat:  backend.DigestKeyFormat,
+		DigestEnumerator: backend.DigestEnumerator,
 	}, nil
 }
 
 // NewBlobAccessFromConfiguration creates a BlobAccess object based on a
 // configuration file.
 func NewBlobAccessFromConfiguration(terminationGroup program.Group, configuration *pb.BlobAccessConfiguration, creator BlobAccessCreator) (BlobAccessInfo, error) {
+	var pinningBlobAccesses []*pinning.PinningBlobAccess
 	nestedCreator := &simpleNestedBlobAccessCreator{
-		terminationGroup: terminationGroup,
+		terminationGroup:    terminationGroup,
+		pinningBlobAccesses: &pinningBlobAccesses,
 	}
 	backend, err := nestedCreator.NewNestedBlobAccess(configuration, creator)
 	if err != nil {
 		return BlobAccessInfo{}, err
 	}
 	return BlobAccessInfo{
-		BlobAccess:      creator.WrapTopLevelBlobAccess(backend.BlobAccess),
-		DigestKeyFormat: backend.DigestKeyFormat,
+		BlobAccess:          creator.WrapTopLevelBlobAccess(backend.BlobAccess),
+		DigestKeyFormat:     backend.DigestKeyFormat,
+		DigestEnumerator:    backend.DigestEnumerator,
+		PinningBlobAccesses: pinningBlobAccesses,
 	}, nil
 }
 
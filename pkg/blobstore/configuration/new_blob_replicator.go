@@ -1,20 +1,27 @@
 package configuration
 
 import (
+	"cmp"
+	"context"
+
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/replication"
 	"github.com/buildbarn/bb-storage/pkg/clock"
 	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bb-storage/pkg/program"
 	pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/blobstore"
+	replicator_pb "github.com/buildbarn/bb-storage/pkg/proto/replicator"
+	"github.com/buildbarn/bb-storage/pkg/util"
 
-	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 // NewBlobReplicatorFromConfiguration creates a BlobReplicator object
 // based on a configuration file.
-func NewBlobReplicatorFromConfiguration(configuration *pb.BlobReplicatorConfiguration, source blobstore.BlobAccess, sink BlobAccessInfo, creator BlobReplicatorCreator) (replication.BlobReplicator, error) {
+func NewBlobReplicatorFromConfiguration(terminationGroup program.Group, configuration *pb.BlobReplicatorConfiguration, source blobstore.BlobAccess, sink BlobAccessInfo, creator BlobReplicatorCreator) (replication.BlobReplicator, error) {
 	if configuration == nil {
 		return nil, status.Error(codes.InvalidArgument, "Replicator configuration not specified")
 	}
@@ -22,20 +29,33 @@ func NewBlobReplicatorFromConfiguration(configuration *pb.BlobReplicatorConfigur
 	var configuredBlobReplicator replication.BlobReplicator
 	switch mode := configuration.Mode.(type) {
 	case *pb.BlobReplicatorConfiguration_ConcurrencyLimiting:
-		base, err := NewBlobReplicatorFromConfiguration(mode.ConcurrencyLimiting.Base, source, sink, creator)
+		base, err := NewBlobReplicatorFromConfiguration(terminationGroup, mode.ConcurrencyLimiting.Base, source, sink, creator)
 		if err != nil {
 			return nil, err
 		}
+		weights := map[replicator_pb.Priority]uint32{
+			replicator_pb.Priority_INTERACTIVE: cmp.Or(mode.ConcurrencyLimiting.InteractiveWeight, 4),
+			replicator_pb.Priority_BACKGROUND:  cmp.Or(mode.ConcurrencyLimiting.BackgroundWeight, 2),
+			replicator_pb.Priority_BULK:        cmp.Or(mode.ConcurrencyLimiting.BulkWeight, 1),
+		}
 		configuredBlobReplicator = replication.NewConcurrencyLimitingBlobReplicator(
 			base,
 			sink.BlobAccess,
-			semaphore.NewWeighted(mode.ConcurrencyLimiting.MaximumConcurrency))
+			replication.NewPriorityScheduler(mode.ConcurrencyLimiting.MaximumConcurrency, weights),
+			clock.SystemClock,
+			storageTypeName)
+	case *pb.BlobReplicatorConfiguration_Journaling:
+		journalingBlobReplicator, err := NewJournalingBlobReplicatorFromConfiguration(terminationGroup, mode.Journaling, source, sink, creator)
+		if err != nil {
+			return nil, err
+		}
+		configuredBlobReplicator = journalingBlobReplicator
 	case *pb.BlobReplicatorConfiguration_Local:
 		configuredBlobReplicator = replication.NewLocalBlobReplicator(source, sink.BlobAccess)
 	case *pb.BlobReplicatorConfiguration_Noop:
 		configuredBlobReplicator = replication.NewNoopBlobReplicator(source)
 	case *pb.BlobReplicatorConfiguration_Queued:
-		base, err := NewBlobReplicatorFromConfiguration(mode.Queued.Base, source, sink, creator)
+		base, err := NewBlobReplicatorFromConfiguration(terminationGroup, mode.Queued.Base, source, sink, creator)
 		if err != nil {
 			return nil, err
 		}
@@ -46,10 +66,53 @@ func NewBlobReplicatorFromConfiguration(configuration *pb.BlobReplicatorConfigur
 		configuredBlobReplicator = replication.NewQueuedBlobReplicator(source, base, existenceCache)
 	default:
 		var err error
-		configuredBlobReplicator, err = creator.NewCustomBlobReplicator(configuration, source, sink)
+		configuredBlobReplicator, err = creator.NewCustomBlobReplicator(terminationGroup, configuration, source, sink)
 		if err != nil {
 			return nil, err
 		}
 	}
 	return replication.NewMetricsBlobReplicator(configuredBlobReplicator, clock.SystemClock, storageTypeName), nil
 }
+
+// NewJournalingBlobReplicatorFromConfiguration creates a
+// JournalingBlobReplicator based on a configuration file, and starts
+// processing its pending replications in the background.
+//
+// Unlike NewBlobReplicatorFromConfiguration(), this function returns
+// the JournalingBlobReplicator itself, so that the caller may expose
+// the ReplicationQueue service for it.
+func NewJournalingBlobReplicatorFromConfiguration(terminationGroup program.Group, configuration *pb.JournalingBlobReplicatorConfiguration, source blobstore.BlobAccess, sink BlobAccessInfo, creator BlobReplicatorCreator) (*replication.JournalingBlobReplicator, error) {
+	base, err := NewBlobReplicatorFromConfiguration(terminationGroup, configuration.Base, source, sink, creator)
+	if err != nil {
+		return nil, err
+	}
+	if configuration.MaximumBatchSize == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Maximum batch size must be positive")
+	}
+	if err := configuration.RetryInterval.CheckValid(); err != nil {
+		return nil, util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid retry interval")
+	}
+	journalDirectory, err := filesystem.NewLocalDirectory(path.LocalFormat.NewParser(configuration.JournalDirectoryPath))
+	if err != nil {
+		return nil, util.StatusWrapf(err, "Failed to open journal directory %#v", configuration.JournalDirectoryPath)
+	}
+	journalingBlobReplicator, err := replication.NewJournalingBlobReplicator(
+		source,
+		base,
+		sink.DigestKeyFormat,
+		journalDirectory,
+		clock.SystemClock,
+		util.DefaultErrorLogger,
+		int(configuration.MaximumBatchSize),
+		configuration.RetryInterval.AsDuration(),
+		creator.GetStorageTypeName())
+	if err != nil {
+		return nil, util.StatusWrapf(err, "Failed to create journaling replicator with journal directory %#v", configuration.JournalDirectoryPath)
+	}
+	terminationGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+		for journalingBlobReplicator.ProcessPendingReplications(ctx) {
+		}
+		return nil
+	})
+	return journalingBlobReplicator, nil
+}
@@ -0,0 +1,64 @@
+package configuration
+
+import (
+	"strconv"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/sharding"
+	pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/blobstore"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewShardingTopologyFromConfiguration creates a sharding.Topology
+// based on the shards and the algorithm specified in a sharding
+// configuration. The backends of the shards are not created.
+func NewShardingTopologyFromConfiguration(configuration *pb.ShardingBlobAccessConfiguration) (sharding.Topology, error) {
+	keys := make([]string, 0, len(configuration.Shards))
+	weights := make([]uint32, 0, len(configuration.Shards))
+	shardKeys := make([]string, 0, len(configuration.Shards))
+	seenKeys := map[string]struct{}{}
+	for i, shard := range configuration.Shards {
+		key := shard.Key
+		if key == "" {
+			key = strconv.FormatInt(int64(i), 10)
+		}
+		if _, ok := seenKeys[key]; ok {
+			return sharding.Topology{}, status.Errorf(codes.InvalidArgument, "Multiple shards have key %#v", key)
+		}
+		seenKeys[key] = struct{}{}
+		keys = append(keys, key)
+
+		if shard.Weight == 0 {
+			return sharding.Topology{}, status.Errorf(codes.InvalidArgument, "Shards must have positive weights")
+		}
+		weights = append(weights, shard.Weight)
+
+		if shard.Backend == nil {
+			// Drained backend.
+			shardKeys = append(shardKeys, "")
+		} else {
+			shardKeys = append(shardKeys, key)
+		}
+	}
+
+	var shardPermuter sharding.ShardPermuter
+	switch algorithm := configuration.Algorithm.(type) {
+	case nil:
+		shardPermuter = sharding.NewWeightedShardPermuter(weights)
+	case *pb.ShardingBlobAccessConfiguration_RendezvousHashing:
+		shardPermuter = sharding.NewRendezvousShardPermuter(keys, weights)
+	case *pb.ShardingBlobAccessConfiguration_ConsistentHashing_:
+		if algorithm.ConsistentHashing.VirtualNodesPerWeight == 0 {
+			return sharding.Topology{}, status.Error(codes.InvalidArgument, "Consistent hashing requires a positive number of virtual nodes per weight")
+		}
+		shardPermuter = sharding.NewConsistentHashingShardPermuter(keys, weights, algorithm.ConsistentHashing.VirtualNodesPerWeight)
+	default:
+		return sharding.Topology{}, status.Error(codes.InvalidArgument, "Sharding algorithm not supported")
+	}
+	return sharding.Topology{
+		HashInitialization: configuration.HashInitialization,
+		ShardPermuter:      shardPermuter,
+		ShardKeys:          shardKeys,
+	}, nil
+}
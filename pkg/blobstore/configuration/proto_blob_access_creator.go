@@ -40,8 +40,9 @@ func newProtoCustomBlobAccess(configuration *pb.BlobAccessConfiguration, nestedC
 			return BlobAccessInfo{}, "", err
 		}
 		return BlobAccessInfo{
-			BlobAccess:      blobstore.NewHierarchicalInstanceNamesBlobAccess(base.BlobAccess),
-			DigestKeyFormat: base.DigestKeyFormat,
+			BlobAccess:       blobstore.NewHierarchicalInstanceNamesBlobAccess(base.BlobAccess),
+			DigestKeyFormat:  base.DigestKeyFormat,
+			DigestEnumerator: base.DigestEnumerator,
 		}, "hierarchical_instance_names", nil
 	default:
 		return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Configuration did not contain a supported storage backend")
@@ -2,6 +2,7 @@ package blobstore
 
 import (
 	"context"
+	"iter"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
@@ -125,6 +126,57 @@ func (ba *demultiplexingBlobAccess) FindMissing(ctx context.Context, digests dig
 	return allMissing.Build(), nil
 }
 
+func (ba *demultiplexingBlobAccess) FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+	// Partition the stream into one stream per backend. Unlike
+	// FindMissing(), backends are called in parallel.
+	type partitionInfo struct {
+		partition *FindMissingStreamPartition
+		patcher   digest.InstanceNamePatcher
+	}
+	partitioner := NewFindMissingStreamPartitioner(ctx, reportMissing)
+	perInstanceNamePartitions := map[digest.InstanceName]*partitionInfo{}
+	perBackendPartitions := map[string]*partitionInfo{}
+	for blobDigest := range digests {
+		instanceName := blobDigest.GetInstanceName()
+		partition, ok := perInstanceNamePartitions[instanceName]
+		if !ok {
+			// This instance name hasn't been observed before.
+			backend, backendName, patcher, err := ba.getBackend(instanceName)
+			if err != nil {
+				partitioner.Abort(err)
+				break
+			}
+			partition, ok = perBackendPartitions[backendName]
+			if !ok {
+				// This backend hasn't been observed before.
+				partition = &partitionInfo{
+					partition: partitioner.AddPartition(func(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+						if err := backend.FindMissingStream(
+							ctx,
+							digests,
+							func(blobDigest digest.Digest) error {
+								// Undo changes to the instance name.
+								return reportMissing(patcher.UnpatchDigest(blobDigest))
+							},
+						); err != nil {
+							return util.StatusWrapf(err, "Backend %#v", backendName)
+						}
+						return nil
+					}),
+					patcher: patcher,
+				}
+				perBackendPartitions[backendName] = partition
+			}
+			perInstanceNamePartitions[instanceName] = partition
+		}
+		// Change the instance name if requested.
+		if !partition.partition.Add(partition.patcher.PatchDigest(blobDigest)) {
+			break
+		}
+	}
+	return partitioner.Wait()
+}
+
 func (ba *demultiplexingBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
 	backend, backendName, patcher, err := ba.getBackend(instanceName)
 	if err != nil {
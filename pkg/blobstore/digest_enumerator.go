@@ -0,0 +1,25 @@
+package blobstore
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/digest"
+)
+
+// DigestEnumerator is implemented by storage backends that are capable
+// of enumerating the digests of the objects they store. This can, for
+// example, be used to copy the full contents of the Action Cache, whose
+// keys cannot be derived from the objects referenced by it.
+//
+// Only a small number of storage backends are capable of providing
+// this feature. Most storage backends are remote, while LocalBlobAccess
+// only stores hashes of keys, unless it is configured to maintain a
+// digest index. Decorators of BlobAccess do not implement this
+// interface. Configuration code instead propagates the DigestEnumerator
+// of a backend through decorators that forward all requests to it.
+type DigestEnumerator interface {
+	// EnumerateDigests calls a function for every object stored
+	// whose instance name is equal to or below the provided
+	// instance name prefix.
+	EnumerateDigests(ctx context.Context, instanceNamePrefix digest.InstanceName, reportDigest func(digest.Digest) error) error
+}
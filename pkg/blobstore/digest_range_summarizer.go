@@ -0,0 +1,121 @@
+package blobstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/buildbarn/bb-storage/pkg/digest"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MaximumDigestRangeBits is the maximum number of bits that may be used
+// to partition digests into ranges. It limits the number of summaries
+// that DigestRangeSummarizer.SummarizeDigestRanges() returns.
+const MaximumDigestRangeBits = 16
+
+// DigestRangeSummary summarizes the objects stored within a single
+// range of digests. If two storage backends yield the same summary
+// for a given range, they are assumed to store the same objects
+// within that range.
+type DigestRangeSummary struct {
+	// The number of objects stored in the range.
+	BlobsCount uint64
+	// The bitwise XOR of the SHA-256 hashes of the keys of all
+	// objects stored in the range.
+	Hash [sha256.Size]byte
+}
+
+// DigestRangeSummarizer is implemented by storage backends whose
+// contents can be compared against those of other backends. Objects
+// are partitioned into 2^rangeBits ranges based on the leading bits of
+// the SHA-256 hash of their keys. Summaries of all ranges can be
+// compared first, so that only the digests of objects in ranges whose
+// summaries differ need to be listed.
+type DigestRangeSummarizer interface {
+	// SummarizeDigestRanges returns summaries of all ranges,
+	// only considering objects whose instance name is equal to or
+	// below the provided instance name prefix.
+	SummarizeDigestRanges(ctx context.Context, instanceNamePrefix digest.InstanceName, rangeBits int) ([]DigestRangeSummary, error)
+	// ListDigests calls a function for every object stored in one
+	// of the provided ranges whose instance name is equal to or
+	// below the provided instance name prefix.
+	ListDigests(ctx context.Context, instanceNamePrefix digest.InstanceName, rangeBits int, ranges []uint32, reportDigest func(digest.Digest) error) error
+}
+
+// GetDigestRange returns the range in which an object is placed when
+// objects are partitioned into 2^rangeBits ranges, and the hash of its
+// key that is used to compute summaries.
+func GetDigestRange(blobDigest digest.Digest, rangeBits int) (uint32, [sha256.Size]byte) {
+	// Use the key including the instance name, so that backends
+	// that partition objects by instance name and backends that
+	// report objects under the instance name prefix both yield the
+	// same results.
+	hash := sha256.Sum256([]byte(blobDigest.GetKey(digest.KeyWithInstance)))
+	if rangeBits == 0 {
+		return 0, hash
+	}
+	return binary.BigEndian.Uint32(hash[:]) >> (32 - rangeBits), hash
+}
+
+// ValidateDigestRangeBits checks whether the number of bits that is
+// used to partition objects into ranges is supported.
+func ValidateDigestRangeBits(rangeBits int) error {
+	if rangeBits < 0 || rangeBits > MaximumDigestRangeBits {
+		return status.Errorf(codes.InvalidArgument, "Number of range bits must be between 0 and %d", MaximumDigestRangeBits)
+	}
+	return nil
+}
+
+type enumeratingDigestRangeSummarizer struct {
+	enumerator DigestEnumerator
+}
+
+// NewEnumeratingDigestRangeSummarizer creates a DigestRangeSummarizer
+// that computes summaries and lists digests by enumerating all objects
+// stored in a storage backend.
+func NewEnumeratingDigestRangeSummarizer(enumerator DigestEnumerator) DigestRangeSummarizer {
+	return enumeratingDigestRangeSummarizer{
+		enumerator: enumerator,
+	}
+}
+
+func (ds enumeratingDigestRangeSummarizer) SummarizeDigestRanges(ctx context.Context, instanceNamePrefix digest.InstanceName, rangeBits int) ([]DigestRangeSummary, error) {
+	if err := ValidateDigestRangeBits(rangeBits); err != nil {
+		return nil, err
+	}
+	summaries := make([]DigestRangeSummary, 1<<rangeBits)
+	if err := ds.enumerator.EnumerateDigests(ctx, instanceNamePrefix, func(blobDigest digest.Digest) error {
+		digestRange, hash := GetDigestRange(blobDigest, rangeBits)
+		summary := &summaries[digestRange]
+		summary.BlobsCount++
+		for i, b := range hash {
+			summary.Hash[i] ^= b
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return summaries, nil
+}
+
+func (ds enumeratingDigestRangeSummarizer) ListDigests(ctx context.Context, instanceNamePrefix digest.InstanceName, rangeBits int, ranges []uint32, reportDigest func(digest.Digest) error) error {
+	if err := ValidateDigestRangeBits(rangeBits); err != nil {
+		return err
+	}
+	selectedRanges := make([]bool, 1<<rangeBits)
+	for _, digestRange := range ranges {
+		if digestRange >= uint32(len(selectedRanges)) {
+			return status.Errorf(codes.InvalidArgument, "Range %d exceeds the number of ranges", digestRange)
+		}
+		selectedRanges[digestRange] = true
+	}
+	return ds.enumerator.EnumerateDigests(ctx, instanceNamePrefix, func(blobDigest digest.Digest) error {
+		if digestRange, _ := GetDigestRange(blobDigest, rangeBits); selectedRanges[digestRange] {
+			return reportDigest(blobDigest)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,114 @@
+package blobstore_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestEnumeratingDigestRangeSummarizer(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	enumerator := mock.NewMockDigestEnumerator(ctrl)
+	summarizer := blobstore.NewEnumeratingDigestRangeSummarizer(enumerator)
+
+	instanceNamePrefix := digest.MustNewInstanceName("hello")
+	digest1 := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	digest2 := digest.MustNewDigest("hello/world", remoteexecution.DigestFunction_MD5, "6fc422233a40a75a1f028e11c3cd1140", 7)
+	digest3 := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+	enumerateDigests := func(ctx context.Context, instanceNamePrefix digest.InstanceName, reportDigest func(digest.Digest) error) error {
+		for _, blobDigest := range []digest.Digest{digest1, digest2, digest3} {
+			if err := reportDigest(blobDigest); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	t.Run("InvalidRangeBits", func(t *testing.T) {
+		_, err := summarizer.SummarizeDigestRanges(ctx, instanceNamePrefix, 17)
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Number of range bits must be between 0 and 16"), err)
+	})
+
+	t.Run("SummarizeSingleRange", func(t *testing.T) {
+		// Summaries should be based on the SHA-256 hashes of the
+		// keys of objects, including their instance names.
+		enumerator.EXPECT().EnumerateDigests(ctx, instanceNamePrefix, gomock.Any()).DoAndReturn(enumerateDigests)
+
+		var expectedHash [sha256.Size]byte
+		for _, key := range []string{
+			"3-8b1a9953c4611296a827abf8c47804d7-5-hello",
+			"3-6fc422233a40a75a1f028e11c3cd1140-7-hello/world",
+			"1-185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969-5-hello",
+		} {
+			hash := sha256.Sum256([]byte(key))
+			for i, b := range hash {
+				expectedHash[i] ^= b
+			}
+		}
+
+		summaries, err := summarizer.SummarizeDigestRanges(ctx, instanceNamePrefix, 0)
+		require.NoError(t, err)
+		require.Equal(t, []blobstore.DigestRangeSummary{
+			{BlobsCount: 3, Hash: expectedHash},
+		}, summaries)
+	})
+
+	t.Run("SummarizeMultipleRanges", func(t *testing.T) {
+		enumerator.EXPECT().EnumerateDigests(ctx, instanceNamePrefix, gomock.Any()).DoAndReturn(enumerateDigests)
+
+		summaries, err := summarizer.SummarizeDigestRanges(ctx, instanceNamePrefix, 4)
+		require.NoError(t, err)
+		require.Len(t, summaries, 16)
+		var blobsCount uint64
+		for _, summary := range summaries {
+			blobsCount += summary.BlobsCount
+		}
+		require.Equal(t, uint64(3), blobsCount)
+		range1, hash1 := blobstore.GetDigestRange(digest1, 4)
+		require.Equal(t, sha256.Sum256([]byte("3-8b1a9953c4611296a827abf8c47804d7-5-hello")), hash1)
+		require.NotZero(t, summaries[range1].BlobsCount)
+	})
+
+	t.Run("ListDigests", func(t *testing.T) {
+		// Only objects in the requested ranges should be
+		// reported.
+		enumerator.EXPECT().EnumerateDigests(ctx, instanceNamePrefix, gomock.Any()).DoAndReturn(enumerateDigests)
+
+		range1, _ := blobstore.GetDigestRange(digest1, 4)
+		var expectedDigests []digest.Digest
+		for _, blobDigest := range []digest.Digest{digest1, digest2, digest3} {
+			if digestRange, _ := blobstore.GetDigestRange(blobDigest, 4); digestRange == range1 {
+				expectedDigests = append(expectedDigests, blobDigest)
+			}
+		}
+
+		var digests []digest.Digest
+		require.NoError(t, summarizer.ListDigests(ctx, instanceNamePrefix, 4, []uint32{range1}, func(blobDigest digest.Digest) error {
+			digests = append(digests, blobDigest)
+			return nil
+		}))
+		require.Equal(t, expectedDigests, digests)
+	})
+
+	t.Run("ListDigestsInvalidRange", func(t *testing.T) {
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.InvalidArgument, "Range 16 exceeds the number of ranges"),
+			summarizer.ListDigests(ctx, instanceNamePrefix, 4, []uint32{16}, func(blobDigest digest.Digest) error {
+				return nil
+			}))
+	})
+}
@@ -2,6 +2,7 @@ package blobstore
 
 import (
 	"context"
+	"iter"
 
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
@@ -63,3 +64,16 @@ func (ba *emptyBlobInjectingBlobAccess) Put(ctx context.Context, digest digest.D
 func (ba *emptyBlobInjectingBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
 	return ba.BlobAccess.FindMissing(ctx, digests.RemoveEmptyBlob())
 }
+
+func (ba *emptyBlobInjectingBlobAccess) FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+	return ba.BlobAccess.FindMissingStream(
+		ctx,
+		func(yield func(digest.Digest) bool) {
+			for blobDigest := range digests {
+				if blobDigest.GetSizeBytes() != 0 && !yield(blobDigest) {
+					return
+				}
+			}
+		},
+		reportMissing)
+}
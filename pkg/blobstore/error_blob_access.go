@@ -2,6 +2,7 @@ package blobstore
 
 import (
 	"context"
+	"iter"
 	"log"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
@@ -43,6 +44,10 @@ func (ba *errorBlobAccess) FindMissing(ctx context.Context, digests digest.Set)
 	return digest.EmptySet, ba.err
 }
 
+func (ba *errorBlobAccess) FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+	return ba.err
+}
+
 func (ba *errorBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
 	return nil, ba.err
 }
@@ -2,6 +2,7 @@ package blobstore
 
 import (
 	"context"
+	"iter"
 
 	"github.com/buildbarn/bb-storage/pkg/digest"
 )
@@ -45,3 +46,44 @@ func (ba *existenceCachingBlobAccess) FindMissing(ctx context.Context, digests d
 	ba.existenceCache.Add(present)
 	return missing, nil
 }
+
+func (ba *existenceCachingBlobAccess) FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+	// Only forward digests that are not present in the cache. The
+	// cache is queried in batches to reduce lock contention.
+	maybeMissing := digest.NewSetBuilder()
+	missing := digest.NewSetBuilder()
+	if err := ba.BlobAccess.FindMissingStream(
+		ctx,
+		func(yield func(digest.Digest) bool) {
+			batch := digest.NewSetBuilder()
+			flush := func() bool {
+				for _, blobDigest := range ba.existenceCache.RemoveExisting(batch.Build()).Items() {
+					maybeMissing.Add(blobDigest)
+					if !yield(blobDigest) {
+						return false
+					}
+				}
+				batch = digest.NewSetBuilder()
+				return true
+			}
+			for blobDigest := range digests {
+				batch.Add(blobDigest)
+				if batch.Length() >= RecommendedFindMissingDigestsCount && !flush() {
+					return
+				}
+			}
+			flush()
+		},
+		func(blobDigest digest.Digest) error {
+			missing.Add(blobDigest)
+			return reportMissing(blobDigest)
+		},
+	); err != nil {
+		return err
+	}
+
+	// Insert the digests that were present for future calls.
+	present, _, _ := digest.GetDifferenceAndIntersection(maybeMissing.Build(), missing.Build())
+	ba.existenceCache.Add(present)
+	return nil
+}
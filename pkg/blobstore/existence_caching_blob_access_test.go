@@ -2,6 +2,8 @@ package blobstore_test
 
 import (
 	"context"
+	"iter"
+	"slices"
 	"testing"
 	"time"
 
@@ -55,3 +57,46 @@ func TestExistenceCachingBlobAccessFindMissing(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, nonExistingDigests, missing)
 }
+
+func TestExistenceCachingBlobAccessFindMissingStream(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	blobAccess := blobstore.NewExistenceCachingBlobAccess(
+		baseBlobAccess,
+		digest.NewExistenceCache(clock, digest.KeyWithoutInstance, 10, time.Minute, eviction.NewLRUSet[string]()))
+
+	existingDigest := digest.MustNewDigest("instance", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+	nonExistingDigest := digest.MustNewDigest("instance", remoteexecution.DigestFunction_SHA256, "78ae647dc5544d227130a0682a51e30bc7777fbb6d8a8f17007463a3ecd1d524", 5)
+	findMissingStream := func() []digest.Digest {
+		var missing []digest.Digest
+		require.NoError(t, blobAccess.FindMissingStream(
+			ctx,
+			slices.Values([]digest.Digest{existingDigest, nonExistingDigest}),
+			func(blobDigest digest.Digest) error {
+				missing = append(missing, blobDigest)
+				return nil
+			}))
+		return missing
+	}
+	expectBackendStream := func(expectedDigests []digest.Digest) {
+		baseBlobAccess.EXPECT().FindMissingStream(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+				require.Equal(t, expectedDigests, slices.Collect(digests))
+				return reportMissing(nonExistingDigest)
+			})
+	}
+
+	// As the cache is empty upon initialization, the first request
+	// should cause both digests to be queried on the backend.
+	clock.EXPECT().Now().Return(time.Unix(1000, 0)).Times(2)
+	expectBackendStream([]digest.Digest{existingDigest, nonExistingDigest})
+	require.Equal(t, []digest.Digest{nonExistingDigest}, findMissingStream())
+
+	// The existing object should be cached for up to a minute,
+	// causing only the nonexisting one to be forwarded.
+	clock.EXPECT().Now().Return(time.Unix(1060, 0)).Times(2)
+	expectBackendStream([]digest.Digest{nonExistingDigest})
+	require.Equal(t, []digest.Digest{nonExistingDigest}, findMissingStream())
+}
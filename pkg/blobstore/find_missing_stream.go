@@ -0,0 +1,193 @@
+package blobstore
+
+import (
+	"context"
+	"iter"
+	"sync"
+
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// findMissingStreamConcurrency is the maximum number of batches that
+// FindMissingStreamInBatches() keeps in flight. Having more than a
+// single batch in flight allows the next batch to be constructed and
+// sent while results of the previous batch are still being computed.
+const findMissingStreamConcurrency = 4
+
+// findMissingStreamPartitionBufferSize is the number of digests that
+// may be queued up for a single partition created through
+// FindMissingStreamPartitioner, before the producer blocks.
+const findMissingStreamPartitionBufferSize = 1000
+
+// FindMissingFunc has the same signature as BlobAccess.FindMissing().
+type FindMissingFunc func(ctx context.Context, digests digest.Set) (digest.Set, error)
+
+// FindMissingStreamFunc has the same signature as
+// BlobAccess.FindMissingStream().
+type FindMissingStreamFunc func(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error
+
+// FindMissingStreamInBatches is a helper function for implementing
+// BlobAccess.FindMissingStream() on top of FindMissing(). It gathers
+// digests into batches of at most RecommendedFindMissingDigestsCount
+// digests, and calls FindMissing() for multiple batches concurrently.
+func FindMissingStreamInBatches(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error, findMissing FindMissingFunc) error {
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(findMissingStreamConcurrency)
+	var reportMissingLock sync.Mutex
+	flush := func(batch digest.Set) {
+		group.Go(func() error {
+			missing, err := findMissing(groupCtx, batch)
+			if err != nil {
+				return err
+			}
+			reportMissingLock.Lock()
+			defer reportMissingLock.Unlock()
+			for _, blobDigest := range missing.Items() {
+				if err := reportMissing(blobDigest); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+
+	batch := digest.NewSetBuilder()
+	for blobDigest := range digests {
+		batch.Add(blobDigest)
+		if batch.Length() >= RecommendedFindMissingDigestsCount {
+			flush(batch.Build())
+			batch = digest.NewSetBuilder()
+			if groupCtx.Err() != nil {
+				break
+			}
+		}
+	}
+	if batch.Length() > 0 && groupCtx.Err() == nil {
+		flush(batch.Build())
+	}
+
+	if err := group.Wait(); err != nil {
+		return err
+	}
+	// Iteration may have been interrupted due to cancelation of
+	// the parent context. Results are incomplete in that case.
+	return util.StatusFromContext(ctx)
+}
+
+// FindMissingStreamPartitioner is a helper type for implementing
+// BlobAccess.FindMissingStream() for decorators that partition digests
+// across multiple backends, such as ShardingBlobAccess. For every
+// partition a goroutine is launched that calls FindMissingStream() on
+// the backend, allowing all partitions to be processed concurrently.
+type FindMissingStreamPartitioner struct {
+	ctx      context.Context
+	group    *errgroup.Group
+	groupCtx context.Context
+	channels []chan digest.Digest
+
+	reportMissingLock  sync.Mutex
+	reportMissing      func(digest.Digest) error
+	reportMissingError error
+}
+
+// NewFindMissingStreamPartitioner creates a FindMissingStreamPartitioner
+// that does not have any partitions yet. Missing digests reported by
+// the partitions are forwarded to the provided callback.
+func NewFindMissingStreamPartitioner(ctx context.Context, reportMissing func(digest.Digest) error) *FindMissingStreamPartitioner {
+	group, groupCtx := errgroup.WithContext(ctx)
+	return &FindMissingStreamPartitioner{
+		ctx:           ctx,
+		group:         group,
+		groupCtx:      groupCtx,
+		reportMissing: reportMissing,
+	}
+}
+
+// AddPartition creates a new partition, whose digests are processed by
+// the provided FindMissingStream() function. Digests can be added to
+// the partition by calling FindMissingStreamPartition.Add().
+func (p *FindMissingStreamPartitioner) AddPartition(findMissingStream FindMissingStreamFunc) *FindMissingStreamPartition {
+	ch := make(chan digest.Digest, findMissingStreamPartitionBufferSize)
+	p.channels = append(p.channels, ch)
+	p.group.Go(func() error {
+		err := findMissingStream(
+			p.groupCtx,
+			func(yield func(digest.Digest) bool) {
+				for blobDigest := range ch {
+					if !yield(blobDigest) {
+						return
+					}
+				}
+			},
+			p.reportMissingLocked)
+		// Drain any digests that have not been consumed, so
+		// that the producer never blocks.
+		for range ch {
+		}
+		return err
+	})
+	return &FindMissingStreamPartition{
+		channel:  ch,
+		groupCtx: p.groupCtx,
+	}
+}
+
+func (p *FindMissingStreamPartitioner) reportMissingLocked(blobDigest digest.Digest) error {
+	p.reportMissingLock.Lock()
+	defer p.reportMissingLock.Unlock()
+	if p.reportMissingError == nil {
+		p.reportMissingError = p.reportMissing(blobDigest)
+	}
+	return p.reportMissingError
+}
+
+// Abort processing of all partitions. This causes Wait() to return the
+// provided error, unless one of the partitions failed previously.
+func (p *FindMissingStreamPartitioner) Abort(err error) {
+	p.group.Go(func() error { return err })
+}
+
+// Wait for all partitions to complete processing. This function must
+// be called exactly once, after all digests have been added to the
+// partitions.
+func (p *FindMissingStreamPartitioner) Wait() error {
+	for _, ch := range p.channels {
+		close(ch)
+	}
+	err := p.group.Wait()
+
+	// Errors returned by the caller's callback are returned as is,
+	// as opposed to having them decorated by the partitions.
+	p.reportMissingLock.Lock()
+	reportMissingError := p.reportMissingError
+	p.reportMissingLock.Unlock()
+	if reportMissingError != nil {
+		return reportMissingError
+	}
+	if err != nil {
+		return err
+	}
+	return util.StatusFromContext(p.ctx)
+}
+
+// FindMissingStreamPartition is a single partition that is created
+// through FindMissingStreamPartitioner.AddPartition().
+type FindMissingStreamPartition struct {
+	channel  chan<- digest.Digest
+	groupCtx context.Context
+}
+
+// Add a digest to the partition. This function returns false if
+// processing has been interrupted, meaning that the caller should stop
+// adding digests and call FindMissingStreamPartitioner.Wait().
+func (p *FindMissingStreamPartition) Add(blobDigest digest.Digest) bool {
+	select {
+	case p.channel <- blobDigest:
+		return true
+	case <-p.groupCtx.Done():
+		return false
+	}
+}
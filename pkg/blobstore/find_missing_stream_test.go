@@ -0,0 +1,135 @@
+package blobstore_test
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+	"sync"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFindMissingStreamInBatches(t *testing.T) {
+	ctx := context.Background()
+
+	// Generate a sequence of digests that doesn't fit in a single
+	// batch.
+	digests := make([]digest.Digest, 0, blobstore.RecommendedFindMissingDigestsCount+1)
+	for i := 0; i < cap(digests); i++ {
+		digests = append(digests, digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, fmt.Sprintf("%032x", i), int64(i)))
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		// Calls to FindMissing() should be limited in size.
+		// Every even-sized digest is reported as missing.
+		var lock sync.Mutex
+		var batchSizes []int
+		missing := digest.NewSetBuilder()
+		require.NoError(t, blobstore.FindMissingStreamInBatches(
+			ctx,
+			slices.Values(digests),
+			func(blobDigest digest.Digest) error {
+				missing.Add(blobDigest)
+				return nil
+			},
+			func(ctx context.Context, digests digest.Set) (digest.Set, error) {
+				lock.Lock()
+				batchSizes = append(batchSizes, digests.Length())
+				lock.Unlock()
+
+				missing := digest.NewSetBuilder()
+				for _, blobDigest := range digests.Items() {
+					if blobDigest.GetSizeBytes()%2 == 0 {
+						missing.Add(blobDigest)
+					}
+				}
+				return missing.Build(), nil
+			}))
+
+		slices.Sort(batchSizes)
+		require.Equal(t, []int{1, blobstore.RecommendedFindMissingDigestsCount}, batchSizes)
+		require.Equal(t, blobstore.RecommendedFindMissingDigestsCount/2+1, missing.Length())
+	})
+
+	t.Run("BackendFailure", func(t *testing.T) {
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Unavailable, "Server offline"),
+			blobstore.FindMissingStreamInBatches(
+				ctx,
+				slices.Values(digests),
+				func(blobDigest digest.Digest) error {
+					t.Fatal("No digests should be reported as missing")
+					return nil
+				},
+				func(ctx context.Context, digests digest.Set) (digest.Set, error) {
+					return digest.EmptySet, status.Error(codes.Unavailable, "Server offline")
+				}))
+	})
+}
+
+func TestFindMissingStreamPartitioner(t *testing.T) {
+	ctx := context.Background()
+
+	digest1 := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "21f843aefbfb88627ec2cad9e8f1f49a", 1)
+	digest2 := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "48f2503cf369373b0631da97fb9de1c1", 2)
+
+	reportAll := func(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+		for blobDigest := range digests {
+			if err := reportMissing(blobDigest); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		missing := digest.NewSetBuilder()
+		partitioner := blobstore.NewFindMissingStreamPartitioner(ctx, func(blobDigest digest.Digest) error {
+			missing.Add(blobDigest)
+			return nil
+		})
+		require.True(t, partitioner.AddPartition(reportAll).Add(digest1))
+		require.True(t, partitioner.AddPartition(reportAll).Add(digest2))
+		require.NoError(t, partitioner.Wait())
+		require.Equal(t, digest.NewSetBuilder().Add(digest1).Add(digest2).Build(), missing.Build())
+	})
+
+	t.Run("ReportMissingFailure", func(t *testing.T) {
+		// Errors returned by the caller's callback should be
+		// returned without any decoration added by partitions.
+		partitioner := blobstore.NewFindMissingStreamPartitioner(ctx, func(blobDigest digest.Digest) error {
+			return status.Error(codes.ResourceExhausted, "Too many missing digests")
+		})
+		partition := partitioner.AddPartition(func(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+			if err := reportAll(ctx, digests, reportMissing); err != nil {
+				return status.Error(codes.Internal, "Partition failed")
+			}
+			return nil
+		})
+		partition.Add(digest1)
+		testutil.RequireEqualStatus(t, status.Error(codes.ResourceExhausted, "Too many missing digests"), partitioner.Wait())
+	})
+
+	t.Run("Abort", func(t *testing.T) {
+		partitioner := blobstore.NewFindMissingStreamPartitioner(ctx, func(blobDigest digest.Digest) error {
+			return nil
+		})
+		partitioner.AddPartition(func(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+			<-ctx.Done()
+			return util.StatusFromContext(ctx)
+		})
+		partitioner.Abort(status.Error(codes.InvalidArgument, "Unknown instance name"))
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Unknown instance name"), partitioner.Wait())
+	})
+}
@@ -0,0 +1,164 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"iter"
+	"log"
+
+	"cloud.google.com/go/storage"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/capabilities"
+	cloud_gcp "github.com/buildbarn/bb-storage/pkg/cloud/gcp"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type gcsBlobAccess struct {
+	capabilities.Provider
+	readBufferFactory         ReadBufferFactory
+	digestKeyFormat           digest.KeyFormat
+	bucket                    cloud_gcp.StorageBucketHandle
+	keyPrefix                 string
+	maximumConcurrentRequests int
+}
+
+// NewGCSBlobAccess creates a BlobAccess that stores objects in a
+// Google Cloud Storage bucket. Objects are named after their key, as
+// returned by digest.Digest.GetKey(), optionally preceded by a prefix.
+func NewGCSBlobAccess(capabilitiesProvider capabilities.Provider, readBufferFactory ReadBufferFactory, digestKeyFormat digest.KeyFormat, bucket cloud_gcp.StorageBucketHandle, keyPrefix string, maximumConcurrentRequests int) BlobAccess {
+	return &gcsBlobAccess{
+		Provider:                  capabilitiesProvider,
+		readBufferFactory:         readBufferFactory,
+		digestKeyFormat:           digestKeyFormat,
+		bucket:                    bucket,
+		keyPrefix:                 keyPrefix,
+		maximumConcurrentRequests: maximumConcurrentRequests,
+	}
+}
+
+func (ba *gcsBlobAccess) getObjectKey(blobDigest digest.Digest) string {
+	return ba.keyPrefix + blobDigest.GetKey(ba.digestKeyFormat)
+}
+
+// newDataIntegrityCallback returns a DataIntegrityCallback that
+// removes an object from the bucket in case its contents are
+// corrupted. This allows clients to upload the object once again.
+func (ba *gcsBlobAccess) newDataIntegrityCallback(key string) buffer.DataIntegrityCallback {
+	return func(dataIsValid bool) {
+		if !dataIsValid {
+			if err := ba.bucket.Object(key).Delete(context.Background()); err != nil {
+				log.Printf("Failed to delete corrupted object %#v: %s", key, err)
+			}
+		}
+	}
+}
+
+func (ba *gcsBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	key := ba.getObjectKey(blobDigest)
+	r, err := ba.bucket.Object(key).NewRangeReader(ctx, 0, cloud_gcp.ReadUntilEOF)
+	if err != nil {
+		return buffer.NewBufferFromError(util.StatusWrapf(gcsErrToStatus(err), "Failed to get object %#v", key))
+	}
+	return ba.readBufferFactory.NewBufferFromReader(
+		blobDigest,
+		statusReturningReadCloser{r: r},
+		ba.newDataIntegrityCallback(key))
+}
+
+func (ba *gcsBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	return getFromCompositeUsingSliceObjects(ctx, ba, ba.readBufferFactory, ba.maximumConcurrentRequests, parentDigest, childDigest, slicer)
+}
+
+func (ba *gcsBlobAccess) getSliceObjectOffset(ctx context.Context, parentDigest, childDigest digest.Digest) (int64, error) {
+	attrs, err := ba.bucket.Object(getSliceObjectKey(ba.keyPrefix, ba.digestKeyFormat, parentDigest, childDigest)).Attrs(ctx)
+	if err != nil {
+		return 0, gcsErrToStatus(err)
+	}
+	return parseSliceObjectOffset(attrs.Metadata)
+}
+
+func (ba *gcsBlobAccess) getSlice(ctx context.Context, parentDigest, childDigest digest.Digest, offsetBytes int64) buffer.Buffer {
+	parentKey := ba.getObjectKey(parentDigest)
+	r, err := ba.bucket.Object(parentKey).NewRangeReader(ctx, offsetBytes, childDigest.GetSizeBytes())
+	if err != nil {
+		return buffer.NewBufferFromError(util.StatusWrapf(gcsErrToStatus(err), "Failed to get object %#v", parentKey))
+	}
+	return ba.readBufferFactory.NewBufferFromReader(
+		childDigest,
+		statusReturningReadCloser{r: r},
+		ba.newDataIntegrityCallback(parentKey))
+}
+
+func (ba *gcsBlobAccess) putSliceObject(ctx context.Context, parentDigest, childDigest digest.Digest, offsetBytes int64) error {
+	sliceKey := getSliceObjectKey(ba.keyPrefix, ba.digestKeyFormat, parentDigest, childDigest)
+	w := ba.bucket.Object(sliceKey).NewWriter(ctx, newSliceObjectMetadata(offsetBytes))
+	if err := w.Close(); err != nil {
+		return util.StatusWrapf(gcsErrToStatus(err), "Failed to create slice object %#v", sliceKey)
+	}
+	return nil
+}
+
+func (ba *gcsBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	// Canceling the context causes the upload to be aborted, which
+	// prevents partially written objects from becoming visible.
+	ctxWithCancel, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	key := ba.getObjectKey(blobDigest)
+	w := ba.bucket.Object(key).NewWriter(ctxWithCancel, nil)
+	if err := b.IntoWriter(gcsStatusReturningWriter{w: w}); err != nil {
+		cancel()
+		w.Close()
+		return util.StatusWrapf(err, "Failed to put object %#v", key)
+	}
+	if err := w.Close(); err != nil {
+		return util.StatusWrapf(gcsErrToStatus(err), "Failed to put object %#v", key)
+	}
+	return nil
+}
+
+func (ba *gcsBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
+	// GCS does not provide an API for checking the existence of
+	// multiple objects at once. Request the attributes of every
+	// object.
+	return findMissingUsingExistenceChecks(ctx, digests, ba.maximumConcurrentRequests, func(ctx context.Context, blobDigest digest.Digest) error {
+		key := ba.getObjectKey(blobDigest)
+		if _, err := ba.bucket.Object(key).Attrs(ctx); err != nil {
+			return util.StatusWrapf(gcsErrToStatus(err), "Failed to get attributes of object %#v", key)
+		}
+		return nil
+	})
+}
+
+func (ba *gcsBlobAccess) FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+	return FindMissingStreamInBatches(ctx, digests, reportMissing, ba.FindMissing)
+}
+
+// gcsErrToStatus converts an error returned by the Google Cloud SDK
+// to a gRPC status. Errors indicating that an object does not exist
+// are converted to NOT_FOUND.
+func gcsErrToStatus(err error) error {
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return errToStatus(err)
+}
+
+// gcsStatusReturningWriter is a decorator for the writer returned by
+// StorageObjectHandle.NewWriter() that converts errors to gRPC style
+// status. This prevents them from being confused with errors returned
+// by the buffer that is being uploaded.
+type gcsStatusReturningWriter struct {
+	w io.Writer
+}
+
+func (w gcsStatusReturningWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	return n, gcsErrToStatus(err)
+}
@@ -0,0 +1,191 @@
+package blobstore_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestGCSBlobAccessGet(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	bucket := mock.NewMockStorageBucketHandle(ctrl)
+	blobAccess := blobstore.NewGCSBlobAccess(
+		nil,
+		blobstore.CASReadBufferFactory,
+		digest.KeyWithoutInstance,
+		bucket,
+		"cas/",
+		10)
+	helloDigest := digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	t.Run("NotFound", func(t *testing.T) {
+		object := mock.NewMockStorageObjectHandle(ctrl)
+		bucket.EXPECT().Object("cas/3-8b1a9953c4611296a827abf8c47804d7-5").Return(object)
+		object.EXPECT().NewRangeReader(ctx, int64(0), int64(-1)).Return(nil, storage.ErrObjectNotExist)
+
+		_, err := blobAccess.Get(ctx, helloDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Failed to get object \"cas/3-8b1a9953c4611296a827abf8c47804d7-5\": storage: object doesn't exist"), err)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		object := mock.NewMockStorageObjectHandle(ctrl)
+		bucket.EXPECT().Object("cas/3-8b1a9953c4611296a827abf8c47804d7-5").Return(object)
+		object.EXPECT().NewRangeReader(ctx, int64(0), int64(-1)).Return(io.NopCloser(strings.NewReader("Hello")), nil)
+
+		data, err := blobAccess.Get(ctx, helloDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("DataCorruption", func(t *testing.T) {
+		// Objects whose contents don't match the digest should
+		// be removed from the bucket.
+		object := mock.NewMockStorageObjectHandle(ctrl)
+		bucket.EXPECT().Object("cas/3-8b1a9953c4611296a827abf8c47804d7-5").Return(object).Times(2)
+		object.EXPECT().NewRangeReader(ctx, int64(0), int64(-1)).Return(io.NopCloser(strings.NewReader("Hallo")), nil)
+		object.EXPECT().Delete(gomock.Any())
+
+		_, err := blobAccess.Get(ctx, helloDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Buffer has checksum d1bf93299de1b68e6d382c893bf1215f, while 8b1a9953c4611296a827abf8c47804d7 was expected"), err)
+	})
+}
+
+func TestGCSBlobAccessGetFromComposite(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	bucket := mock.NewMockStorageBucketHandle(ctrl)
+	blobAccess := blobstore.NewGCSBlobAccess(
+		nil,
+		blobstore.CASReadBufferFactory,
+		digest.KeyWithoutInstance,
+		bucket,
+		"",
+		10)
+	parentDigest := digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "f32a26e2a3a8aa338cd77b6e1263c535", 6)
+	childDigest := digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "ddc35f88fa71b6ef142ae61f35364653", 3)
+
+	t.Run("Sliced", func(t *testing.T) {
+		// If the parent object has been sliced before, the child
+		// object should be loaded using a range request.
+		sliceObject := mock.NewMockStorageObjectHandle(ctrl)
+		bucket.EXPECT().Object("slices/3-f32a26e2a3a8aa338cd77b6e1263c535-6/3-ddc35f88fa71b6ef142ae61f35364653-3").Return(sliceObject)
+		sliceObject.EXPECT().Attrs(ctx).Return(&storage.ObjectAttrs{
+			Metadata: map[string]string{"offset-bytes": "3"},
+		}, nil)
+		parentObject := mock.NewMockStorageObjectHandle(ctrl)
+		bucket.EXPECT().Object("3-f32a26e2a3a8aa338cd77b6e1263c535-6").Return(parentObject)
+		parentObject.EXPECT().NewRangeReader(ctx, int64(3), int64(3)).Return(io.NopCloser(strings.NewReader("Bar")), nil)
+
+		data, err := blobAccess.GetFromComposite(ctx, parentDigest, childDigest, nil).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Bar"), data)
+	})
+
+	t.Run("SliceLookupFailure", func(t *testing.T) {
+		sliceObject := mock.NewMockStorageObjectHandle(ctrl)
+		bucket.EXPECT().Object("slices/3-f32a26e2a3a8aa338cd77b6e1263c535-6/3-ddc35f88fa71b6ef142ae61f35364653-3").Return(sliceObject)
+		sliceObject.EXPECT().Attrs(ctx).Return(nil, errors.New("dial tcp 1.2.3.4:443: connect: connection refused"))
+
+		_, err := blobAccess.GetFromComposite(ctx, parentDigest, childDigest, nil).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Failed to get slice object: dial tcp 1.2.3.4:443: connect: connection refused"), err)
+	})
+}
+
+func TestGCSBlobAccessPut(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	bucket := mock.NewMockStorageBucketHandle(ctrl)
+	blobAccess := blobstore.NewGCSBlobAccess(
+		nil,
+		blobstore.CASReadBufferFactory,
+		digest.KeyWithoutInstance,
+		bucket,
+		"cas/",
+		10)
+	helloDigest := digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	t.Run("Success", func(t *testing.T) {
+		object := mock.NewMockStorageObjectHandle(ctrl)
+		bucket.EXPECT().Object("cas/3-8b1a9953c4611296a827abf8c47804d7-5").Return(object)
+		writer := mock.NewMockWriteCloser(ctrl)
+		object.EXPECT().NewWriter(gomock.Any(), nil).Return(writer)
+		writer.EXPECT().Write([]byte("Hello")).Return(5, nil)
+		writer.EXPECT().Close()
+
+		require.NoError(t, blobAccess.Put(ctx, helloDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("DataCorruption", func(t *testing.T) {
+		// If the data to be uploaded turns out to be corrupted,
+		// the upload should be aborted.
+		object := mock.NewMockStorageObjectHandle(ctrl)
+		bucket.EXPECT().Object("cas/3-8b1a9953c4611296a827abf8c47804d7-5").Return(object)
+		writer := mock.NewMockWriteCloser(ctrl)
+		var writerCtx context.Context
+		object.EXPECT().NewWriter(gomock.Any(), nil).DoAndReturn(func(ctx context.Context, metadata map[string]string) io.WriteCloser {
+			writerCtx = ctx
+			return writer
+		})
+		writer.EXPECT().Close().DoAndReturn(func() error {
+			require.Error(t, writerCtx.Err())
+			return writerCtx.Err()
+		})
+
+		err := blobAccess.Put(ctx, helloDigest, buffer.NewCASBufferFromByteSlice(helloDigest, []byte("Hallo"), buffer.UserProvided))
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Failed to put object \"cas/3-8b1a9953c4611296a827abf8c47804d7-5\": Buffer has checksum d1bf93299de1b68e6d382c893bf1215f, while 8b1a9953c4611296a827abf8c47804d7 was expected"), err)
+	})
+}
+
+func TestGCSBlobAccessFindMissing(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	bucket := mock.NewMockStorageBucketHandle(ctrl)
+	blobAccess := blobstore.NewGCSBlobAccess(
+		nil,
+		blobstore.CASReadBufferFactory,
+		digest.KeyWithoutInstance,
+		bucket,
+		"",
+		2)
+	digest1 := digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "acbd18db4cc2f85cedef654fccc4a4d8", 3)
+	digest2 := digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "37b51d194a7513e45b56f6524f2d51f2", 3)
+
+	t.Run("Success", func(t *testing.T) {
+		object1 := mock.NewMockStorageObjectHandle(ctrl)
+		bucket.EXPECT().Object("3-acbd18db4cc2f85cedef654fccc4a4d8-3").Return(object1)
+		object1.EXPECT().Attrs(gomock.Any()).Return(&storage.ObjectAttrs{}, nil)
+		object2 := mock.NewMockStorageObjectHandle(ctrl)
+		bucket.EXPECT().Object("3-37b51d194a7513e45b56f6524f2d51f2-3").Return(object2)
+		object2.EXPECT().Attrs(gomock.Any()).Return(nil, storage.ErrObjectNotExist)
+
+		missing, err := blobAccess.FindMissing(ctx, digest.NewSetBuilder().Add(digest1).Add(digest2).Build())
+		require.NoError(t, err)
+		require.Equal(t, digest2.ToSingletonSet(), missing)
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		object1 := mock.NewMockStorageObjectHandle(ctrl)
+		bucket.EXPECT().Object("3-acbd18db4cc2f85cedef654fccc4a4d8-3").Return(object1)
+		object1.EXPECT().Attrs(gomock.Any()).Return(nil, errors.New("dial tcp 1.2.3.4:443: connect: connection refused"))
+
+		_, err := blobAccess.FindMissing(ctx, digest1.ToSingletonSet())
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Failed to get attributes of object \"3-acbd18db4cc2f85cedef654fccc4a4d8-3\": dial tcp 1.2.3.4:443: connect: connection refused"), err)
+	})
+}
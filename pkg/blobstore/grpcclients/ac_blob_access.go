@@ -2,6 +2,7 @@ package grpcclients
 
 import (
 	"context"
+	"iter"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
@@ -82,6 +83,10 @@ func (ba *acBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (di
 	return digest.EmptySet, status.Error(codes.Unimplemented, "Bazel action cache does not support bulk existence checking")
 }
 
+func (ba *acBlobAccess) FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+	return blobstore.FindMissingStreamInBatches(ctx, digests, reportMissing, ba.FindMissing)
+}
+
 func (ba *acBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
 	cacheCapabilities, err := getCacheCapabilities(ctx, ba.capabilitiesClient, instanceName)
 	if err != nil {
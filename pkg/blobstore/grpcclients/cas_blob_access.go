@@ -3,6 +3,7 @@ package grpcclients
 import (
 	"context"
 	"io"
+	"iter"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
@@ -22,6 +23,8 @@ type casBlobAccess struct {
 	capabilitiesClient              remoteexecution.CapabilitiesClient
 	uuidGenerator                   util.UUIDGenerator
 	readChunkSize                   int
+	compressor                      remoteexecution.Compressor_Value
+	compressionThresholdBytes       int64
 }
 
 // NewCASBlobAccess creates a BlobAccess handle that relays any requests
@@ -29,16 +32,32 @@ type casBlobAccess struct {
 // remoteexecution.ContentAddressableStorage services. Those are the
 // services that Bazel uses to access blobs stored in the Content
 // Addressable Storage.
-func NewCASBlobAccess(client grpc.ClientConnInterface, uuidGenerator util.UUIDGenerator, readChunkSize int) blobstore.BlobAccess {
+//
+// If a compressor other than IDENTITY is provided, blobs whose size is
+// at least compressionThresholdBytes are transferred in compressed
+// form, using the "compressed-blobs" resource names of the ByteStream
+// service.
+func NewCASBlobAccess(client grpc.ClientConnInterface, uuidGenerator util.UUIDGenerator, readChunkSize int, compressor remoteexecution.Compressor_Value, compressionThresholdBytes int64) blobstore.BlobAccess {
 	return &casBlobAccess{
 		byteStreamClient:                bytestream.NewByteStreamClient(client),
 		contentAddressableStorageClient: remoteexecution.NewContentAddressableStorageClient(client),
 		capabilitiesClient:              remoteexecution.NewCapabilitiesClient(client),
 		uuidGenerator:                   uuidGenerator,
 		readChunkSize:                   readChunkSize,
+		compressor:                      compressor,
+		compressionThresholdBytes:       compressionThresholdBytes,
 	}
 }
 
+// getCompressor returns the compressor that should be used to transfer
+// a blob with a given digest.
+func (ba *casBlobAccess) getCompressor(digest digest.Digest) remoteexecution.Compressor_Value {
+	if digest.GetSizeBytes() < ba.compressionThresholdBytes {
+		return remoteexecution.Compressor_IDENTITY
+	}
+	return ba.compressor
+}
+
 type byteStreamChunkReader struct {
 	client bytestream.ByteStream_ReadClient
 	cancel context.CancelFunc
@@ -62,18 +81,25 @@ func (r *byteStreamChunkReader) Close() {
 }
 
 func (ba *casBlobAccess) Get(ctx context.Context, digest digest.Digest) buffer.Buffer {
+	compressor := ba.getCompressor(digest)
 	ctxWithCancel, cancel := context.WithCancel(ctx)
 	client, err := ba.byteStreamClient.Read(ctxWithCancel, &bytestream.ReadRequest{
-		ResourceName: digest.GetByteStreamReadPath(remoteexecution.Compressor_IDENTITY),
+		ResourceName: digest.GetByteStreamReadPath(compressor),
 	})
 	if err != nil {
 		cancel()
 		return buffer.NewBufferFromError(err)
 	}
-	return buffer.NewCASBufferFromChunkReader(digest, &byteStreamChunkReader{
+	var r buffer.ChunkReader = &byteStreamChunkReader{
 		client: client,
 		cancel: cancel,
-	}, buffer.BackendProvided(buffer.Irreparable(digest)))
+	}
+	if compressor != remoteexecution.Compressor_IDENTITY {
+		if r, err = buffer.NewDecompressingChunkReader(r, compressor, ba.readChunkSize); err != nil {
+			return buffer.NewBufferFromError(err)
+		}
+	}
+	return buffer.NewCASBufferFromChunkReader(digest, r, buffer.BackendProvided(buffer.Irreparable(digest)))
 }
 
 func (ba *casBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
@@ -82,7 +108,14 @@ func (ba *casBlobAccess) GetFromComposite(ctx context.Context, parentDigest, chi
 }
 
 func (ba *casBlobAccess) Put(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+	compressor := ba.getCompressor(digest)
 	r := b.ToChunkReader(0, ba.readChunkSize)
+	if compressor != remoteexecution.Compressor_IDENTITY {
+		var err error
+		if r, err = buffer.NewCompressingChunkReader(r, compressor, ba.readChunkSize); err != nil {
+			return err
+		}
+	}
 	defer r.Close()
 
 	ctxWithCancel, cancel := context.WithCancel(ctx)
@@ -92,7 +125,7 @@ func (ba *casBlobAccess) Put(ctx context.Context, digest digest.Digest, b buffer
 		return err
 	}
 
-	resourceName := digest.GetByteStreamWritePath(uuid.Must(ba.uuidGenerator()), remoteexecution.Compressor_IDENTITY)
+	resourceName := digest.GetByteStreamWritePath(uuid.Must(ba.uuidGenerator()), compressor)
 	writeOffset := int64(0)
 	for {
 		if data, err := r.Read(); err == nil {
@@ -165,6 +198,10 @@ func (ba *casBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (d
 	return missingDigests.Build(), nil
 }
 
+func (ba *casBlobAccess) FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+	return blobstore.FindMissingStreamInBatches(ctx, digests, reportMissing, ba.FindMissing)
+}
+
 func (ba *casBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
 	cacheCapabilities, err := getCacheCapabilities(ctx, ba.capabilitiesClient, instanceName)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/testutil"
 	"github.com/google/uuid"
+	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/require"
 
 	"google.golang.org/genproto/googleapis/bytestream"
@@ -29,7 +30,7 @@ func TestCASBlobAccessPut(t *testing.T) {
 
 	client := mock.NewMockClientConnInterface(ctrl)
 	uuidGenerator := mock.NewMockUUIDGenerator(ctrl)
-	blobAccess := grpcclients.NewCASBlobAccess(client, uuidGenerator.Call, 10)
+	blobAccess := grpcclients.NewCASBlobAccess(client, uuidGenerator.Call, 10, remoteexecution.Compressor_IDENTITY, 0)
 
 	blobDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
 	uuid := uuid.Must(uuid.Parse("7d659e5f-0e4b-48f0-ad9f-3489db6e103b"))
@@ -166,7 +167,7 @@ func TestCASBlobAccessGetCapabilities(t *testing.T) {
 
 	client := mock.NewMockClientConnInterface(ctrl)
 	uuidGenerator := mock.NewMockUUIDGenerator(ctrl)
-	blobAccess := grpcclients.NewCASBlobAccess(client, uuidGenerator.Call, 10)
+	blobAccess := grpcclients.NewCASBlobAccess(client, uuidGenerator.Call, 10, remoteexecution.Compressor_IDENTITY, 0)
 
 	t.Run("BackendFailure", func(t *testing.T) {
 		client.EXPECT().Invoke(
@@ -253,3 +254,96 @@ func TestCASBlobAccessGetCapabilities(t *testing.T) {
 		}, serverCapabilities)
 	})
 }
+
+func TestCASBlobAccessCompression(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	client := mock.NewMockClientConnInterface(ctrl)
+	uuidGenerator := mock.NewMockUUIDGenerator(ctrl)
+	blobAccess := grpcclients.NewCASBlobAccess(client, uuidGenerator.Call, 10, remoteexecution.Compressor_ZSTD, 5)
+
+	data := []byte("Hello world, hello world, hello world")
+	blobDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "492125c7ddaf9841e6dd533436c89b68", int64(len(data)))
+	smallDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "1824e8e0307cbfdd1993511ab040075c", 4)
+	uuid := uuid.Must(uuid.Parse("7d659e5f-0e4b-48f0-ad9f-3489db6e103b"))
+
+	encoder, err := zstd.NewWriter(nil)
+	require.NoError(t, err)
+	compressed := encoder.EncodeAll(data, nil)
+	require.NoError(t, encoder.Close())
+
+	t.Run("GetCompressed", func(t *testing.T) {
+		// Blobs at or above the compression threshold should be
+		// downloaded in compressed form.
+		clientStream := mock.NewMockClientStream(ctrl)
+		client.EXPECT().NewStream(gomock.Any(), gomock.Any(), "/google.bytestream.ByteStream/Read").
+			Return(clientStream, nil)
+		clientStream.EXPECT().SendMsg(testutil.EqProto(t, &bytestream.ReadRequest{
+			ResourceName: "hello/compressed-blobs/zstd/492125c7ddaf9841e6dd533436c89b68/37",
+		}))
+		clientStream.EXPECT().CloseSend()
+		clientStream.EXPECT().RecvMsg(gomock.Any()).DoAndReturn(func(m interface{}) error {
+			m.(*bytestream.ReadResponse).Data = compressed
+			return nil
+		})
+		clientStream.EXPECT().RecvMsg(gomock.Any()).Return(io.EOF).AnyTimes()
+
+		b, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, data, b)
+	})
+
+	t.Run("PutCompressed", func(t *testing.T) {
+		// Blobs at or above the compression threshold should be
+		// uploaded in compressed form. Write offsets are based
+		// on the size of the compressed data.
+		clientStream := mock.NewMockClientStream(ctrl)
+		client.EXPECT().NewStream(gomock.Any(), gomock.Any(), "/google.bytestream.ByteStream/Write").
+			Return(clientStream, nil)
+		uuidGenerator.EXPECT().Call().Return(uuid, nil)
+		var uploaded []byte
+		clientStream.EXPECT().SendMsg(gomock.Any()).DoAndReturn(func(m interface{}) error {
+			request := m.(*bytestream.WriteRequest)
+			if len(uploaded) == 0 {
+				require.Equal(t, "hello/uploads/7d659e5f-0e4b-48f0-ad9f-3489db6e103b/compressed-blobs/zstd/492125c7ddaf9841e6dd533436c89b68/37", request.ResourceName)
+			} else {
+				require.Empty(t, request.ResourceName)
+			}
+			require.Equal(t, int64(len(uploaded)), request.WriteOffset)
+			require.LessOrEqual(t, len(request.Data), 10)
+			uploaded = append(uploaded, request.Data...)
+			return nil
+		}).MinTimes(2)
+		clientStream.EXPECT().CloseSend()
+		clientStream.EXPECT().RecvMsg(gomock.Any()).Return(nil)
+
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice(data)))
+
+		decoder, err := zstd.NewReader(nil)
+		require.NoError(t, err)
+		defer decoder.Close()
+		decompressed, err := decoder.DecodeAll(uploaded, nil)
+		require.NoError(t, err)
+		require.Equal(t, data, decompressed)
+	})
+
+	t.Run("PutBelowThreshold", func(t *testing.T) {
+		// Small blobs should be uploaded without compression.
+		clientStream := mock.NewMockClientStream(ctrl)
+		client.EXPECT().NewStream(gomock.Any(), gomock.Any(), "/google.bytestream.ByteStream/Write").
+			Return(clientStream, nil)
+		uuidGenerator.EXPECT().Call().Return(uuid, nil)
+		clientStream.EXPECT().SendMsg(testutil.EqProto(t, &bytestream.WriteRequest{
+			ResourceName: "hello/uploads/7d659e5f-0e4b-48f0-ad9f-3489db6e103b/blobs/1824e8e0307cbfdd1993511ab040075c/4",
+			Data:         []byte("Hell"),
+		}))
+		clientStream.EXPECT().SendMsg(testutil.EqProto(t, &bytestream.WriteRequest{
+			WriteOffset: 4,
+			FinishWrite: true,
+		}))
+		clientStream.EXPECT().CloseSend()
+		clientStream.EXPECT().RecvMsg(gomock.Any()).Return(nil)
+
+		require.NoError(t, blobAccess.Put(ctx, smallDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hell"))))
+	})
+}
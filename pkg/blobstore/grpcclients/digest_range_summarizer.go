@@ -0,0 +1,100 @@
+package grpcclients
+
+import (
+	"context"
+	"crypto/sha256"
+	"io"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	pb "github.com/buildbarn/bb-storage/pkg/proto/digestenumeration"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type digestRangeSummarizer struct {
+	client      pb.DigestEnumerationClient
+	storageType pb.StorageType
+}
+
+// NewDigestRangeSummarizer creates a DigestRangeSummarizer that relays
+// any requests to a gRPC server that implements the
+// digestenumeration.DigestEnumeration service.
+func NewDigestRangeSummarizer(client grpc.ClientConnInterface, storageType pb.StorageType) blobstore.DigestRangeSummarizer {
+	return &digestRangeSummarizer{
+		client:      pb.NewDigestEnumerationClient(client),
+		storageType: storageType,
+	}
+}
+
+func (ds *digestRangeSummarizer) SummarizeDigestRanges(ctx context.Context, instanceNamePrefix digest.InstanceName, rangeBits int) ([]blobstore.DigestRangeSummary, error) {
+	if err := blobstore.ValidateDigestRangeBits(rangeBits); err != nil {
+		return nil, err
+	}
+	response, err := ds.client.SummarizeDigestRanges(ctx, &pb.SummarizeDigestRangesRequest{
+		StorageType:        ds.storageType,
+		InstanceNamePrefix: instanceNamePrefix.String(),
+		RangeBits:          uint32(rangeBits),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Ranges) != 1<<rangeBits {
+		return nil, status.Errorf(codes.Internal, "Server returned %d ranges, while %d were expected", len(response.Ranges), 1<<rangeBits)
+	}
+	summaries := make([]blobstore.DigestRangeSummary, 0, len(response.Ranges))
+	for i, summary := range response.Ranges {
+		if len(summary.Hash) != sha256.Size {
+			return nil, status.Errorf(codes.Internal, "Hash of range %d has length %d, while %d was expected", i, len(summary.Hash), sha256.Size)
+		}
+		s := blobstore.DigestRangeSummary{BlobsCount: summary.BlobsCount}
+		copy(s.Hash[:], summary.Hash)
+		summaries = append(summaries, s)
+	}
+	return summaries, nil
+}
+
+func (ds *digestRangeSummarizer) ListDigests(ctx context.Context, instanceNamePrefix digest.InstanceName, rangeBits int, ranges []uint32, reportDigest func(digest.Digest) error) error {
+	if err := blobstore.ValidateDigestRangeBits(rangeBits); err != nil {
+		return err
+	}
+	ctxWithCancel, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := ds.client.ListDigests(ctxWithCancel, &pb.ListDigestsRequest{
+		StorageType:        ds.storageType,
+		InstanceNamePrefix: instanceNamePrefix.String(),
+		RangeBits:          uint32(rangeBits),
+		Ranges:             ranges,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		for _, blob := range response.Blobs {
+			instanceName, err := digest.NewInstanceName(blob.InstanceName)
+			if err != nil {
+				return util.StatusWrapfWithCode(err, codes.Internal, "Server returned invalid instance name %#v", blob.InstanceName)
+			}
+			digestFunction, err := instanceName.GetDigestFunction(blob.DigestFunction, 0)
+			if err != nil {
+				return util.StatusWrapWithCode(err, codes.Internal, "Server returned invalid digest function")
+			}
+			blobDigest, err := digestFunction.NewDigestFromProto(blob.Digest)
+			if err != nil {
+				return util.StatusWrapWithCode(err, codes.Internal, "Server returned invalid digest")
+			}
+			if err := reportDigest(blobDigest); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -2,6 +2,7 @@ package grpcclients
 
 import (
 	"context"
+	"iter"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
@@ -104,6 +105,10 @@ func (ba *icasBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (
 	return missingDigests.Build(), nil
 }
 
+func (ba *icasBlobAccess) FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+	return blobstore.FindMissingStreamInBatches(ctx, digests, reportMissing, ba.FindMissing)
+}
+
 func (ba *icasBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
 	panic("GetCapabilities() should only be called against BlobAccess instances for the Content Addressable Storage and Action Cache")
 }
@@ -2,6 +2,7 @@ package grpcclients
 
 import (
 	"context"
+	"iter"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
@@ -70,6 +71,10 @@ func (ba *isccBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (
 	return digest.EmptySet, status.Error(codes.Unimplemented, "Initial Size Class Cache does not support bulk existence checking")
 }
 
+func (ba *isccBlobAccess) FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+	return blobstore.FindMissingStreamInBatches(ctx, digests, reportMissing, ba.FindMissing)
+}
+
 func (ba *isccBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
 	panic("GetCapabilities() should only be called against BlobAccess instances for the Content Addressable Storage and Action Cache")
 }
@@ -37,11 +37,24 @@ func (s *byteStreamServer) Read(in *bytestream.ReadRequest, out bytestream.ByteS
 	if err != nil {
 		return err
 	}
-	if compressor != remoteexecution.Compressor_IDENTITY {
-		return status.Error(codes.Unimplemented, "This service does not support downloading compressed files")
-	}
 
-	r := s.blobAccess.Get(out.Context(), digest).ToChunkReader(in.ReadOffset, s.readChunkSize)
+	b := s.blobAccess.Get(out.Context(), digest)
+	var r buffer.ChunkReader
+	if compressor == remoteexecution.Compressor_IDENTITY {
+		r = b.ToChunkReader(in.ReadOffset, s.readChunkSize)
+	} else if in.ReadOffset == 0 {
+		// Let the buffer provide compressed data, so that
+		// storage backends that store data in compressed form
+		// don't need to recompress it.
+		r = b.ToCompressedChunkReader(compressor, s.readChunkSize)
+	} else {
+		// The read offset always refers to the uncompressed
+		// data. Apply compression after the offset has been
+		// taken into account.
+		if r, err = buffer.NewCompressingChunkReader(b.ToChunkReader(in.ReadOffset, s.readChunkSize), compressor, s.readChunkSize); err != nil {
+			return err
+		}
+	}
 	defer r.Close()
 
 	for {
@@ -110,22 +123,38 @@ func (s *byteStreamServer) Write(stream bytestream.ByteStream_WriteServer) error
 	if err != nil {
 		return err
 	}
-	if compressor != remoteexecution.Compressor_IDENTITY {
-		return status.Error(codes.Unimplemented, "This service does not support uploading compressed files")
-	}
 
 	r := &byteStreamWriteServerChunkReader{stream: stream}
 	if err := r.setRequest(request); err != nil {
 		return err
 	}
+	if compressor == remoteexecution.Compressor_IDENTITY {
+		if err := s.blobAccess.Put(
+			stream.Context(),
+			digest,
+			buffer.NewCASBufferFromChunkReader(digest, r, buffer.UserProvided)); err != nil {
+			return err
+		}
+		return stream.SendAndClose(&bytestream.WriteResponse{
+			CommittedSize: digest.GetSizeBytes(),
+		})
+	}
+
+	// For compressed uploads, the write offsets of successive
+	// requests are based on the size of the compressed data. Report
+	// the total amount of compressed data that was received.
+	decompressingReader, err := buffer.NewDecompressingChunkReader(r, compressor, s.readChunkSize)
+	if err != nil {
+		return err
+	}
 	if err := s.blobAccess.Put(
 		stream.Context(),
 		digest,
-		buffer.NewCASBufferFromChunkReader(digest, r, buffer.UserProvided)); err != nil {
+		buffer.NewCASBufferFromChunkReader(digest, decompressingReader, buffer.UserProvided)); err != nil {
 		return err
 	}
 	return stream.SendAndClose(&bytestream.WriteResponse{
-		CommittedSize: digest.GetSizeBytes(),
+		CommittedSize: r.writeOffset,
 	})
 }
 
@@ -1,6 +1,7 @@
 package grpcservers_test
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"net"
@@ -13,6 +14,7 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/blobstore/grpcservers"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/require"
 
 	"google.golang.org/genproto/googleapis/bytestream"
@@ -180,6 +182,83 @@ func TestByteStreamServer(t *testing.T) {
 		require.Equal(t, io.EOF, err)
 	})
 
+	t.Run("ReadSuccessCompressed", func(t *testing.T) {
+		// Attempt to fetch a blob in compressed form. The read
+		// offset applies to the uncompressed data.
+		blobAccess.EXPECT().Get(
+			gomock.Any(),
+			digest.MustNewDigest("ubuntu1804", remoteexecution.DigestFunction_MD5, "da39a3ee5e6b4b0d3255bfef95601890", 19),
+		).Return(buffer.NewValidatedBufferFromByteSlice([]byte("This offset message")))
+
+		req, err := client.Read(ctx, &bytestream.ReadRequest{
+			ResourceName: "ubuntu1804/compressed-blobs/zstd/da39a3ee5e6b4b0d3255bfef95601890/19",
+			ReadOffset:   4,
+		})
+		require.NoError(t, err)
+		var compressed []byte
+		for {
+			readResponse, err := req.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(readResponse.Data), 10)
+			compressed = append(compressed, readResponse.Data...)
+		}
+		decoder, err := zstd.NewReader(nil)
+		require.NoError(t, err)
+		defer decoder.Close()
+		data, err := decoder.DecodeAll(compressed, nil)
+		require.NoError(t, err)
+		require.Equal(t, []byte(" offset message"), data)
+	})
+
+	t.Run("ReadSuccessCompressedPassthrough", func(t *testing.T) {
+		// If the blob is stored in compressed form using the
+		// requested compression algorithm, it should be
+		// returned without being recompressed.
+		encoder, err := zstd.NewWriter(nil)
+		require.NoError(t, err)
+		compressed := encoder.EncodeAll([]byte("This offset message"), nil)
+		messageDigest := digest.MustNewDigest("ubuntu1804", remoteexecution.DigestFunction_MD5, "cc5efe432d43289162c1f1d3e387fed2", 19)
+		blobAccess.EXPECT().Get(gomock.Any(), messageDigest).Return(
+			buffer.NewCASBufferFromCompressedReader(
+				messageDigest,
+				io.NopCloser(bytes.NewReader(compressed)),
+				remoteexecution.Compressor_ZSTD,
+				buffer.UserProvided))
+
+		req, err := client.Read(ctx, &bytestream.ReadRequest{
+			ResourceName: "ubuntu1804/compressed-blobs/zstd/cc5efe432d43289162c1f1d3e387fed2/19",
+		})
+		require.NoError(t, err)
+		var data []byte
+		for {
+			readResponse, err := req.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(readResponse.Data), 10)
+			data = append(data, readResponse.Data...)
+		}
+		require.Equal(t, compressed, data)
+	})
+
+	t.Run("ReadUnsupportedCompressor", func(t *testing.T) {
+		blobAccess.EXPECT().Get(
+			gomock.Any(),
+			digest.MustNewDigest("ubuntu1804", remoteexecution.DigestFunction_MD5, "da39a3ee5e6b4b0d3255bfef95601890", 19),
+		).Return(buffer.NewValidatedBufferFromByteSlice([]byte("This offset message")))
+
+		req, err := client.Read(ctx, &bytestream.ReadRequest{
+			ResourceName: "ubuntu1804/compressed-blobs/brotli/da39a3ee5e6b4b0d3255bfef95601890/19",
+		})
+		require.NoError(t, err)
+		_, err = req.Recv()
+		testutil.RequireEqualStatus(t, status.Error(codes.Unimplemented, "Unsupported compressor BROTLI"), err)
+	})
+
 	t.Run("ReadNonexistentBlob", func(t *testing.T) {
 		// Attempt to fetch a nonexistent blob.
 		blobAccess.EXPECT().Get(
@@ -236,6 +315,65 @@ func TestByteStreamServer(t *testing.T) {
 		require.Equal(t, int64(14), response.CommittedSize)
 	})
 
+	t.Run("WriteSuccessCompressed", func(t *testing.T) {
+		// Attempt to write a blob in compressed form. Write
+		// offsets of successive requests are based on the size
+		// of the compressed data.
+		blobAccess.EXPECT().Put(
+			gomock.Any(),
+			digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "581c1053f832a1c719fb6528a588ccfd", 14),
+			gomock.Any(),
+		).DoAndReturn(func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+			data, err := b.ToByteSlice(100)
+			require.NoError(t, err)
+			require.Equal(t, []byte("LaputanMachine"), data)
+			return nil
+		})
+
+		encoder, err := zstd.NewWriter(nil)
+		require.NoError(t, err)
+		compressed := encoder.EncodeAll([]byte("LaputanMachine"), nil)
+		require.NoError(t, encoder.Close())
+
+		stream, err := client.Write(ctx)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&bytestream.WriteRequest{
+			ResourceName: "uploads/7de747e0-ab6b-4d83-90cb-11989f84c473/compressed-blobs/zstd/581c1053f832a1c719fb6528a588ccfd/14",
+			Data:         compressed[:5],
+		}))
+		require.NoError(t, stream.Send(&bytestream.WriteRequest{
+			Data:        compressed[5:],
+			WriteOffset: 5,
+			FinishWrite: true,
+		}))
+		response, err := stream.CloseAndRecv()
+		require.NoError(t, err)
+		require.Equal(t, int64(len(compressed)), response.CommittedSize)
+	})
+
+	t.Run("WriteCompressedMalformed", func(t *testing.T) {
+		// Uploading data that is not properly compressed should
+		// cause the write to fail.
+		blobAccess.EXPECT().Put(
+			gomock.Any(),
+			digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "581c1053f832a1c719fb6528a588ccfd", 14),
+			gomock.Any(),
+		).DoAndReturn(func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+			_, err := b.ToByteSlice(100)
+			return err
+		})
+
+		stream, err := client.Write(ctx)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&bytestream.WriteRequest{
+			ResourceName: "uploads/7de747e0-ab6b-4d83-90cb-11989f84c473/compressed-blobs/zstd/581c1053f832a1c719fb6528a588ccfd/14",
+			Data:         []byte("LaputanMachine"),
+			FinishWrite:  true,
+		}))
+		_, err = stream.CloseAndRecv()
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
 	t.Run("WriteSuccessWithoutFinish", func(t *testing.T) {
 		// Attempt to write without finishing properly.
 		blobAccess.EXPECT().Put(
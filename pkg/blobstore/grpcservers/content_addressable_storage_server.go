@@ -2,6 +2,7 @@ package grpcservers
 
 import (
 	"context"
+	"slices"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
@@ -48,12 +49,19 @@ func (s *contentAddressableStorageServer) FindMissingBlobs(ctx context.Context,
 		}
 		inDigests.Add(digest)
 	}
-	outDigests, err := s.contentAddressableStorage.FindMissing(ctx, inDigests.Build())
-	if err != nil {
+	outDigests := digest.NewSetBuilder()
+	if err := s.contentAddressableStorage.FindMissingStream(
+		ctx,
+		slices.Values(inDigests.Build().Items()),
+		func(blobDigest digest.Digest) error {
+			outDigests.Add(blobDigest)
+			return nil
+		},
+	); err != nil {
 		return nil, err
 	}
 	partialDigests := make([]*remoteexecution.Digest, 0, outDigests.Length())
-	for _, outDigest := range outDigests.Items() {
+	for _, outDigest := range outDigests.Build().Items() {
 		partialDigests = append(partialDigests, outDigest.GetProto())
 	}
 	return &remoteexecution.FindMissingBlobsResponse{
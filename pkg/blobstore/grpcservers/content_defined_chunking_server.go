@@ -0,0 +1,234 @@
+package grpcservers
+
+import (
+	"context"
+	"io"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/chunking"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/proto/cdc"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// splitBlobFindMissingBatchSize is the maximum number of chunks for
+// which SplitBlob() calls FindMissing() at once, prior to uploading the
+// ones that are absent.
+const splitBlobFindMissingBatchSize = 16
+
+type contentDefinedChunkingServer struct {
+	contentAddressableStorage blobstore.BlobAccess
+	chunker                   chunking.Chunker
+}
+
+// NewContentDefinedChunkingServer creates a gRPC service that is
+// capable of splitting objects stored in the Content Addressable
+// Storage (CAS) into chunks, and splicing chunks back together.
+func NewContentDefinedChunkingServer(contentAddressableStorage blobstore.BlobAccess, chunker chunking.Chunker) cdc.ContentDefinedChunkingServer {
+	return &contentDefinedChunkingServer{
+		contentAddressableStorage: contentAddressableStorage,
+		chunker:                   chunker,
+	}
+}
+
+func getDigestFunctionAndBlobDigest(instanceNameStr string, digestFunctionValue remoteexecution.DigestFunction_Value, blobDigest *remoteexecution.Digest) (digest.Function, digest.Digest, error) {
+	instanceName, err := digest.NewInstanceName(instanceNameStr)
+	if err != nil {
+		return digest.Function{}, digest.BadDigest, util.StatusWrapf(err, "Invalid instance name %#v", instanceNameStr)
+	}
+	digestFunction, err := instanceName.GetDigestFunction(digestFunctionValue, len(blobDigest.GetHash()))
+	if err != nil {
+		return digest.Function{}, digest.BadDigest, err
+	}
+	parsedBlobDigest, err := digestFunction.NewDigestFromProto(blobDigest)
+	if err != nil {
+		return digest.Function{}, digest.BadDigest, util.StatusWrap(err, "Invalid blob digest")
+	}
+	return digestFunction, parsedBlobDigest, nil
+}
+
+// uploadMissingChunks uploads chunks that were obtained by splitting
+// an object into the CAS, skipping the ones that are already present.
+func (s *contentDefinedChunkingServer) uploadMissingChunks(ctx context.Context, chunkDigests []digest.Digest, chunks [][]byte) error {
+	chunkDigestsSet := digest.NewSetBuilder()
+	for _, chunkDigest := range chunkDigests {
+		chunkDigestsSet.Add(chunkDigest)
+	}
+	missing, err := s.contentAddressableStorage.FindMissing(ctx, chunkDigestsSet.Build())
+	if err != nil {
+		return util.StatusWrap(err, "Failed to determine which chunks are missing")
+	}
+	missingChunkDigests := make(map[digest.Digest]struct{}, missing.Length())
+	for _, chunkDigest := range missing.Items() {
+		missingChunkDigests[chunkDigest] = struct{}{}
+	}
+	for i, chunkDigest := range chunkDigests {
+		if _, ok := missingChunkDigests[chunkDigest]; ok {
+			if err := s.contentAddressableStorage.Put(ctx, chunkDigest, buffer.NewValidatedBufferFromByteSlice(chunks[i])); err != nil {
+				return util.StatusWrapf(err, "Failed to store chunk %#v", chunkDigest.String())
+			}
+			// Prevent uploading chunks that occur multiple
+			// times within the same batch more than once.
+			delete(missingChunkDigests, chunkDigest)
+		}
+	}
+	return nil
+}
+
+func (s *contentDefinedChunkingServer) SplitBlob(ctx context.Context, in *cdc.SplitBlobRequest) (*cdc.SplitBlobResponse, error) {
+	digestFunction, blobDigest, err := getDigestFunctionAndBlobDigest(in.InstanceName, in.DigestFunction, in.BlobDigest)
+	if err != nil {
+		return nil, err
+	}
+
+	r := s.contentAddressableStorage.Get(ctx, blobDigest).ToReader()
+	defer r.Close()
+
+	// Split the object into chunks. Store chunks in the CAS in
+	// batches, so that FindMissing() can be used to prevent
+	// redundant uploads.
+	var allChunkDigests []*remoteexecution.Digest
+	var batchChunkDigests []digest.Digest
+	var batchChunks [][]byte
+	if err := s.chunker.Split(r, func(chunk []byte) error {
+		digestGenerator := digestFunction.NewGenerator(int64(len(chunk)))
+		if _, err := digestGenerator.Write(chunk); err != nil {
+			return util.StatusWrap(err, "Failed to compute digest of chunk")
+		}
+		chunkDigest := digestGenerator.Sum()
+		allChunkDigests = append(allChunkDigests, chunkDigest.GetProto())
+		batchChunkDigests = append(batchChunkDigests, chunkDigest)
+		batchChunks = append(batchChunks, append([]byte(nil), chunk...))
+		if len(batchChunkDigests) >= splitBlobFindMissingBatchSize {
+			if err := s.uploadMissingChunks(ctx, batchChunkDigests, batchChunks); err != nil {
+				return err
+			}
+			batchChunkDigests = batchChunkDigests[:0]
+			batchChunks = batchChunks[:0]
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if len(batchChunkDigests) > 0 {
+		if err := s.uploadMissingChunks(ctx, batchChunkDigests, batchChunks); err != nil {
+			return nil, err
+		}
+	}
+
+	return &cdc.SplitBlobResponse{
+		ChunkDigests:   allChunkDigests,
+		DigestFunction: digestFunction.GetEnumValue(),
+	}, nil
+}
+
+func (s *contentDefinedChunkingServer) SpliceBlob(ctx context.Context, in *cdc.SpliceBlobRequest) (*cdc.SpliceBlobResponse, error) {
+	digestFunction, blobDigest, err := getDigestFunctionAndBlobDigest(in.InstanceName, in.DigestFunction, in.BlobDigest)
+	if err != nil {
+		return nil, err
+	}
+
+	chunkDigests := make([]digest.Digest, 0, len(in.ChunkDigests))
+	var totalSizeBytes int64
+	for i, chunkDigest := range in.ChunkDigests {
+		parsedChunkDigest, err := digestFunction.NewDigestFromProto(chunkDigest)
+		if err != nil {
+			return nil, util.StatusWrapf(err, "Invalid digest for chunk at index %d", i)
+		}
+		chunkDigests = append(chunkDigests, parsedChunkDigest)
+		totalSizeBytes += parsedChunkDigest.GetSizeBytes()
+	}
+	if expectedSizeBytes := blobDigest.GetSizeBytes(); totalSizeBytes != expectedSizeBytes {
+		return nil, status.Errorf(codes.InvalidArgument, "Chunks have a total size of %d bytes, while the blob is %d bytes in size", totalSizeBytes, expectedSizeBytes)
+	}
+
+	// Don't splice the object if it is already present.
+	missing, err := s.contentAddressableStorage.FindMissing(ctx, blobDigest.ToSingletonSet())
+	if err != nil {
+		return nil, util.StatusWrap(err, "Failed to determine whether the blob is missing")
+	}
+	if !missing.Empty() {
+		// Concatenate the chunks while uploading. The
+		// resulting object is validated against the digest
+		// provided by the client.
+		if err := s.contentAddressableStorage.Put(
+			ctx,
+			blobDigest,
+			buffer.NewCASBufferFromReader(
+				blobDigest,
+				&spliceReader{
+					ctx:                       ctx,
+					contentAddressableStorage: s.contentAddressableStorage,
+					chunkDigests:              chunkDigests,
+				},
+				buffer.UserProvided)); err != nil {
+			return nil, err
+		}
+	}
+	return &cdc.SpliceBlobResponse{
+		BlobDigest: blobDigest.GetProto(),
+	}, nil
+}
+
+// spliceReader is an io.ReadCloser that returns the concatenated
+// contents of a sequence of objects stored in the CAS. Objects are
+// only loaded when reached.
+type spliceReader struct {
+	ctx                       context.Context
+	contentAddressableStorage blobstore.BlobAccess
+	chunkDigests              []digest.Digest
+	current                   io.ReadCloser
+}
+
+func (r *spliceReader) Read(p []byte) (int, error) {
+	for {
+		if r.current == nil {
+			if len(r.chunkDigests) == 0 {
+				return 0, io.EOF
+			}
+			chunkDigest := r.chunkDigests[0]
+			r.chunkDigests = r.chunkDigests[1:]
+			r.current = &chunkReadErrorWrappingReader{
+				ReadCloser:  r.contentAddressableStorage.Get(r.ctx, chunkDigest).ToReader(),
+				chunkDigest: chunkDigest,
+			}
+		}
+
+		n, err := r.current.Read(p)
+		if err != io.EOF {
+			return n, err
+		}
+		r.current.Close()
+		r.current = nil
+		if n > 0 {
+			return n, nil
+		}
+	}
+}
+
+func (r *spliceReader) Close() error {
+	if r.current != nil {
+		return r.current.Close()
+	}
+	return nil
+}
+
+// chunkReadErrorWrappingReader prefixes errors that occur while
+// reading chunks with the digest of the chunk.
+type chunkReadErrorWrappingReader struct {
+	io.ReadCloser
+	chunkDigest digest.Digest
+}
+
+func (r *chunkReadErrorWrappingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if err != nil && err != io.EOF {
+		err = util.StatusWrapf(err, "Failed to read chunk %#v", r.chunkDigest.String())
+	}
+	return n, err
+}
@@ -0,0 +1,159 @@
+package grpcservers_test
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/chunking"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/grpcservers"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/proto/cdc"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestContentDefinedChunkingServer(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	contentAddressableStorage := mock.NewMockBlobAccess(ctrl)
+	chunker, err := chunking.NewFastCDCChunker(256, 1024, 4096)
+	require.NoError(t, err)
+	server := grpcservers.NewContentDefinedChunkingServer(contentAddressableStorage, chunker)
+
+	data := make([]byte, 100000)
+	rand.New(rand.NewSource(123)).Read(data)
+	digestFunction := digest.MustNewFunction("example", remoteexecution.DigestFunction_SHA256)
+	digestGenerator := digestFunction.NewGenerator(int64(len(data)))
+	digestGenerator.Write(data)
+	blobDigest := digestGenerator.Sum()
+	mustNewDigestFromProto := func(partialDigest *remoteexecution.Digest) digest.Digest {
+		blobDigest, err := digestFunction.NewDigestFromProto(partialDigest)
+		require.NoError(t, err)
+		return blobDigest
+	}
+
+	// Simple in-memory CAS backing the mock.
+	storedBlobs := map[digest.Digest][]byte{}
+	contentAddressableStorage.EXPECT().FindMissing(ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, digests digest.Set) (digest.Set, error) {
+			missing := digest.NewSetBuilder()
+			for _, blobDigest := range digests.Items() {
+				if _, ok := storedBlobs[blobDigest]; !ok {
+					missing.Add(blobDigest)
+				}
+			}
+			return missing.Build(), nil
+		}).AnyTimes()
+	contentAddressableStorage.EXPECT().Get(ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+			if data, ok := storedBlobs[blobDigest]; ok {
+				return buffer.NewCASBufferFromByteSlice(blobDigest, data, buffer.UserProvided)
+			}
+			return buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found"))
+		}).AnyTimes()
+	contentAddressableStorage.EXPECT().Put(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+			data, err := b.ToByteSlice(1 << 20)
+			if err != nil {
+				return err
+			}
+			storedBlobs[blobDigest] = data
+			return nil
+		}).AnyTimes()
+
+	t.Run("SplitNotFound", func(t *testing.T) {
+		_, err := server.SplitBlob(ctx, &cdc.SplitBlobRequest{
+			InstanceName:   "example",
+			BlobDigest:     blobDigest.GetProto(),
+			DigestFunction: remoteexecution.DigestFunction_SHA256,
+		})
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object not found"), err)
+	})
+
+	var chunkDigests []*remoteexecution.Digest
+	t.Run("SplitSuccess", func(t *testing.T) {
+		storedBlobs[blobDigest] = data
+		response, err := server.SplitBlob(ctx, &cdc.SplitBlobRequest{
+			InstanceName:   "example",
+			BlobDigest:     blobDigest.GetProto(),
+			DigestFunction: remoteexecution.DigestFunction_SHA256,
+		})
+		require.NoError(t, err)
+		require.Equal(t, remoteexecution.DigestFunction_SHA256, response.DigestFunction)
+		chunkDigests = response.ChunkDigests
+
+		// All chunks should have been stored, and their
+		// concatenation should yield the original object.
+		var concatenated []byte
+		for _, chunkDigest := range chunkDigests {
+			chunk, ok := storedBlobs[mustNewDigestFromProto(chunkDigest)]
+			require.True(t, ok)
+			concatenated = append(concatenated, chunk...)
+		}
+		require.Equal(t, data, concatenated)
+		delete(storedBlobs, blobDigest)
+	})
+
+	t.Run("SpliceSizeMismatch", func(t *testing.T) {
+		_, err := server.SpliceBlob(ctx, &cdc.SpliceBlobRequest{
+			InstanceName:   "example",
+			BlobDigest:     blobDigest.GetProto(),
+			ChunkDigests:   chunkDigests[1:],
+			DigestFunction: remoteexecution.DigestFunction_SHA256,
+		})
+		testutil.RequirePrefixedStatus(t, status.Error(codes.InvalidArgument, "Chunks have a total size of "), err)
+	})
+
+	t.Run("SpliceChecksumMismatch", func(t *testing.T) {
+		// Swapping chunks keeps the total size intact, but
+		// yields an object with a different checksum. This
+		// should cause the upload to fail.
+		swappedChunkDigests := append([]*remoteexecution.Digest(nil), chunkDigests...)
+		swappedChunkDigests[0], swappedChunkDigests[1] = swappedChunkDigests[1], swappedChunkDigests[0]
+		_, err := server.SpliceBlob(ctx, &cdc.SpliceBlobRequest{
+			InstanceName:   "example",
+			BlobDigest:     blobDigest.GetProto(),
+			ChunkDigests:   swappedChunkDigests,
+			DigestFunction: remoteexecution.DigestFunction_SHA256,
+		})
+		testutil.RequirePrefixedStatus(t, status.Error(codes.InvalidArgument, "Buffer has checksum "), err)
+		_, ok := storedBlobs[blobDigest]
+		require.False(t, ok)
+	})
+
+	t.Run("SpliceMissingChunk", func(t *testing.T) {
+		missingChunkDigest := mustNewDigestFromProto(chunkDigests[2])
+		missingChunk := storedBlobs[missingChunkDigest]
+		delete(storedBlobs, missingChunkDigest)
+		_, err := server.SpliceBlob(ctx, &cdc.SpliceBlobRequest{
+			InstanceName:   "example",
+			BlobDigest:     blobDigest.GetProto(),
+			ChunkDigests:   chunkDigests,
+			DigestFunction: remoteexecution.DigestFunction_SHA256,
+		})
+		testutil.RequireEqualStatus(t, status.Errorf(codes.NotFound, "Failed to read chunk %#v: Object not found", missingChunkDigest.String()), err)
+		storedBlobs[missingChunkDigest] = missingChunk
+	})
+
+	t.Run("SpliceSuccess", func(t *testing.T) {
+		response, err := server.SpliceBlob(ctx, &cdc.SpliceBlobRequest{
+			InstanceName:   "example",
+			BlobDigest:     blobDigest.GetProto(),
+			ChunkDigests:   chunkDigests,
+			DigestFunction: remoteexecution.DigestFunction_SHA256,
+		})
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &cdc.SpliceBlobResponse{BlobDigest: blobDigest.GetProto()}, response)
+		require.True(t, bytes.Equal(data, storedBlobs[blobDigest]))
+	})
+}
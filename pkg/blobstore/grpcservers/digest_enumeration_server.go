@@ -0,0 +1,106 @@
+package grpcservers
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	pb "github.com/buildbarn/bb-storage/pkg/proto/digestenumeration"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The number of digests to return in a single ListDigestsResponse.
+const digestEnumerationServerListBatchSize = 1000
+
+type digestEnumerationServer struct {
+	summarizers map[pb.StorageType]blobstore.DigestRangeSummarizer
+}
+
+// NewDigestEnumerationServer creates a gRPC service for exposing the
+// digests of objects stored in the Content Addressable Storage and the
+// Action Cache. Either of the DigestRangeSummarizers may be nil, in
+// which case requests for that storage type fail.
+func NewDigestEnumerationServer(contentAddressableStorage, actionCache blobstore.DigestRangeSummarizer) pb.DigestEnumerationServer {
+	summarizers := map[pb.StorageType]blobstore.DigestRangeSummarizer{}
+	if contentAddressableStorage != nil {
+		summarizers[pb.StorageType_CONTENT_ADDRESSABLE_STORAGE] = contentAddressableStorage
+	}
+	if actionCache != nil {
+		summarizers[pb.StorageType_ACTION_CACHE] = actionCache
+	}
+	return &digestEnumerationServer{
+		summarizers: summarizers,
+	}
+}
+
+func (s *digestEnumerationServer) getSummarizer(storageType pb.StorageType, instanceNamePrefixStr string, rangeBits uint32) (blobstore.DigestRangeSummarizer, digest.InstanceName, int, error) {
+	summarizer, ok := s.summarizers[storageType]
+	if !ok {
+		return nil, digest.EmptyInstanceName, 0, status.Errorf(codes.Unimplemented, "Storage type %s is not capable of enumerating its contents", storageType)
+	}
+	instanceNamePrefix, err := digest.NewInstanceName(instanceNamePrefixStr)
+	if err != nil {
+		return nil, digest.EmptyInstanceName, 0, util.StatusWrapf(err, "Invalid instance name prefix %#v", instanceNamePrefixStr)
+	}
+	if err := blobstore.ValidateDigestRangeBits(int(rangeBits)); err != nil {
+		return nil, digest.EmptyInstanceName, 0, err
+	}
+	return summarizer, instanceNamePrefix, int(rangeBits), nil
+}
+
+func (s *digestEnumerationServer) SummarizeDigestRanges(ctx context.Context, request *pb.SummarizeDigestRangesRequest) (*pb.SummarizeDigestRangesResponse, error) {
+	summarizer, instanceNamePrefix, rangeBits, err := s.getSummarizer(request.StorageType, request.InstanceNamePrefix, request.RangeBits)
+	if err != nil {
+		return nil, err
+	}
+	summaries, err := summarizer.SummarizeDigestRanges(ctx, instanceNamePrefix, rangeBits)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.SummarizeDigestRangesResponse{
+		Ranges: make([]*pb.DigestRangeSummary, 0, len(summaries)),
+	}
+	for _, summary := range summaries {
+		response.Ranges = append(response.Ranges, &pb.DigestRangeSummary{
+			BlobsCount: summary.BlobsCount,
+			Hash:       summary.Hash[:],
+		})
+	}
+	return response, nil
+}
+
+func (s *digestEnumerationServer) ListDigests(request *pb.ListDigestsRequest, out pb.DigestEnumeration_ListDigestsServer) error {
+	summarizer, instanceNamePrefix, rangeBits, err := s.getSummarizer(request.StorageType, request.InstanceNamePrefix, request.RangeBits)
+	if err != nil {
+		return err
+	}
+	var blobs []*pb.ListDigestsResponse_Blob
+	flush := func() error {
+		if err := out.Send(&pb.ListDigestsResponse{Blobs: blobs}); err != nil {
+			return err
+		}
+		blobs = blobs[:0]
+		return nil
+	}
+	if err := summarizer.ListDigests(out.Context(), instanceNamePrefix, rangeBits, request.Ranges, func(blobDigest digest.Digest) error {
+		digestFunction := blobDigest.GetDigestFunction()
+		blobs = append(blobs, &pb.ListDigestsResponse_Blob{
+			InstanceName:   digestFunction.GetInstanceName().String(),
+			DigestFunction: digestFunction.GetEnumValue(),
+			Digest:         blobDigest.GetProto(),
+		})
+		if len(blobs) < digestEnumerationServerListBatchSize {
+			return nil
+		}
+		return flush()
+	}); err != nil {
+		return err
+	}
+	if len(blobs) > 0 {
+		return flush()
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+package blobstore
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	hedgingBlobAccessPrometheusMetrics sync.Once
+
+	hedgingBlobAccessRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "hedging_blob_access_requests_total",
+			Help:      "Number of Get() requests processed by HedgingBlobAccess, partitioned by the backend whose response was used.",
+		},
+		[]string{"storage_type", "outcome"})
+	hedgingBlobAccessHedgingDelaySeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "hedging_blob_access_hedging_delay_seconds",
+			Help:      "Amount of time HedgingBlobAccess waits for the primary backend before sending a request to the alternate backend.",
+		},
+		[]string{"storage_type"})
+)
+
+const (
+	// hedgingLatencySamplesCount is the number of latencies of the
+	// primary backend that are retained to compute the hedging delay.
+	hedgingLatencySamplesCount = 1000
+	// hedgingLatencySamplesPerUpdate controls how often the hedging
+	// delay is recomputed. This prevents requests from having to
+	// sort all samples.
+	hedgingLatencySamplesPerUpdate = 100
+)
+
+type hedgingBlobAccess struct {
+	BlobAccess
+	alternate         BlobAccess
+	readBufferFactory ReadBufferFactory
+	clock             clock.Clock
+	latencyPercentile float64
+	maximumSizeBytes  int64
+
+	lock                 sync.Mutex
+	latencySamples       []time.Duration
+	nextLatencySample    int
+	samplesSinceUpdate   int
+	hedgingDelay         time.Duration
+	hedgingDelayComputed bool
+
+	requestsUnhedged       prometheus.Counter
+	requestsPrimary        prometheus.Counter
+	requestsAlternate      prometheus.Counter
+	requestsHedgedFailures prometheus.Counter
+	hedgingDelaySeconds    prometheus.Gauge
+}
+
+// NewHedgingBlobAccess creates a decorator for BlobAccess that reduces
+// tail latency of Get() by sending a second request to an alternate
+// backend if the primary backend does not respond in time. The delay
+// after which the alternate backend is contacted is based on a
+// percentile of the latencies of the primary backend that were
+// observed recently. The response that arrives first is returned.
+//
+// Hedging requires objects to be loaded into memory in their entirety.
+// Objects larger than the provided maximum size are always read from
+// the primary backend, without hedging. All other operations are
+// forwarded to the primary backend.
+func NewHedgingBlobAccess(primary, alternate BlobAccess, readBufferFactory ReadBufferFactory, clock clock.Clock, latencyPercentile float64, maximumSizeBytes int64, storageType string) BlobAccess {
+	hedgingBlobAccessPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(hedgingBlobAccessRequests)
+		prometheus.MustRegister(hedgingBlobAccessHedgingDelaySeconds)
+	})
+
+	return &hedgingBlobAccess{
+		BlobAccess:        primary,
+		alternate:         alternate,
+		readBufferFactory: readBufferFactory,
+		clock:             clock,
+		latencyPercentile: latencyPercentile,
+		maximumSizeBytes:  maximumSizeBytes,
+
+		latencySamples: make([]time.Duration, 0, hedgingLatencySamplesCount),
+
+		requestsUnhedged:       hedgingBlobAccessRequests.WithLabelValues(storageType, "Unhedged"),
+		requestsPrimary:        hedgingBlobAccessRequests.WithLabelValues(storageType, "HedgedPrimary"),
+		requestsAlternate:      hedgingBlobAccessRequests.WithLabelValues(storageType, "HedgedAlternate"),
+		requestsHedgedFailures: hedgingBlobAccessRequests.WithLabelValues(storageType, "HedgedFailure"),
+		hedgingDelaySeconds:    hedgingBlobAccessHedgingDelaySeconds.WithLabelValues(storageType),
+	}
+}
+
+// getHedgingDelay returns the amount of time to wait for the primary
+// backend before sending a request to the alternate backend. No
+// hedging is performed until enough latencies have been observed.
+func (ba *hedgingBlobAccess) getHedgingDelay() (time.Duration, bool) {
+	ba.lock.Lock()
+	defer ba.lock.Unlock()
+	return ba.hedgingDelay, ba.hedgingDelayComputed
+}
+
+// recordLatency stores the latency of a single request against the
+// primary backend, periodically recomputing the hedging delay.
+func (ba *hedgingBlobAccess) recordLatency(latency time.Duration) {
+	ba.lock.Lock()
+	defer ba.lock.Unlock()
+	if len(ba.latencySamples) < hedgingLatencySamplesCount {
+		ba.latencySamples = append(ba.latencySamples, latency)
+	} else {
+		ba.latencySamples[ba.nextLatencySample] = latency
+		ba.nextLatencySample = (ba.nextLatencySample + 1) % hedgingLatencySamplesCount
+	}
+
+	ba.samplesSinceUpdate++
+	if ba.samplesSinceUpdate >= hedgingLatencySamplesPerUpdate {
+		ba.samplesSinceUpdate = 0
+		sortedSamples := slices.Clone(ba.latencySamples)
+		slices.Sort(sortedSamples)
+		ba.hedgingDelay = sortedSamples[int(ba.latencyPercentile*float64(len(sortedSamples)-1))]
+		ba.hedgingDelayComputed = true
+		ba.hedgingDelaySeconds.Set(ba.hedgingDelay.Seconds())
+	}
+}
+
+type hedgingResult struct {
+	data      []byte
+	err       error
+	alternate bool
+}
+
+func (ba *hedgingBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	if blobDigest.GetSizeBytes() > ba.maximumSizeBytes {
+		ba.requestsUnhedged.Inc()
+		return ba.BlobAccess.Get(ctx, blobDigest)
+	}
+
+	ctxWithCancel, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make(chan hedgingResult, 2)
+	launch := func(backend BlobAccess, alternate bool) {
+		go func() {
+			data, err := backend.Get(ctxWithCancel, blobDigest).ToByteSlice(int(ba.maximumSizeBytes))
+			results <- hedgingResult{
+				data:      data,
+				err:       err,
+				alternate: alternate,
+			}
+		}()
+	}
+
+	startTime := ba.clock.Now()
+	launch(ba.BlobAccess, false)
+	var hedgingTimerChannel <-chan time.Time
+	if hedgingDelay, ok := ba.getHedgingDelay(); ok {
+		var hedgingTimer clock.Timer
+		hedgingTimer, hedgingTimerChannel = ba.clock.NewTimer(hedgingDelay)
+		defer hedgingTimer.Stop()
+	}
+
+	hedged, primaryCompleted := false, false
+	outstandingRequests := 1
+	var firstErr error
+	for {
+		select {
+		case <-hedgingTimerChannel:
+			// The primary backend did not respond in time.
+			hedgingTimerChannel = nil
+			hedged = true
+			outstandingRequests++
+			launch(ba.alternate, true)
+		case result := <-results:
+			outstandingRequests--
+			if !result.alternate {
+				primaryCompleted = true
+				ba.recordLatency(ba.clock.Now().Sub(startTime))
+			}
+			if result.err == nil {
+				if !hedged {
+					ba.requestsUnhedged.Inc()
+				} else if result.alternate {
+					// The latency of the primary backend
+					// is at least as high as the time
+					// spent until now.
+					if !primaryCompleted {
+						ba.recordLatency(ba.clock.Now().Sub(startTime))
+					}
+					ba.requestsAlternate.Inc()
+				} else {
+					ba.requestsPrimary.Inc()
+				}
+				return ba.readBufferFactory.NewBufferFromByteSlice(blobDigest, result.data, buffer.Irreparable(blobDigest))
+			}
+
+			if result.alternate {
+				result.err = util.StatusWrap(result.err, "Alternate backend")
+			}
+			if firstErr == nil {
+				firstErr = result.err
+			}
+			if outstandingRequests == 0 {
+				// Errors are not a reason to send a
+				// request to the alternate backend.
+				// Retrying is left to RetryingBlobAccess.
+				if hedged {
+					ba.requestsHedgedFailures.Inc()
+				} else {
+					ba.requestsUnhedged.Inc()
+				}
+				return buffer.NewBufferFromError(firstErr)
+			}
+		}
+	}
+}
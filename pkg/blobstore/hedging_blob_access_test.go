@@ -0,0 +1,121 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestHedgingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	primary := mock.NewMockBlobAccess(ctrl)
+	alternate := mock.NewMockBlobAccess(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	now := time.Unix(1000, 0)
+	clock.EXPECT().Now().DoAndReturn(func() time.Time { return now }).AnyTimes()
+	blobAccess := blobstore.NewHedgingBlobAccess(
+		primary,
+		alternate,
+		blobstore.CASReadBufferFactory,
+		clock,
+		/* latencyPercentile = */ 0.9,
+		/* maximumSizeBytes = */ 100,
+		"cas")
+
+	helloDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	largeDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "3e25960a79dbc69b674cd4ec67a72c62", 1000)
+
+	t.Run("LargeObject", func(t *testing.T) {
+		// Objects that exceed the maximum size should be read
+		// from the primary backend without hedging.
+		primary.EXPECT().Get(ctx, largeDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+
+		_, err := blobAccess.Get(ctx, largeDigest).ToByteSlice(1000)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object not found"), err)
+	})
+
+	t.Run("Warmup", func(t *testing.T) {
+		// No hedging should take place until enough latencies
+		// of the primary backend have been observed. Use
+		// latencies between 1 and 100 milliseconds, so that the
+		// 90th percentile is 90 milliseconds.
+		for i := 1; i <= 100; i++ {
+			primary.EXPECT().Get(gomock.Any(), helloDigest).DoAndReturn(
+				func(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+					now = now.Add(time.Duration(i) * time.Millisecond)
+					return buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))
+				})
+
+			data, err := blobAccess.Get(ctx, helloDigest).ToByteSlice(100)
+			require.NoError(t, err)
+			require.Equal(t, []byte("Hello"), data)
+		}
+	})
+
+	t.Run("PrimaryFastEnough", func(t *testing.T) {
+		timer := mock.NewMockTimer(ctrl)
+		clock.EXPECT().NewTimer(90*time.Millisecond).Return(timer, nil)
+		primary.EXPECT().Get(gomock.Any(), helloDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+		timer.EXPECT().Stop().Return(true)
+
+		data, err := blobAccess.Get(ctx, helloDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("PrimaryFailure", func(t *testing.T) {
+		// Errors returned by the primary backend should not
+		// cause the request to be sent to the alternate backend.
+		timer := mock.NewMockTimer(ctrl)
+		clock.EXPECT().NewTimer(90*time.Millisecond).Return(timer, nil)
+		primary.EXPECT().Get(gomock.Any(), helloDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.Unavailable, "Server offline")))
+		timer.EXPECT().Stop().Return(true)
+
+		_, err := blobAccess.Get(ctx, helloDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server offline"), err)
+	})
+
+	t.Run("AlternateWins", func(t *testing.T) {
+		// The primary backend is slow to respond, causing the
+		// request to be sent to the alternate backend. The
+		// request against the primary backend should be
+		// canceled once the alternate backend responds.
+		timer := mock.NewMockTimer(ctrl)
+		timerChan := make(chan time.Time, 1)
+		timerChan <- time.Unix(1100, 0)
+		clock.EXPECT().NewTimer(90*time.Millisecond).Return(timer, timerChan)
+		primaryCanceled := make(chan struct{})
+		primary.EXPECT().Get(gomock.Any(), helloDigest).DoAndReturn(
+			func(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+				<-ctx.Done()
+				close(primaryCanceled)
+				return buffer.NewBufferFromError(util.StatusFromContext(ctx))
+			})
+		alternate.EXPECT().Get(gomock.Any(), helloDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+		timer.EXPECT().Stop().Return(false)
+
+		data, err := blobAccess.Get(ctx, helloDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+		<-primaryCanceled
+	})
+}
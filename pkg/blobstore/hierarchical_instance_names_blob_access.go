@@ -2,6 +2,7 @@ package blobstore
 
 import (
 	"context"
+	"iter"
 
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
@@ -132,6 +133,10 @@ func (ba *hierarchicalInstanceNamesBlobAccess) FindMissing(ctx context.Context,
 	return finallyMissing.Build(), nil
 }
 
+func (ba *hierarchicalInstanceNamesBlobAccess) FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+	return FindMissingStreamInBatches(ctx, digests, reportMissing, ba.FindMissing)
+}
+
 type hierarchicalInstanceNamesGetErrorHandler struct {
 	blobAccess BlobAccess
 	context    context.Context
@@ -0,0 +1,56 @@
+package local
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+)
+
+type admissionControllingBlobAccess struct {
+	blobstore.BlobAccess
+	admissionPolicies []AdmissionPolicy
+}
+
+// NewAdmissionControllingBlobAccess creates a decorator for BlobAccess
+// that only forwards writes of objects that are admitted by all of the
+// provided admission policies. Policies are evaluated in order, and
+// evaluation stops at the first policy that rejects the object.
+//
+// Writes of objects that are rejected are discarded silently, meaning
+// that they are reported as being absent afterwards. This decorator
+// should therefore only be used for backends that act as a cache.
+func NewAdmissionControllingBlobAccess(base blobstore.BlobAccess, admissionPolicies []AdmissionPolicy) blobstore.BlobAccess {
+	return &admissionControllingBlobAccess{
+		BlobAccess:        base,
+		admissionPolicies: admissionPolicies,
+	}
+}
+
+func (ba *admissionControllingBlobAccess) recordAccess(blobDigest digest.Digest) {
+	for _, admissionPolicy := range ba.admissionPolicies {
+		admissionPolicy.RecordAccess(blobDigest)
+	}
+}
+
+func (ba *admissionControllingBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	ba.recordAccess(blobDigest)
+	return ba.BlobAccess.Get(ctx, blobDigest)
+}
+
+func (ba *admissionControllingBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	ba.recordAccess(parentDigest)
+	return ba.BlobAccess.GetFromComposite(ctx, parentDigest, childDigest, slicer)
+}
+
+func (ba *admissionControllingBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	for _, admissionPolicy := range ba.admissionPolicies {
+		if !admissionPolicy.ShouldAdmit(blobDigest) {
+			b.Discard()
+			return nil
+		}
+	}
+	return ba.BlobAccess.Put(ctx, blobDigest, b)
+}
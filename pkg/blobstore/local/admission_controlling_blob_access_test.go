@@ -0,0 +1,63 @@
+package local_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestAdmissionControllingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	admissionPolicy1 := mock.NewMockAdmissionPolicy(ctrl)
+	admissionPolicy2 := mock.NewMockAdmissionPolicy(ctrl)
+	blobAccess := local.NewAdmissionControllingBlobAccess(baseBlobAccess, []local.AdmissionPolicy{admissionPolicy1, admissionPolicy2})
+
+	helloDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	t.Run("Get", func(t *testing.T) {
+		// Reads should be recorded by all policies.
+		admissionPolicy1.EXPECT().RecordAccess(helloDigest)
+		admissionPolicy2.EXPECT().RecordAccess(helloDigest)
+		baseBlobAccess.EXPECT().Get(ctx, helloDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := blobAccess.Get(ctx, helloDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("PutRejected", func(t *testing.T) {
+		// If the first policy rejects the object, the second
+		// policy should not be consulted. The object should be
+		// discarded without returning an error.
+		admissionPolicy1.EXPECT().ShouldAdmit(helloDigest).Return(false)
+		r := mock.NewMockReadCloser(ctrl)
+		r.EXPECT().Close()
+
+		require.NoError(t, blobAccess.Put(ctx, helloDigest, buffer.NewCASBufferFromReader(helloDigest, r, buffer.UserProvided)))
+	})
+
+	t.Run("PutAdmitted", func(t *testing.T) {
+		admissionPolicy1.EXPECT().ShouldAdmit(helloDigest).Return(true)
+		admissionPolicy2.EXPECT().ShouldAdmit(helloDigest).Return(true)
+		baseBlobAccess.EXPECT().Put(ctx, helloDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(100)
+				require.NoError(t, err)
+				require.Equal(t, []byte("Hello"), data)
+				return nil
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, helloDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+}
@@ -0,0 +1,67 @@
+package local
+
+import (
+	"sync"
+
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	admissionPolicyPrometheusMetrics sync.Once
+
+	admissionPolicyRejectedBlobs = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "admission_policy_rejected_blobs_total",
+			Help:      "Number of objects that were not stored, because they were rejected by an admission policy",
+		},
+		[]string{"storage_type", "policy"})
+	admissionPolicyRejectedBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "admission_policy_rejected_bytes_total",
+			Help:      "Total size of objects that were not stored, because they were rejected by an admission policy, in bytes",
+		},
+		[]string{"storage_type", "policy"})
+)
+
+// AdmissionPolicy decides whether objects written into storage should
+// actually be stored. It may be used to prevent objects that are
+// unlikely to be accessed again from displacing more valuable ones.
+type AdmissionPolicy interface {
+	// RecordAccess is invoked whenever an object is requested, so
+	// that policies may track how frequently objects are accessed.
+	RecordAccess(blobDigest digest.Digest)
+
+	// ShouldAdmit is invoked whenever an object is written. It
+	// returns whether the object should be stored.
+	ShouldAdmit(blobDigest digest.Digest) bool
+}
+
+// admissionPolicyMetrics contains the Prometheus metrics that are
+// updated by AdmissionPolicy implementations when rejecting objects.
+type admissionPolicyMetrics struct {
+	rejectedBlobs prometheus.Counter
+	rejectedBytes prometheus.Counter
+}
+
+func newAdmissionPolicyMetrics(storageType, policy string) admissionPolicyMetrics {
+	admissionPolicyPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(admissionPolicyRejectedBlobs)
+		prometheus.MustRegister(admissionPolicyRejectedBytes)
+	})
+
+	return admissionPolicyMetrics{
+		rejectedBlobs: admissionPolicyRejectedBlobs.WithLabelValues(storageType, policy),
+		rejectedBytes: admissionPolicyRejectedBytes.WithLabelValues(storageType, policy),
+	}
+}
+
+func (m *admissionPolicyMetrics) reject(blobDigest digest.Digest) bool {
+	m.rejectedBlobs.Inc()
+	m.rejectedBytes.Add(float64(blobDigest.GetSizeBytes()))
+	return false
+}
@@ -0,0 +1,148 @@
+package local
+
+import (
+	"bytes"
+	"io"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/klauspost/compress/zstd"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BlobCompressor is used by flatBlobAccess to convert the contents of
+// blobs to the form in which they are stored inside blocks. This makes
+// it possible to store blobs in compressed form.
+//
+// Implementations of BlobCompressor must ensure that the stored size of
+// a blob is only equal to the size in the digest if the blob is stored
+// as is. The ReadBufferFactory used by the underlying blocks relies on
+// this to determine whether a blob needs to be decompressed.
+type BlobCompressor interface {
+	// Compress the contents of a blob that is about to be stored.
+	// This function returns a buffer containing the data to be
+	// written, and its size.
+	Compress(blobDigest digest.Digest, b buffer.Buffer) (buffer.Buffer, int64, error)
+
+	// CloneForRefresh creates two buffers for a blob that is about
+	// to be copied to another location in storage. The first buffer
+	// yields the contents of the blob, while the second buffer
+	// yields the data to be written. The provided buffer needs to
+	// be obtained through LocationBlobGetter.
+	CloneForRefresh(blobDigest digest.Digest, b buffer.Buffer, storedSizeBytes int64) (buffer.Buffer, buffer.Buffer)
+
+	// IsStoredAsIs returns whether a blob having a given stored
+	// size is stored as is. If so, ranges of the stored data
+	// correspond to ranges of the blob's contents.
+	IsStoredAsIs(blobDigest digest.Digest, storedSizeBytes int64) bool
+}
+
+type identityBlobCompressor struct{}
+
+func (identityBlobCompressor) Compress(blobDigest digest.Digest, b buffer.Buffer) (buffer.Buffer, int64, error) {
+	sizeBytes, err := b.GetSizeBytes()
+	if err != nil {
+		b.Discard()
+		return nil, 0, err
+	}
+	return b, sizeBytes, nil
+}
+
+func (identityBlobCompressor) CloneForRefresh(blobDigest digest.Digest, b buffer.Buffer, storedSizeBytes int64) (buffer.Buffer, buffer.Buffer) {
+	return b.CloneStream()
+}
+
+func (identityBlobCompressor) IsStoredAsIs(blobDigest digest.Digest, storedSizeBytes int64) bool {
+	return true
+}
+
+// IdentityBlobCompressor is an implementation of BlobCompressor that
+// stores all blobs as is.
+var IdentityBlobCompressor BlobCompressor = identityBlobCompressor{}
+
+type zstdBlobCompressor struct {
+	encoder          *zstd.Encoder
+	maximumSizeBytes int64
+}
+
+// NewZstdBlobCompressor creates a BlobCompressor that stores blobs in
+// Zstandard compressed form. As compression is performed in memory,
+// only blobs up to a given size are compressed. Blobs that are larger,
+// or that don't become smaller when compressed, are stored as is.
+//
+// Blobs stored in Zstandard compressed form can be read back by using
+// blobstore.NewCompressedCASReadBufferFactory().
+func NewZstdBlobCompressor(maximumSizeBytes int64) (BlobCompressor, error) {
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		return nil, util.StatusWrapWithCode(err, codes.Internal, "Failed to create Zstandard encoder")
+	}
+	return &zstdBlobCompressor{
+		encoder:          encoder,
+		maximumSizeBytes: maximumSizeBytes,
+	}, nil
+}
+
+func (bc *zstdBlobCompressor) Compress(blobDigest digest.Digest, b buffer.Buffer) (buffer.Buffer, int64, error) {
+	sizeBytes, err := b.GetSizeBytes()
+	if err != nil {
+		b.Discard()
+		return nil, 0, err
+	}
+	if sizeBytes > bc.maximumSizeBytes {
+		return b, sizeBytes, nil
+	}
+
+	data, err := b.ToByteSlice(int(sizeBytes))
+	if err != nil {
+		return nil, 0, err
+	}
+	compressed := bc.encoder.EncodeAll(data, make([]byte, 0, len(data)))
+	if len(compressed) >= len(data) {
+		// Compression didn't yield any savings. Store the
+		// blob as is, as the stored size would otherwise be
+		// ambiguous.
+		return buffer.NewValidatedBufferFromByteSlice(data), sizeBytes, nil
+	}
+	return buffer.NewValidatedBufferFromByteSlice(compressed), int64(len(compressed)), nil
+}
+
+func (bc *zstdBlobCompressor) CloneForRefresh(blobDigest digest.Digest, b buffer.Buffer, storedSizeBytes int64) (buffer.Buffer, buffer.Buffer) {
+	if bc.IsStoredAsIs(blobDigest, storedSizeBytes) {
+		return b.CloneStream()
+	}
+
+	// Extract the compressed data from the buffer. This causes
+	// the data to be validated, without requiring it to be
+	// recompressed.
+	r := b.ToCompressedChunkReader(remoteexecution.Compressor_ZSTD, int(storedSizeBytes))
+	defer r.Close()
+	compressed := make([]byte, 0, storedSizeBytes)
+	for {
+		chunk, err := r.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return buffer.NewBufferFromError(err), buffer.NewBufferFromError(err)
+		}
+		compressed = append(compressed, chunk...)
+	}
+	if int64(len(compressed)) != storedSizeBytes {
+		err := status.Errorf(codes.Internal, "Compressed blob is %d bytes in size, while %d bytes were expected", len(compressed), storedSizeBytes)
+		return buffer.NewBufferFromError(err), buffer.NewBufferFromError(err)
+	}
+	return buffer.NewCASBufferFromCompressedReader(
+			blobDigest,
+			io.NopCloser(bytes.NewReader(compressed)),
+			remoteexecution.Compressor_ZSTD,
+			buffer.BackendProvided(buffer.Irreparable(blobDigest))),
+		buffer.NewValidatedBufferFromByteSlice(compressed)
+}
+
+func (bc *zstdBlobCompressor) IsStoredAsIs(blobDigest digest.Digest, storedSizeBytes int64) bool {
+	return storedSizeBytes == blobDigest.GetSizeBytes()
+}
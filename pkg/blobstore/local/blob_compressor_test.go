@@ -0,0 +1,87 @@
+package local_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestZstdBlobCompressor(t *testing.T) {
+	blobCompressor, err := local.NewZstdBlobCompressor(100000)
+	require.NoError(t, err)
+
+	helloDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+	original := bytes.Repeat([]byte("Hello world, "), 1000)
+	originalDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA256, "d2c6e29a9856db7ea156f125021ffc985bbd91c38ebaa65f2e91bc73d0ff491f", int64(len(original)))
+
+	t.Run("CompressIncompressible", func(t *testing.T) {
+		// Small blobs don't become smaller when compressed.
+		// They should be stored as is.
+		b, sizeBytes, err := blobCompressor.Compress(helloDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+		require.NoError(t, err)
+		require.Equal(t, int64(5), sizeBytes)
+		data, err := b.ToByteSlice(10)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+		require.True(t, blobCompressor.IsStoredAsIs(helloDigest, sizeBytes))
+	})
+
+	t.Run("CompressTooBig", func(t *testing.T) {
+		// Blobs exceeding the maximum size should be stored as
+		// is, as they would otherwise need to be loaded into
+		// memory.
+		smallBlobCompressor, err := local.NewZstdBlobCompressor(1000)
+		require.NoError(t, err)
+
+		b, sizeBytes, err := smallBlobCompressor.Compress(originalDigest, buffer.NewValidatedBufferFromByteSlice(original))
+		require.NoError(t, err)
+		require.Equal(t, int64(len(original)), sizeBytes)
+		data, err := b.ToByteSlice(len(original))
+		require.NoError(t, err)
+		require.Equal(t, original, data)
+	})
+
+	t.Run("CompressChecksumFailure", func(t *testing.T) {
+		// Blobs must be validated prior to storing them.
+		_, _, err := blobCompressor.Compress(helloDigest, buffer.NewCASBufferFromByteSlice(helloDigest, []byte("Hallo"), buffer.UserProvided))
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Buffer has checksum 753692ec36adb4c794c973945eb2a99c1649703ea6f76bf259abb4fb838e013e, while 185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969 was expected"), err)
+	})
+
+	t.Run("CompressAndRefresh", func(t *testing.T) {
+		// Compressible blobs should be stored in compressed
+		// form. When refreshed, the compressed data should be
+		// copied as is.
+		b, sizeBytes, err := blobCompressor.Compress(originalDigest, buffer.NewValidatedBufferFromByteSlice(original))
+		require.NoError(t, err)
+		require.Less(t, sizeBytes, int64(len(original)))
+		require.False(t, blobCompressor.IsStoredAsIs(originalDigest, sizeBytes))
+		compressed, err := b.ToByteSlice(int(sizeBytes))
+		require.NoError(t, err)
+		require.Len(t, compressed, int(sizeBytes))
+
+		bReturned, bStored := blobCompressor.CloneForRefresh(
+			originalDigest,
+			buffer.NewCASBufferFromCompressedReader(
+				originalDigest,
+				io.NopCloser(bytes.NewReader(compressed)),
+				remoteexecution.Compressor_ZSTD,
+				buffer.UserProvided),
+			sizeBytes)
+		data, err := bReturned.ToByteSlice(len(original))
+		require.NoError(t, err)
+		require.Equal(t, original, data)
+		data, err = bStored.ToByteSlice(int(sizeBytes))
+		require.NoError(t, err)
+		require.Equal(t, compressed, data)
+	})
+}
@@ -25,6 +25,10 @@ type BlockPutFinalizer func() (int64, error)
 // by Get() must remain valid, even if Release() is called.
 type Block interface {
 	Get(digest digest.Digest, offsetBytes, sizeBytes int64, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer
+	// GetRaw returns the data stored at a given offset as is,
+	// without performing any validation or decompression. This can
+	// be used to recover the digest of a blob from its contents.
+	GetRaw(offsetBytes, sizeBytes int64) buffer.Buffer
 	HasSpace(sizeBytes int64) bool
 	Put(sizeBytes int64) BlockPutWriter
 	Release()
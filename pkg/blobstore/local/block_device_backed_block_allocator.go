@@ -193,21 +193,34 @@ func (pb *blockDeviceBackedBlock) Get(digest digest.Digest, offsetBytes, sizeByt
 	if c := pb.usecount.Add(1); c <= 1 {
 		panic(fmt.Sprintf("Get(): Block has invalid reference count %d", c))
 	}
-	pb.blockAllocator.blockAllocatorGetsStarted.Inc()
-
 	return pb.blockAllocator.readBufferFactory.NewBufferFromReaderAt(
 		digest,
-		&blockDeviceBackedBlockReader{
-			SectionReader: *io.NewSectionReader(
-				pb.blockAllocator.blockDevice,
-				pb.deviceOffsetSectors*int64(pb.blockAllocator.sectorSizeBytes)+offsetBytes,
-				sizeBytes),
-			block: pb,
-		},
+		pb.newReader(offsetBytes, sizeBytes),
 		sizeBytes,
 		dataIntegrityCallback)
 }
 
+func (pb *blockDeviceBackedBlock) GetRaw(offsetBytes, sizeBytes int64) buffer.Buffer {
+	if c := pb.usecount.Add(1); c <= 1 {
+		panic(fmt.Sprintf("GetRaw(): Block has invalid reference count %d", c))
+	}
+	return buffer.NewValidatedBufferFromReaderAt(pb.newReader(offsetBytes, sizeBytes), sizeBytes)
+}
+
+// newReader creates a reader for data stored in the block. The caller
+// must have incremented the use count of the block, which is dropped
+// when the reader is closed.
+func (pb *blockDeviceBackedBlock) newReader(offsetBytes, sizeBytes int64) *blockDeviceBackedBlockReader {
+	pb.blockAllocator.blockAllocatorGetsStarted.Inc()
+	return &blockDeviceBackedBlockReader{
+		SectionReader: *io.NewSectionReader(
+			pb.blockAllocator.blockDevice,
+			pb.deviceOffsetSectors*int64(pb.blockAllocator.sectorSizeBytes)+offsetBytes,
+			sizeBytes),
+		block: pb,
+	}
+}
+
 func (pb *blockDeviceBackedBlock) HasSpace(sizeBytes int64) bool {
 	pa := pb.blockAllocator
 	remainingSizeBytes := (pa.blockSectorCount - pb.writeOffsetSectors) * int64(pa.sectorSizeBytes)
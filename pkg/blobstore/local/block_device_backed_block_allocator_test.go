@@ -135,6 +135,17 @@ func TestBlockDeviceBackedBlockAllocator(t *testing.T) {
 	offsetBytes, err = blocks[7].Put(5)(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))()
 	require.NoError(t, err)
 	require.Equal(t, int64(17), offsetBytes)
+
+	// GetRaw() should return data as is, without validating it
+	// against a digest.
+	blockDevice.EXPECT().ReadAt(gomock.Any(), int64(717)).DoAndReturn(
+		func(p []byte, off int64) (int, error) {
+			copy(p, "Hello")
+			return 5, nil
+		})
+	data, err = blocks[7].GetRaw(17, 5).ToByteSlice(100)
+	require.NoError(t, err)
+	require.Equal(t, []byte("Hello"), data)
 }
 
 // Assume the underlying block device has an actual sector size. All
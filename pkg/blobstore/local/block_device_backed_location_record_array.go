@@ -50,15 +50,14 @@ func computeChecksumForRecord(record *[BlockDeviceBackedLocationRecordSize]byte,
 	return h
 }
 
-func (lra *blockDeviceBackedLocationRecordArray) Get(index int) (LocationRecord, error) {
-	var record [BlockDeviceBackedLocationRecordSize]byte
-	if _, err := lra.device.ReadAt(record[:], int64(index)*BlockDeviceBackedLocationRecordSize); err != nil {
-		return LocationRecord{}, err
-	}
-
+// deserializeLocationRecord converts a LocationRecord stored in
+// serialized form back to its original form. It returns
+// ErrLocationRecordInvalid if the record refers to a block that is no
+// longer present, or if its checksum is invalid.
+func deserializeLocationRecord(record *[BlockDeviceBackedLocationRecordSize]byte, resolver BlockReferenceResolver) (LocationRecord, error) {
 	// Reobtain the index of the block in the BlockList. This may
 	// fail if the entry refers to a block that is no longer there.
-	blockIndex, hashSeed, found := lra.resolver.BlockReferenceToBlockIndex(BlockReference{
+	blockIndex, hashSeed, found := resolver.BlockReferenceToBlockIndex(BlockReference{
 		EpochID:        binary.LittleEndian.Uint32(record[:]),
 		BlocksFromLast: binary.LittleEndian.Uint16(record[4:]),
 	})
@@ -70,7 +69,7 @@ func (lra *blockDeviceBackedLocationRecordArray) Get(index int) (LocationRecord,
 	// match up with what's expected. Such records may have either
 	// been corrupted or correspond to blobs that weren't flushed
 	// before shutdown.
-	if computeChecksumForRecord(&record, hashSeed) != binary.LittleEndian.Uint64(record[4+2+sha256.Size+4+8+8:]) {
+	if computeChecksumForRecord(record, hashSeed) != binary.LittleEndian.Uint64(record[4+2+sha256.Size+4+8+8:]) {
 		return LocationRecord{}, ErrLocationRecordInvalid
 	}
 
@@ -89,19 +88,41 @@ func (lra *blockDeviceBackedLocationRecordArray) Get(index int) (LocationRecord,
 	return l, nil
 }
 
+// serializeLocationRecord converts a LocationRecord to serialized form,
+// so that it can be stored on disk.
+func serializeLocationRecord(record *[BlockDeviceBackedLocationRecordSize]byte, blockReference BlockReference, hashSeed uint64, recordKey LocationRecordKey, offsetBytes, sizeBytes int64) {
+	binary.LittleEndian.PutUint32(record[:], blockReference.EpochID)
+	binary.LittleEndian.PutUint16(record[4:], blockReference.BlocksFromLast)
+	copy(record[4+2:], recordKey.Key[:])
+	binary.LittleEndian.PutUint32(record[4+2+sha256.Size:], recordKey.Attempt)
+	binary.LittleEndian.PutUint64(record[4+2+sha256.Size+4:], uint64(offsetBytes))
+	binary.LittleEndian.PutUint64(record[4+2+sha256.Size+4+8:], uint64(sizeBytes))
+	binary.LittleEndian.PutUint64(record[4+2+sha256.Size+4+8+8:], computeChecksumForRecord(record, hashSeed))
+}
+
+func (lra *blockDeviceBackedLocationRecordArray) Get(index int) (LocationRecord, error) {
+	var record [BlockDeviceBackedLocationRecordSize]byte
+	if _, err := lra.device.ReadAt(record[:], int64(index)*BlockDeviceBackedLocationRecordSize); err != nil {
+		return LocationRecord{}, err
+	}
+	return deserializeLocationRecord(&record, lra.resolver)
+}
+
 func (lra *blockDeviceBackedLocationRecordArray) Put(index int, locationRecord LocationRecord) error {
 	blockReference, hashSeed := lra.resolver.BlockIndexToBlockReference(locationRecord.Location.BlockIndex)
 
 	// Serialize the LocationRecord ready to be written to disk.
 	var record [BlockDeviceBackedLocationRecordSize]byte
-	binary.LittleEndian.PutUint32(record[:], blockReference.EpochID)
-	binary.LittleEndian.PutUint16(record[4:], blockReference.BlocksFromLast)
-	copy(record[4+2:], locationRecord.RecordKey.Key[:])
-	binary.LittleEndian.PutUint32(record[4+2+sha256.Size:], locationRecord.RecordKey.Attempt)
-	binary.LittleEndian.PutUint64(record[4+2+sha256.Size+4:], uint64(locationRecord.Location.OffsetBytes))
-	binary.LittleEndian.PutUint64(record[4+2+sha256.Size+4+8:], uint64(locationRecord.Location.SizeBytes))
-	binary.LittleEndian.PutUint64(record[4+2+sha256.Size+4+8+8:], computeChecksumForRecord(&record, hashSeed))
+	serializeLocationRecord(&record, blockReference, hashSeed, locationRecord.RecordKey, locationRecord.Location.OffsetBytes, locationRecord.Location.SizeBytes)
+
+	_, err := lra.device.WriteAt(record[:], int64(index)*BlockDeviceBackedLocationRecordSize)
+	return err
+}
 
+func (lra *blockDeviceBackedLocationRecordArray) Clear(index int) error {
+	// An all-zero record is what a block device contains initially.
+	// Its checksum only matches for a hash seed of zero.
+	var record [BlockDeviceBackedLocationRecordSize]byte
 	_, err := lra.device.WriteAt(record[:], int64(index)*BlockDeviceBackedLocationRecordSize)
 	return err
 }
@@ -148,3 +148,18 @@ func TestBlockDeviceBackedLocationRecordArrayPut(t *testing.T) {
 			lra.Put(100, exampleBlockDeviceBackedLocationRecord))
 	})
 }
+
+func TestBlockDeviceBackedLocationRecordArrayClear(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	blockDevice := mock.NewMockBlockDevice(ctrl)
+	blockIndexResolver := mock.NewMockBlockReferenceResolver(ctrl)
+	lra := local.NewBlockDeviceBackedLocationRecordArray(blockDevice, blockIndexResolver)
+
+	// Clearing a record should cause it to be overwritten with
+	// zeroes, as if it was never written.
+	blockDevice.EXPECT().WriteAt(make([]byte, local.BlockDeviceBackedLocationRecordSize), int64(6600)).
+		Return(local.BlockDeviceBackedLocationRecordSize, nil)
+
+	require.NoError(t, lra.Clear(100))
+}
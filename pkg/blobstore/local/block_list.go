@@ -44,6 +44,9 @@ type BlockList interface {
 
 	// Get a blob from a given block in the BlockList.
 	Get(blockIndex int, digest digest.Digest, offsetBytes, sizeBytes int64, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer
+	// GetRaw obtains data from a given block in the BlockList as
+	// is, without performing any validation or decompression.
+	GetRaw(blockIndex int, offsetBytes, sizeBytes int64) buffer.Buffer
 
 	// HasSpace returns whether a given block in the BlockList is
 	// capable of storing an additional blob of a given size.
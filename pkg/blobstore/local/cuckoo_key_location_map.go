@@ -0,0 +1,229 @@
+package local
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	cuckooKeyLocationMapPrometheusMetrics sync.Once
+
+	cuckooKeyLocationMapPutIterations = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "cuckoo_key_location_map_put_iterations",
+			Help:      "Number of iterations it took for Put()",
+			Buckets:   prometheus.ExponentialBuckets(1.0, 2.0, 8),
+		},
+		[]string{"storage_type", "outcome"})
+)
+
+type cuckooKeyLocationMap struct {
+	recordArray          LocationRecordArray
+	bucketsCount         int
+	bucketSize           int
+	hashInitialization   uint64
+	maximumPutIterations int
+
+	putInserted          prometheus.Observer
+	putUpdated           prometheus.Observer
+	putIgnoredOlder      prometheus.Observer
+	putTooOld            prometheus.Observer
+	putTooManyIterations prometheus.Observer
+	putDiscarded         prometheus.Counter
+}
+
+// NewCuckooKeyLocationMap creates a KeyLocationMap backed by a hash
+// table that uses bucketized cuckoo hashing. Every key may be stored in
+// any of the slots of two buckets. This permits the hash table to
+// reach a considerably higher fill ratio than HashingKeyLocationMap
+// before records need to be discarded, while also bounding the number
+// of slots that Get() needs to inspect to twice the bucket size.
+//
+// The bucket in which a record is stored is identified by the Attempt
+// field of its LocationRecordKey, being either zero or one. This means
+// that records can be stored in the same LocationRecordArrays as used
+// by HashingKeyLocationMap.
+//
+// When both buckets of a key are full, the record pointing to the
+// oldest location in these buckets is displaced and moved into its
+// alternative bucket, potentially displacing another record. Records
+// are only discarded if no free slot is found after a bounded number
+// of displacements, which is unlikely to happen until the hash table is
+// almost full. Records for which all candidate slots contain records
+// pointing to newer locations are discarded immediately.
+//
+// Like HashingKeyLocationMap, this implementation is self-cleaning, as
+// records for no longer existent locations are treated as free slots.
+//
+// The LocationRecordArray needs to provide bucketsCount * bucketSize
+// records. As slot computation uses modulo arithmetic, it is
+// recommended to let bucketsCount be prime.
+func NewCuckooKeyLocationMap(recordArray LocationRecordArray, bucketsCount, bucketSize int, hashInitialization uint64, maximumPutIterations int, storageType string) KeyLocationMap {
+	cuckooKeyLocationMapPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(cuckooKeyLocationMapPutIterations)
+	})
+
+	return &cuckooKeyLocationMap{
+		recordArray:          recordArray,
+		bucketsCount:         bucketsCount,
+		bucketSize:           bucketSize,
+		hashInitialization:   hashInitialization,
+		maximumPutIterations: maximumPutIterations,
+
+		putInserted:          cuckooKeyLocationMapPutIterations.WithLabelValues(storageType, "Inserted"),
+		putUpdated:           cuckooKeyLocationMapPutIterations.WithLabelValues(storageType, "Updated"),
+		putIgnoredOlder:      cuckooKeyLocationMapPutIterations.WithLabelValues(storageType, "IgnoredOlder"),
+		putTooOld:            cuckooKeyLocationMapPutIterations.WithLabelValues(storageType, "TooOld"),
+		putTooManyIterations: cuckooKeyLocationMapPutIterations.WithLabelValues(storageType, "TooManyIterations"),
+		putDiscarded:         newKeyLocationMapDiscardedRecordsCounter(storageType, "Cuckoo"),
+	}
+}
+
+// getFirstSlot returns the index of the first slot of the bucket in
+// which a record may be stored. As the hashes of keys only differing in
+// their Attempt field are strongly correlated, the hash is mixed prior
+// to computing the bucket. Without it, the two buckets in which a key
+// may be stored would only be a few fixed distances apart.
+func (klm *cuckooKeyLocationMap) getFirstSlot(k *LocationRecordKey) int {
+	h := k.Hash(klm.hashInitialization)
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return int(h%uint64(klm.bucketsCount)) * klm.bucketSize
+}
+
+func (klm *cuckooKeyLocationMap) Get(key Key) (Location, error) {
+	recordKey := LocationRecordKey{Key: key}
+	for ; recordKey.Attempt < 2; recordKey.Attempt++ {
+		firstSlot := klm.getFirstSlot(&recordKey)
+		for slot := firstSlot; slot < firstSlot+klm.bucketSize; slot++ {
+			record, err := klm.recordArray.Get(slot)
+			if err == ErrLocationRecordInvalid {
+				// Unlike HashingKeyLocationMap, records
+				// are not stored in a well-defined
+				// order, meaning we must continue
+				// searching.
+				continue
+			} else if err != nil {
+				return Location{}, err
+			}
+			if record.RecordKey == recordKey {
+				return record.Location, nil
+			}
+		}
+	}
+	return Location{}, status.Error(codes.NotFound, "Object not found")
+}
+
+func (klm *cuckooKeyLocationMap) Put(key Key, location Location) error {
+	// Scan both buckets for an existing record for the same key.
+	// While doing that, keep track of the first slot that is free,
+	// and the slot containing the record pointing to the oldest
+	// location.
+	record := LocationRecord{
+		RecordKey: LocationRecordKey{Key: key},
+		Location:  location,
+	}
+	freeSlot, freeAttempt := -1, uint32(0)
+	oldestSlot, oldestAttempt := -1, uint32(0)
+	var oldestRecord LocationRecord
+	for attempt := uint32(0); attempt < 2; attempt++ {
+		record.RecordKey.Attempt = attempt
+		firstSlot := klm.getFirstSlot(&record.RecordKey)
+		for slot := firstSlot; slot < firstSlot+klm.bucketSize; slot++ {
+			oldRecord, err := klm.recordArray.Get(slot)
+			if err == ErrLocationRecordInvalid {
+				if freeSlot < 0 {
+					freeSlot, freeAttempt = slot, attempt
+				}
+				continue
+			} else if err != nil {
+				return err
+			}
+			if oldRecord.RecordKey == record.RecordKey {
+				// Only allow overwriting an entry if it
+				// points to a newer version of the same
+				// blob.
+				if oldRecord.Location.IsOlder(record.Location) {
+					if err := klm.recordArray.Put(slot, record); err != nil {
+						return err
+					}
+					klm.putUpdated.Observe(1)
+					return nil
+				}
+				klm.putIgnoredOlder.Observe(1)
+				return nil
+			}
+			if oldestSlot < 0 || oldRecord.Location.IsOlder(oldestRecord.Location) {
+				oldestSlot, oldestAttempt, oldestRecord = slot, attempt, oldRecord
+			}
+		}
+	}
+
+	if freeSlot >= 0 {
+		// One of the buckets has a free slot.
+		record.RecordKey.Attempt = freeAttempt
+		if err := klm.recordArray.Put(freeSlot, record); err != nil {
+			return err
+		}
+		klm.putInserted.Observe(1)
+		return nil
+	}
+
+	// Both buckets are full. If the record to insert points to a
+	// location that is older than all records in these buckets,
+	// discard it. This may happen when reinserting records.
+	if !oldestRecord.Location.IsOlder(record.Location) {
+		klm.putTooOld.Observe(1)
+		klm.putDiscarded.Inc()
+		return nil
+	}
+
+	// Displace the record pointing to the oldest location, and
+	// attempt to move it into its alternative bucket. If that
+	// bucket is full as well, displace one of its records and
+	// repeat. Records are displaced in a pseudo-random, but
+	// deterministic order to prevent cycles.
+	victimSlot, attempt, victim := oldestSlot, oldestAttempt, oldestRecord
+	for iteration := 1; ; iteration++ {
+		record.RecordKey.Attempt = attempt
+		if err := klm.recordArray.Put(victimSlot, record); err != nil {
+			return err
+		}
+		if iteration >= klm.maximumPutIterations {
+			klm.putTooManyIterations.Observe(float64(iteration))
+			klm.putDiscarded.Inc()
+			return nil
+		}
+
+		// Scan the alternative bucket of the displaced record.
+		record = victim
+		attempt = record.RecordKey.Attempt ^ 1
+		record.RecordKey.Attempt = attempt
+		firstSlot := klm.getFirstSlot(&record.RecordKey)
+		victimSlot = firstSlot + int(record.RecordKey.Key[iteration%len(record.RecordKey.Key)])%klm.bucketSize
+		for candidateSlot := firstSlot; candidateSlot < firstSlot+klm.bucketSize; candidateSlot++ {
+			oldRecord, err := klm.recordArray.Get(candidateSlot)
+			if err == ErrLocationRecordInvalid {
+				if err := klm.recordArray.Put(candidateSlot, record); err != nil {
+					return err
+				}
+				klm.putInserted.Observe(float64(iteration + 1))
+				return nil
+			} else if err != nil {
+				return err
+			}
+			if candidateSlot == victimSlot {
+				victim = oldRecord
+			}
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package local_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+// newCuckooKeyLocationMapTestArray creates an in-memory
+// LocationRecordArray for which all blocks are valid.
+func newCuckooKeyLocationMapTestArray(ctrl *gomock.Controller, recordsCount int) local.LocationRecordArray {
+	blockReferenceResolver := mock.NewMockBlockReferenceResolver(ctrl)
+	blockReferenceResolver.EXPECT().BlockIndexToBlockReference(gomock.Any()).
+		DoAndReturn(func(blockIndex int) (local.BlockReference, uint64) {
+			return local.BlockReference{
+				EpochID:        1,
+				BlocksFromLast: uint16(blockIndex),
+			}, 0x1d12c2a6c1d29d69
+		}).
+		AnyTimes()
+	blockReferenceResolver.EXPECT().BlockReferenceToBlockIndex(gomock.Any()).
+		DoAndReturn(func(blockReference local.BlockReference) (int, uint64, bool) {
+			if blockReference.EpochID == 0 {
+				return 0, 0, false
+			}
+			return int(blockReference.BlocksFromLast), 0x1d12c2a6c1d29d69, true
+		}).
+		AnyTimes()
+	return local.NewInMemoryLocationRecordArray(recordsCount, blockReferenceResolver)
+}
+
+func getCuckooKeyLocationMapTestKey(i int) local.Key {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], uint64(i))
+	return local.Key(sha256.Sum256(b[:]))
+}
+
+func TestCuckooKeyLocationMapGetPut(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	array := newCuckooKeyLocationMapTestArray(ctrl, 13*4)
+	klm := local.NewCuckooKeyLocationMap(array, 13, 4, 0x970aef1f90c7f916, 16, "cas")
+
+	key := getCuckooKeyLocationMapTestKey(1)
+	oldLocation := local.Location{
+		BlockIndex:  14,
+		OffsetBytes: 859,
+		SizeBytes:   12930,
+	}
+	newLocation := local.Location{
+		BlockIndex:  17,
+		OffsetBytes: 864,
+		SizeBytes:   12,
+	}
+
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := klm.Get(key)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object not found"), err)
+	})
+
+	t.Run("Insertion", func(t *testing.T) {
+		require.NoError(t, klm.Put(key, oldLocation))
+		location, err := klm.Get(key)
+		require.NoError(t, err)
+		require.Equal(t, oldLocation, location)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		// Newer locations of the same object should replace
+		// existing ones.
+		require.NoError(t, klm.Put(key, newLocation))
+		location, err := klm.Get(key)
+		require.NoError(t, err)
+		require.Equal(t, newLocation, location)
+	})
+
+	t.Run("IgnoredOlder", func(t *testing.T) {
+		// Older locations should be ignored.
+		require.NoError(t, klm.Put(key, oldLocation))
+		location, err := klm.Get(key)
+		require.NoError(t, err)
+		require.Equal(t, newLocation, location)
+	})
+}
+
+func TestCuckooKeyLocationMapFull(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// Create a hash table consisting of a single bucket with two
+	// slots, and fill it up.
+	array := newCuckooKeyLocationMapTestArray(ctrl, 2)
+	klm := local.NewCuckooKeyLocationMap(array, 1, 2, 0x970aef1f90c7f916, 1, "cas")
+
+	key1 := getCuckooKeyLocationMapTestKey(1)
+	location1 := local.Location{BlockIndex: 10, OffsetBytes: 100, SizeBytes: 5}
+	require.NoError(t, klm.Put(key1, location1))
+	key2 := getCuckooKeyLocationMapTestKey(2)
+	location2 := local.Location{BlockIndex: 10, OffsetBytes: 200, SizeBytes: 5}
+	require.NoError(t, klm.Put(key2, location2))
+
+	t.Run("TooOld", func(t *testing.T) {
+		// Inserting a record that is older than all records
+		// in its buckets should cause it to be discarded.
+		key3 := getCuckooKeyLocationMapTestKey(3)
+		require.NoError(t, klm.Put(key3, local.Location{BlockIndex: 9, OffsetBytes: 100, SizeBytes: 5}))
+
+		_, err := klm.Get(key3)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object not found"), err)
+	})
+
+	t.Run("TooManyIterations", func(t *testing.T) {
+		// Inserting a newer record should cause the record
+		// pointing to the oldest location to be displaced. As
+		// there is no space to relocate it, it gets discarded.
+		key4 := getCuckooKeyLocationMapTestKey(4)
+		location4 := local.Location{BlockIndex: 11, OffsetBytes: 100, SizeBytes: 5}
+		require.NoError(t, klm.Put(key4, location4))
+
+		_, err := klm.Get(key1)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object not found"), err)
+		location, err := klm.Get(key2)
+		require.NoError(t, err)
+		require.Equal(t, location2, location)
+		location, err = klm.Get(key4)
+		require.NoError(t, err)
+		require.Equal(t, location4, location)
+	})
+}
+
+func TestCuckooKeyLocationMapHighLoad(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// Cuckoo hashing with buckets of four slots should permit
+	// filling the hash table almost entirely, without any records
+	// being lost.
+	const bucketsCount, bucketSize = 1009, 4
+	array := newCuckooKeyLocationMapTestArray(ctrl, bucketsCount*bucketSize)
+	klm := local.NewCuckooKeyLocationMap(array, bucketsCount, bucketSize, 0x970aef1f90c7f916, 64, "cas")
+
+	recordsCount := bucketsCount * bucketSize * 85 / 100
+	for i := 0; i < recordsCount; i++ {
+		require.NoError(t, klm.Put(getCuckooKeyLocationMapTestKey(i), local.Location{
+			BlockIndex:  1,
+			OffsetBytes: int64(i),
+			SizeBytes:   1,
+		}))
+	}
+	for i := 0; i < recordsCount; i++ {
+		location, err := klm.Get(getCuckooKeyLocationMapTestKey(i))
+		require.NoError(t, err)
+		require.Equal(t, int64(i), location.OffsetBytes)
+	}
+}
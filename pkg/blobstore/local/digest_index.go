@@ -0,0 +1,416 @@
+package local
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	digestIndexSegmentPrefix = "digests."
+
+	// The number of keys for which liveness is checked while
+	// holding the lock of the key-location map.
+	digestIndexCompactionBatchSize = 1000
+)
+
+var componentDigestIndexCompacting = path.MustNewComponent("digests.compacting")
+
+// DigestIndex keeps track of the keys of objects written into a
+// KeyLocationMap, so that the digests of objects stored can be
+// enumerated. This is needed for storage backends such as the Action
+// Cache, where the digests of objects cannot be derived from their
+// contents.
+//
+// Keys are appended to segment files named "digests.${n}" that are
+// stored in a directory. As the KeyLocationMap discards records over
+// time, segments contain keys of objects that are no longer present.
+// Compaction rewrites all segments into a single one, only retaining
+// keys that are still present in the KeyLocationMap. Compaction
+// occurs whenever digests are enumerated, and in the background once
+// the number of keys appended exceeds the number of keys retained by
+// the previous compaction.
+//
+// Keys are appended before objects are written, while compaction
+// treats keys of objects that are still being written as being
+// present. This ensures that the index is never missing objects,
+// regardless of when a crash occurs.
+type DigestIndex struct {
+	directory       filesystem.Directory
+	keyLocationMap  KeyLocationMap
+	lock            *sync.RWMutex
+	digestKeyFormat digest.KeyFormat
+	errorLogger     util.ErrorLogger
+
+	appendLock                 sync.Mutex
+	appender                   filesystem.FileAppender
+	currentSegment             uint64
+	appendedKeysCount          int
+	compactionThreshold        int
+	minimumCompactionThreshold int
+	pendingKeys                map[string]int
+	compactionWakeup           chan struct{}
+
+	compactionLock sync.Mutex
+}
+
+// NewDigestIndex creates a DigestIndex that stores its segments in a
+// provided directory. Existing segments are retained, so that objects
+// stored in a persistent KeyLocationMap may still be enumerated after
+// a restart.
+//
+// Background compaction only occurs once at least
+// minimumCompactionThreshold keys have been appended since the last
+// compaction.
+func NewDigestIndex(directory filesystem.Directory, keyLocationMap KeyLocationMap, lock *sync.RWMutex, digestKeyFormat digest.KeyFormat, errorLogger util.ErrorLogger, minimumCompactionThreshold int) (*DigestIndex, error) {
+	di := &DigestIndex{
+		directory:       directory,
+		keyLocationMap:  keyLocationMap,
+		lock:            lock,
+		digestKeyFormat: digestKeyFormat,
+		errorLogger:     errorLogger,
+
+		compactionThreshold:        minimumCompactionThreshold,
+		minimumCompactionThreshold: minimumCompactionThreshold,
+		pendingKeys:                map[string]int{},
+		compactionWakeup:           make(chan struct{}, 1),
+	}
+
+	// Start appending to a new segment, so that we never append
+	// keys to segments that may have been truncated by a crash.
+	segments, err := di.listSegments()
+	if err != nil {
+		return nil, err
+	}
+	var lastSegment uint64
+	if len(segments) > 0 {
+		lastSegment = segments[len(segments)-1]
+	}
+	if err := di.openSegmentLocked(lastSegment + 1); err != nil {
+		return nil, err
+	}
+	return di, nil
+}
+
+func getDigestIndexSegmentComponent(segment uint64) path.Component {
+	return path.MustNewComponent(digestIndexSegmentPrefix + strconv.FormatUint(segment, 10))
+}
+
+// listSegments returns the numbers of all segments stored in the
+// directory in ascending order.
+func (di *DigestIndex) listSegments() ([]uint64, error) {
+	entries, err := di.directory.ReadDir()
+	if err != nil {
+		return nil, util.StatusWrapWithCode(err, codes.Internal, "Failed to read digest index directory")
+	}
+	var segments []uint64
+	for _, entry := range entries {
+		if suffix, ok := strings.CutPrefix(entry.Name().String(), digestIndexSegmentPrefix); ok {
+			if segment, err := strconv.ParseUint(suffix, 10, 64); err == nil {
+				segments = append(segments, segment)
+			}
+		}
+	}
+	sort.Slice(segments, func(i, j int) bool { return segments[i] < segments[j] })
+	return segments, nil
+}
+
+// openSegmentLocked opens a new segment to which keys are appended,
+// and closes the segment to which keys were appended previously. This
+// method must be called while holding the append lock.
+func (di *DigestIndex) openSegmentLocked(segment uint64) error {
+	appender, err := di.directory.OpenAppend(getDigestIndexSegmentComponent(segment), filesystem.CreateExcl(0o666))
+	if err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to create digest index segment")
+	}
+	oldAppender := di.appender
+	di.appender = appender
+	di.currentSegment = segment
+	if oldAppender != nil {
+		if err := oldAppender.Sync(); err != nil {
+			oldAppender.Close()
+			return util.StatusWrapWithCode(err, codes.Internal, "Failed to synchronize digest index segment")
+		}
+		if err := oldAppender.Close(); err != nil {
+			return util.StatusWrapWithCode(err, codes.Internal, "Failed to close digest index segment")
+		}
+	}
+	return nil
+}
+
+// addPendingKey appends the key of an object that is about to be
+// written to the current segment. The key is treated as being present
+// during compaction until removePendingKey() is called.
+func (di *DigestIndex) addPendingKey(key string) error {
+	di.appendLock.Lock()
+	defer di.appendLock.Unlock()
+
+	if _, err := di.appender.Write([]byte(key + "\n")); err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to append to digest index segment")
+	}
+	di.pendingKeys[key]++
+	di.appendedKeysCount++
+	if di.appendedKeysCount >= di.compactionThreshold {
+		select {
+		case di.compactionWakeup <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+func (di *DigestIndex) removePendingKey(key string) {
+	di.appendLock.Lock()
+	defer di.appendLock.Unlock()
+
+	if di.pendingKeys[key] == 1 {
+		delete(di.pendingKeys, key)
+	} else {
+		di.pendingKeys[key]--
+	}
+}
+
+// Sync the segment to which keys are currently being appended to
+// disk. This method may be called prior to writing persistent state,
+// so that all objects referenced by the persistent state are also
+// present in the index.
+func (di *DigestIndex) Sync() error {
+	di.appendLock.Lock()
+	defer di.appendLock.Unlock()
+
+	if err := di.appender.Sync(); err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to synchronize digest index segment")
+	}
+	return nil
+}
+
+// filterPresentKeys removes keys from a list for which no objects are
+// present in the KeyLocationMap, and which are also not in the process
+// of being written.
+func (di *DigestIndex) filterPresentKeys(keys []string) ([]string, error) {
+	di.appendLock.Lock()
+	pending := make([]bool, len(keys))
+	for i, key := range keys {
+		_, pending[i] = di.pendingKeys[key]
+	}
+	di.appendLock.Unlock()
+
+	presentKeys := keys[:0]
+	di.lock.RLock()
+	defer di.lock.RUnlock()
+	for i, key := range keys {
+		if !pending[i] {
+			if _, err := di.keyLocationMap.Get(NewKeyFromString(key)); err != nil {
+				if status.Code(err) == codes.NotFound {
+					continue
+				}
+				return nil, util.StatusWrapf(err, "Failed to look up key %#v", key)
+			}
+		}
+		presentKeys = append(presentKeys, key)
+	}
+	return presentKeys, nil
+}
+
+// compact all segments that are no longer being appended to into a
+// single segment. Each of the keys that is retained is passed to a
+// callback.
+func (di *DigestIndex) compact(ctx context.Context, reportKey func(key string) error) error {
+	di.compactionLock.Lock()
+	defer di.compactionLock.Unlock()
+
+	// Let new keys be appended to a new segment, so that the
+	// existing segments can be compacted without interruption.
+	di.appendLock.Lock()
+	lastSegment := di.currentSegment
+	if err := di.openSegmentLocked(lastSegment + 1); err != nil {
+		di.appendLock.Unlock()
+		return err
+	}
+	di.appendedKeysCount = 0
+	di.appendLock.Unlock()
+
+	allSegments, err := di.listSegments()
+	if err != nil {
+		return err
+	}
+	segments := allSegments[:0]
+	for _, segment := range allSegments {
+		if segment <= lastSegment {
+			segments = append(segments, segment)
+		}
+	}
+
+	// Write all keys that are still present into a new file that
+	// replaces the last segment. As it's fine for segments to
+	// contain duplicate keys, it's safe to crash before the other
+	// segments are removed.
+	seenKeys := map[Key]struct{}{}
+	if err := filesystem.WriteFileAtomically(di.directory, getDigestIndexSegmentComponent(lastSegment), componentDigestIndexCompacting, func(w io.Writer) error {
+		bw := bufio.NewWriter(w)
+		keys := make([]string, 0, digestIndexCompactionBatchSize)
+		flushKeys := func() error {
+			presentKeys, err := di.filterPresentKeys(keys)
+			if err != nil {
+				return err
+			}
+			for _, key := range presentKeys {
+				if _, err := bw.WriteString(key + "\n"); err != nil {
+					return err
+				}
+				if err := reportKey(key); err != nil {
+					return err
+				}
+			}
+			keys = keys[:0]
+			return nil
+		}
+		for _, segment := range segments {
+			if err := util.StatusFromContext(ctx); err != nil {
+				return err
+			}
+			if err := di.readSegment(segment, func(key string) error {
+				k := NewKeyFromString(key)
+				if _, ok := seenKeys[k]; ok {
+					return nil
+				}
+				seenKeys[k] = struct{}{}
+				keys = append(keys, key)
+				if len(keys) < digestIndexCompactionBatchSize {
+					return nil
+				}
+				return flushKeys()
+			}); err != nil {
+				return err
+			}
+		}
+		if err := flushKeys(); err != nil {
+			return err
+		}
+		return bw.Flush()
+	}); err != nil {
+		return util.StatusWrap(err, "Failed to write compacted digest index segment")
+	}
+
+	for _, segment := range segments {
+		if segment != lastSegment {
+			if err := di.directory.Remove(getDigestIndexSegmentComponent(segment)); err != nil {
+				return util.StatusWrapWithCode(err, codes.Internal, "Failed to remove compacted digest index segment")
+			}
+		}
+	}
+
+	// Only compact in the background once the number of keys
+	// appended exceeds the number of keys retained.
+	di.appendLock.Lock()
+	di.compactionThreshold = max(len(seenKeys), di.minimumCompactionThreshold)
+	di.appendLock.Unlock()
+	return nil
+}
+
+// readSegment calls into a callback for every key stored in a
+// segment. Lines that are not terminated, which may be the case if a
+// crash occurred while keys were being appended, are ignored.
+func (di *DigestIndex) readSegment(segment uint64, reportKey func(key string) error) error {
+	f, err := di.directory.OpenRead(getDigestIndexSegmentComponent(segment))
+	if err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to open digest index segment")
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(io.NewSectionReader(f, 0, math.MaxInt64))
+	for {
+		line, err := r.ReadString('\n')
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return util.StatusWrapWithCode(err, codes.Internal, "Failed to read digest index segment")
+		}
+		if key := line[:len(line)-1]; key != "" {
+			if err := reportKey(key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// ProcessCompaction waits until a sufficient number of keys have been
+// appended to the index, and compacts it. This function returns false
+// if the provided context is cancelled.
+func (di *DigestIndex) ProcessCompaction(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-di.compactionWakeup:
+	}
+	if err := di.compact(ctx, func(key string) error { return nil }); err != nil {
+		di.errorLogger.Log(util.StatusWrap(err, "Failed to compact digest index"))
+	}
+	return true
+}
+
+// EnumerateDigests compacts the index, and reports the digests of all
+// objects that are present. Objects written while enumeration takes
+// place may not be reported.
+func (di *DigestIndex) EnumerateDigests(ctx context.Context, instanceNamePrefix digest.InstanceName, reportDigest func(digest.Digest) error) error {
+	// If the keys of objects don't contain an instance name, the
+	// objects are not associated with any instance name. Report
+	// them as if they are stored under the prefix.
+	instanceNamePrefixTrie := digest.NewInstanceNameTrie()
+	instanceNamePrefixTrie.Set(instanceNamePrefix, 0)
+	return di.compact(ctx, func(key string) error {
+		blobDigest, err := instanceNamePrefix.NewDigestFromKey(key, di.digestKeyFormat)
+		if err != nil {
+			// Keys are not validated when appended. Skip
+			// keys that cannot be parsed.
+			return nil
+		}
+		if instanceNamePrefixTrie.ContainsPrefix(blobDigest.GetInstanceName()) {
+			return reportDigest(blobDigest)
+		}
+		return nil
+	})
+}
+
+type digestIndexingBlobAccess struct {
+	blobstore.BlobAccess
+	digestIndex *DigestIndex
+}
+
+// NewDigestIndexingBlobAccess creates a decorator for BlobAccess that
+// adds the keys of all objects that are written to a DigestIndex.
+func NewDigestIndexingBlobAccess(base blobstore.BlobAccess, digestIndex *DigestIndex) blobstore.BlobAccess {
+	return &digestIndexingBlobAccess{
+		BlobAccess:  base,
+		digestIndex: digestIndex,
+	}
+}
+
+func (ba *digestIndexingBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	key := blobDigest.GetKey(ba.digestIndex.digestKeyFormat)
+	if strings.ContainsRune(key, '\n') {
+		b.Discard()
+		return status.Error(codes.InvalidArgument, "Digests containing newline characters cannot be stored in the digest index")
+	}
+	if err := ba.digestIndex.addPendingKey(key); err != nil {
+		b.Discard()
+		return util.StatusWrap(err, "Failed to add key to digest index")
+	}
+	defer ba.digestIndex.removePendingKey(key)
+	return ba.BlobAccess.Put(ctx, blobDigest, b)
+}
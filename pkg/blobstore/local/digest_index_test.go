@@ -0,0 +1,145 @@
+package local_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestDigestIndex(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	keyLocationMap := mock.NewMockKeyLocationMap(ctrl)
+	errorLogger := mock.NewMockErrorLogger(ctrl)
+	directoryPath := t.TempDir()
+	directory, err := filesystem.NewLocalDirectory(path.LocalFormat.NewParser(directoryPath))
+	require.NoError(t, err)
+	defer directory.Close()
+	var lock sync.RWMutex
+
+	newDigestIndex := func() *local.DigestIndex {
+		digestIndex, err := local.NewDigestIndex(directory, keyLocationMap, &lock, digest.KeyWithoutInstance, errorLogger, 2)
+		require.NoError(t, err)
+		return digestIndex
+	}
+
+	digest1 := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	digest2 := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "6fc422233a40a75a1f028e11c3cd1140", 7)
+	digest3 := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "1f9f6a4ecdba2d5d5b9a1bb9b43d9f05", 11)
+	key1 := local.NewKeyFromString(digest1.GetKey(digest.KeyWithoutInstance))
+	key2 := local.NewKeyFromString(digest2.GetKey(digest.KeyWithoutInstance))
+	key3 := local.NewKeyFromString(digest3.GetKey(digest.KeyWithoutInstance))
+
+	digestIndex := newDigestIndex()
+	blobAccess := local.NewDigestIndexingBlobAccess(baseBlobAccess, digestIndex)
+
+	t.Run("Put", func(t *testing.T) {
+		// Keys of objects should be appended to the index,
+		// regardless of whether objects were written before.
+		for _, blobDigest := range []digest.Digest{digest1, digest2, digest1, digest3} {
+			baseBlobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+					b.Discard()
+					return nil
+				})
+			require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+		}
+	})
+
+	t.Run("BackgroundCompaction", func(t *testing.T) {
+		// As the number of keys appended exceeds the minimum
+		// compaction threshold, compaction should be
+		// triggered. Keys of objects that are no longer present
+		// should be removed.
+		keyLocationMap.EXPECT().Get(key1).Return(local.Location{}, nil)
+		keyLocationMap.EXPECT().Get(key2).Return(local.Location{}, status.Error(codes.NotFound, "Object not found"))
+		keyLocationMap.EXPECT().Get(key3).Return(local.Location{}, nil)
+
+		require.True(t, digestIndex.ProcessCompaction(ctx))
+	})
+
+	t.Run("EnumerateDigests", func(t *testing.T) {
+		// Only digests retained by the previous compaction
+		// should be reported. Digests should be reported
+		// under the instance name prefix, as keys don't
+		// contain instance names.
+		keyLocationMap.EXPECT().Get(key1).Return(local.Location{}, nil)
+		keyLocationMap.EXPECT().Get(key3).Return(local.Location{}, nil)
+
+		var digests []digest.Digest
+		require.NoError(t, digestIndex.EnumerateDigests(ctx, digest.MustNewInstanceName("hello"), func(blobDigest digest.Digest) error {
+			digests = append(digests, blobDigest)
+			return nil
+		}))
+		require.Equal(t, []digest.Digest{digest1, digest3}, digests)
+	})
+
+	t.Run("EnumerateDuringPut", func(t *testing.T) {
+		// Objects that are in the process of being written
+		// should be reported, even if they are not present in
+		// the key-location map yet.
+		keyLocationMap.EXPECT().Get(key1).Return(local.Location{}, nil)
+		keyLocationMap.EXPECT().Get(key3).Return(local.Location{}, status.Error(codes.NotFound, "Object not found"))
+
+		var digests []digest.Digest
+		baseBlobAccess.EXPECT().Put(ctx, digest2, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return digestIndex.EnumerateDigests(ctx, digest.MustNewInstanceName("hello"), func(blobDigest digest.Digest) error {
+					digests = append(digests, blobDigest)
+					return nil
+				})
+			})
+		require.NoError(t, blobAccess.Put(ctx, digest2, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+		require.Equal(t, []digest.Digest{digest1, digest2}, digests)
+	})
+
+	t.Run("Restart", func(t *testing.T) {
+		// Segments should be reloaded after a restart. Lines
+		// that are not terminated, which may be the result of
+		// a crash, should be ignored.
+		require.NoError(t, os.WriteFile(filepath.Join(directoryPath, "digests.100"), []byte(digest3.GetKey(digest.KeyWithoutInstance)), 0o666))
+		digestIndex := newDigestIndex()
+
+		keyLocationMap.EXPECT().Get(key1).Return(local.Location{}, nil)
+		keyLocationMap.EXPECT().Get(key2).Return(local.Location{}, nil)
+
+		var digests []digest.Digest
+		require.NoError(t, digestIndex.EnumerateDigests(ctx, digest.EmptyInstanceName, func(blobDigest digest.Digest) error {
+			digests = append(digests, blobDigest)
+			return nil
+		}))
+		require.Equal(t, []digest.Digest{
+			digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5),
+			digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "6fc422233a40a75a1f028e11c3cd1140", 7),
+		}, digests)
+
+		// Compaction should leave a single segment behind.
+		entries, err := os.ReadDir(directoryPath)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+	})
+
+	t.Run("ProcessCompactionCancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		require.False(t, digestIndex.ProcessCompaction(ctx))
+	})
+}
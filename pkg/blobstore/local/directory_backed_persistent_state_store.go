@@ -19,6 +19,9 @@ import (
 var (
 	componentState    = path.MustNewComponent("state")
 	componentStateNew = path.MustNewComponent("state.new")
+
+	componentKeyLocationMap    = path.MustNewComponent("key_location_map")
+	componentKeyLocationMapNew = path.MustNewComponent("key_location_map.new")
 )
 
 type directoryBackedPersistentStateStore struct {
@@ -27,7 +30,8 @@ type directoryBackedPersistentStateStore struct {
 
 // NewDirectoryBackedPersistentStateStore creates a PersistentStateStore
 // that writes PersistentState Protobuf messages to a file named "state"
-// stored inside a filesystem.Directory.
+// stored inside a filesystem.Directory. Snapshots of the key-location
+// map are written to a file named "key_location_map".
 func NewDirectoryBackedPersistentStateStore(directory filesystem.Directory) PersistentStateStore {
 	return directoryBackedPersistentStateStore{
 		directory: directory,
@@ -76,32 +80,29 @@ func (pss directoryBackedPersistentStateStore) WritePersistentState(persistentSt
 		return util.StatusWrapWithCode(err, codes.Internal, "Failed to marshal data")
 	}
 
-	// Write the persistent state to a temporary file.
-	if err := pss.directory.Remove(componentStateNew); err != nil && !os.IsNotExist(err) {
-		return util.StatusWrapWithCode(err, codes.Internal, "Failed to remove previous temporary file")
-	}
-	f, err := pss.directory.OpenAppend(componentStateNew, filesystem.CreateExcl(0o666))
+	return filesystem.WriteFileAtomically(pss.directory, componentState, componentStateNew, func(w io.Writer) error {
+		_, err := w.Write(data)
+		return err
+	})
+}
+
+func (pss directoryBackedPersistentStateStore) ReadLocationRecordArraySnapshot() (io.ReadCloser, error) {
+	f, err := pss.directory.OpenRead(componentKeyLocationMap)
 	if err != nil {
-		return util.StatusWrapWithCode(err, codes.Internal, "Failed to create temporary file")
-	}
-	if _, err := f.Write(data); err != nil {
-		f.Close()
-		return util.StatusWrapWithCode(err, codes.Internal, "Failed to write to temporary file")
-	}
-	if err := f.Sync(); err != nil {
-		f.Close()
-		return util.StatusWrapWithCode(err, codes.Internal, "Failed to synchronize temporary file")
-	}
-	if err := f.Close(); err != nil {
-		return util.StatusWrapWithCode(err, codes.Internal, "Failed to close temporary file")
+		if os.IsNotExist(err) {
+			return nil, util.StatusWrapWithCode(err, codes.NotFound, "Failed to open file")
+		}
+		return nil, util.StatusWrapWithCode(err, codes.Internal, "Failed to open file")
 	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{
+		Reader: io.NewSectionReader(f, 0, math.MaxInt64),
+		Closer: f,
+	}, nil
+}
 
-	// Move the new persistent state over the old copy.
-	if err := pss.directory.Rename(componentStateNew, pss.directory, componentState); err != nil {
-		return util.StatusWrapWithCode(err, codes.Internal, "Failed to rename temporary file")
-	}
-	if err := pss.directory.Sync(); err != nil {
-		return util.StatusWrapWithCode(err, codes.Internal, "Failed to synchronize directory")
-	}
-	return nil
+func (pss directoryBackedPersistentStateStore) WriteLocationRecordArraySnapshot(writeSnapshot func(w io.Writer) error) error {
+	return filesystem.WriteFileAtomically(pss.directory, componentKeyLocationMap, componentKeyLocationMapNew, writeSnapshot)
 }
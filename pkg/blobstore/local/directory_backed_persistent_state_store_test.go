@@ -188,4 +188,57 @@ func TestDirectoryBackedPersistentStateStore(t *testing.T) {
 
 		require.NoError(t, persistentStateStore.WritePersistentState(&examplePersistentState))
 	})
+
+	t.Run("ReadSnapshotNotFound", func(t *testing.T) {
+		directory.EXPECT().OpenRead(path.MustNewComponent("key_location_map")).Return(nil, syscall.ENOENT)
+
+		_, err := persistentStateStore.ReadLocationRecordArraySnapshot()
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Failed to open file: no such file or directory"), err)
+	})
+
+	t.Run("ReadSnapshotSuccess", func(t *testing.T) {
+		f := mock.NewMockFileReader(ctrl)
+		directory.EXPECT().OpenRead(path.MustNewComponent("key_location_map")).Return(f, nil)
+		f.EXPECT().ReadAt(gomock.Any(), int64(0)).DoAndReturn(func(p []byte, off int64) (int, error) {
+			return copy(p, "Hello"), io.EOF
+		})
+		f.EXPECT().Close()
+
+		r, err := persistentStateStore.ReadLocationRecordArraySnapshot()
+		require.NoError(t, err)
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+		require.NoError(t, r.Close())
+	})
+
+	t.Run("WriteSnapshotFailure", func(t *testing.T) {
+		directory.EXPECT().Remove(path.MustNewComponent("key_location_map.new"))
+		f := mock.NewMockFileAppender(ctrl)
+		directory.EXPECT().OpenAppend(path.MustNewComponent("key_location_map.new"), filesystem.CreateExcl(0o666)).Return(f, nil)
+		f.EXPECT().Close()
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Internal, "Failed to write to temporary file: Record array locked"),
+			persistentStateStore.WriteLocationRecordArraySnapshot(func(w io.Writer) error {
+				return status.Error(codes.Internal, "Record array locked")
+			}))
+	})
+
+	t.Run("WriteSnapshotSuccess", func(t *testing.T) {
+		directory.EXPECT().Remove(path.MustNewComponent("key_location_map.new"))
+		f := mock.NewMockFileAppender(ctrl)
+		directory.EXPECT().OpenAppend(path.MustNewComponent("key_location_map.new"), filesystem.CreateExcl(0o666)).Return(f, nil)
+		f.EXPECT().Write([]byte("Hello")).Return(5, nil)
+		f.EXPECT().Sync()
+		f.EXPECT().Close()
+		directory.EXPECT().Rename(path.MustNewComponent("key_location_map.new"), directory, path.MustNewComponent("key_location_map"))
+		directory.EXPECT().Sync()
+
+		require.NoError(t, persistentStateStore.WriteLocationRecordArraySnapshot(func(w io.Writer) error {
+			_, err := w.Write([]byte("Hello"))
+			return err
+		}))
+	})
 }
@@ -2,6 +2,7 @@ package local
 
 import (
 	"context"
+	"iter"
 	"sync"
 	"time"
 
@@ -56,6 +57,7 @@ type flatBlobAccess struct {
 
 	keyLocationMap  KeyLocationMap
 	locationBlobMap LocationBlobMap
+	blobCompressor  BlobCompressor
 	digestKeyFormat digest.KeyFormat
 
 	lock        *sync.RWMutex
@@ -79,7 +81,10 @@ type flatBlobAccess struct {
 // either ignores the REv2 instance name in digests entirely, or it
 // strongly partitions objects by instance name. It does not introduce
 // any hierarchy.
-func NewFlatBlobAccess(keyLocationMap KeyLocationMap, locationBlobMap LocationBlobMap, digestKeyFormat digest.KeyFormat, lock *sync.RWMutex, storageType string, capabilitiesProvider capabilities.Provider) blobstore.BlobAccess {
+//
+// Blobs are converted to the form in which they are stored using a
+// BlobCompressor. This permits storing blobs in compressed form.
+func NewFlatBlobAccess(keyLocationMap KeyLocationMap, locationBlobMap LocationBlobMap, blobCompressor BlobCompressor, digestKeyFormat digest.KeyFormat, lock *sync.RWMutex, storageType string, capabilitiesProvider capabilities.Provider) blobstore.BlobAccess {
 	flatBlobAccessPrometheusMetrics.Do(func() {
 		prometheus.MustRegister(flatBlobAccessRefreshesBlobs)
 		prometheus.MustRegister(flatBlobAccessRefreshesDurationSeconds)
@@ -91,6 +96,7 @@ func NewFlatBlobAccess(keyLocationMap KeyLocationMap, locationBlobMap LocationBl
 
 		keyLocationMap:  keyLocationMap,
 		locationBlobMap: locationBlobMap,
+		blobCompressor:  blobCompressor,
 		digestKeyFormat: digestKeyFormat,
 		lock:            lock,
 
@@ -177,7 +183,7 @@ func (ba *flatBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buf
 
 	// Copy the object while it's been returned. Block until copying
 	// has finished to apply back-pressure.
-	b1, b2 := b.CloneStream()
+	b1, b2 := ba.blobCompressor.CloneForRefresh(blobDigest, b, location.SizeBytes)
 	return b1.WithTask(func() error {
 		putFinalizer := putWriter(b2)
 		ba.lock.Lock()
@@ -255,7 +261,7 @@ func (ba *flatBlobAccess) GetFromComposite(ctx context.Context, parentDigest, ch
 		}
 
 		// Copy the data while it's being sliced.
-		bParent1, bParent2 := bParent.CloneStream()
+		bParent1, bParent2 := ba.blobCompressor.CloneForRefresh(parentDigest, bParent, parentLocation.SizeBytes)
 		bParentSlicing = bParent1.WithTask(func() error {
 			putFinalizer = putWriter(bParent2)
 			return nil
@@ -302,7 +308,12 @@ func (ba *flatBlobAccess) GetFromComposite(ctx context.Context, parentDigest, ch
 
 	// Create key-location map entries for each of the slices. This
 	// permits subsequent GetFromComposite() calls to access the
-	// individual parts without any slicing.
+	// individual parts without any slicing. This is not possible if
+	// the parent object is stored in compressed form, as the
+	// offsets of the slices don't correspond to the stored data.
+	if !ba.blobCompressor.IsStoredAsIs(parentDigest, parentLocation.SizeBytes) {
+		slices = nil
+	}
 	for i, slice := range slices {
 		if err := ba.keyLocationMap.Put(sliceKeys[i], Location{
 			BlockIndex:  parentLocation.BlockIndex,
@@ -319,9 +330,8 @@ func (ba *flatBlobAccess) GetFromComposite(ctx context.Context, parentDigest, ch
 }
 
 func (ba *flatBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
-	sizeBytes, err := b.GetSizeBytes()
+	b, sizeBytes, err := ba.blobCompressor.Compress(blobDigest, b)
 	if err != nil {
-		b.Discard()
 		return err
 	}
 
@@ -409,7 +419,8 @@ func (ba *flatBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (
 				// Blob is present and still needs to be
 				// refreshed. Allocate space for a copy.
 				b := getter(blobToRefresh.digest)
-				blobRefreshSizeBytes += location.SizeBytes
+				storedSizeBytes := location.SizeBytes
+				blobRefreshSizeBytes += storedSizeBytes
 				putWriter, err := ba.locationBlobMap.Put(location.SizeBytes)
 				ba.lock.Unlock()
 				if err != nil {
@@ -420,7 +431,9 @@ func (ba *flatBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (
 				// Copy the data while unlocked, so that
 				// concurrent requests for other data
 				// continue to be serviced.
-				putFinalizer := putWriter(b)
+				bDiscarded, bStored := ba.blobCompressor.CloneForRefresh(blobToRefresh.digest, b, storedSizeBytes)
+				bDiscarded.Discard()
+				putFinalizer := putWriter(bStored)
 
 				ba.lock.Lock()
 				if _, err := ba.finalizePut(putFinalizer, blobToRefresh.key); err != nil {
@@ -444,3 +457,7 @@ func (ba *flatBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (
 	ba.refreshesBlobsSizeFindMissing.Observe(float64(blobRefreshSizeBytes))
 	return missing.Build(), nil
 }
+
+func (ba *flatBlobAccess) FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+	return blobstore.FindMissingStreamInBatches(ctx, digests, reportMissing, ba.FindMissing)
+}
@@ -0,0 +1,110 @@
+package local
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"sync"
+
+	"github.com/buildbarn/bb-storage/pkg/digest"
+)
+
+const (
+	// frequencySketchDepth is the number of counters in the
+	// count-min sketch that are associated with every object.
+	frequencySketchDepth = 4
+	// frequencySketchMaximumCount is the value at which counters
+	// saturate. Similar to TinyLFU, counters are limited to four bits
+	// worth of range.
+	frequencySketchMaximumCount = 15
+	// frequencySketchSampleFactor controls how many increments may
+	// be performed, relative to the number of counters, before all
+	// counters are halved.
+	frequencySketchSampleFactor = 10
+)
+
+type frequencySketchAdmissionPolicy struct {
+	minimumSizeBytes int64
+	minimumFrequency uint8
+	metrics          admissionPolicyMetrics
+
+	lock       sync.Mutex
+	counters   []uint8
+	increments int
+}
+
+// NewFrequencySketchAdmissionPolicy creates an AdmissionPolicy that
+// tracks how frequently objects are accessed using a count-min sketch,
+// similar to TinyLFU. Objects are only admitted if they have been
+// accessed a minimum number of times. The write of the object itself
+// counts as an access. Objects smaller than a given size are always
+// admitted, and are not tracked. As the size is obtained from the
+// digest of the object, this policy may only be used for the Content
+// Addressable Storage.
+//
+// To ensure the sketch favours objects that were accessed recently,
+// all counters are halved once the number of increments reaches ten
+// times the number of counters.
+func NewFrequencySketchAdmissionPolicy(countersCount int, minimumFrequency int, minimumSizeBytes int64, storageType string) AdmissionPolicy {
+	if countersCount < 1 {
+		countersCount = 1
+	}
+	if minimumFrequency > frequencySketchMaximumCount {
+		minimumFrequency = frequencySketchMaximumCount
+	}
+	return &frequencySketchAdmissionPolicy{
+		minimumSizeBytes: minimumSizeBytes,
+		minimumFrequency: uint8(minimumFrequency),
+		metrics:          newAdmissionPolicyMetrics(storageType, "FrequencySketch"),
+
+		counters: make([]uint8, 1<<bits.Len(uint(countersCount-1))),
+	}
+}
+
+// increment the counters associated with an object, returning the
+// estimated number of times the object has been accessed.
+func (ap *frequencySketchAdmissionPolicy) increment(blobDigest digest.Digest) uint8 {
+	// Digests are cryptographic hashes, meaning their contents can
+	// be used to derive counter indices directly.
+	var hash [16]byte
+	copy(hash[:], blobDigest.GetHashBytes())
+	h1 := binary.LittleEndian.Uint64(hash[:8])
+	h2 := binary.LittleEndian.Uint64(hash[8:]) | 1
+	mask := uint64(len(ap.counters) - 1)
+
+	ap.lock.Lock()
+	defer ap.lock.Unlock()
+
+	estimate := uint8(frequencySketchMaximumCount)
+	for i := uint64(0); i < frequencySketchDepth; i++ {
+		counter := &ap.counters[(h1+i*h2)&mask]
+		if *counter < frequencySketchMaximumCount {
+			*counter++
+		}
+		estimate = min(estimate, *counter)
+	}
+
+	ap.increments++
+	if ap.increments >= frequencySketchSampleFactor*len(ap.counters) {
+		for i := range ap.counters {
+			ap.counters[i] /= 2
+		}
+		ap.increments /= 2
+	}
+	return estimate
+}
+
+func (ap *frequencySketchAdmissionPolicy) RecordAccess(blobDigest digest.Digest) {
+	if blobDigest.GetSizeBytes() >= ap.minimumSizeBytes {
+		ap.increment(blobDigest)
+	}
+}
+
+func (ap *frequencySketchAdmissionPolicy) ShouldAdmit(blobDigest digest.Digest) bool {
+	if blobDigest.GetSizeBytes() < ap.minimumSizeBytes {
+		return true
+	}
+	if ap.increment(blobDigest) < ap.minimumFrequency {
+		return ap.metrics.reject(blobDigest)
+	}
+	return true
+}
@@ -0,0 +1,54 @@
+package local_test
+
+import (
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFrequencySketchAdmissionPolicy(t *testing.T) {
+	admissionPolicy := local.NewFrequencySketchAdmissionPolicy(1024, 3, 100, "cas")
+
+	t.Run("SmallObject", func(t *testing.T) {
+		// Objects below the minimum size should always be
+		// admitted.
+		smallDigest := digest.MustNewDigest("", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 99)
+		require.True(t, admissionPolicy.ShouldAdmit(smallDigest))
+	})
+
+	t.Run("FrequentlyUploaded", func(t *testing.T) {
+		// Objects should only be admitted after having been
+		// uploaded a sufficient number of times.
+		largeDigest := digest.MustNewDigest("", remoteexecution.DigestFunction_SHA256, "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9", 1000)
+		require.False(t, admissionPolicy.ShouldAdmit(largeDigest))
+		require.False(t, admissionPolicy.ShouldAdmit(largeDigest))
+		require.True(t, admissionPolicy.ShouldAdmit(largeDigest))
+	})
+
+	t.Run("FrequentlyRead", func(t *testing.T) {
+		// Attempts to read objects should also count towards
+		// their frequency.
+		largeDigest := digest.MustNewDigest("", remoteexecution.DigestFunction_SHA256, "a591a6d40bf420404a011733cfb7b190d62c65bf0bcda32b57b277d9ad9f146e", 1000)
+		admissionPolicy.RecordAccess(largeDigest)
+		admissionPolicy.RecordAccess(largeDigest)
+		require.True(t, admissionPolicy.ShouldAdmit(largeDigest))
+	})
+
+	t.Run("Aging", func(t *testing.T) {
+		// Once a large number of other objects have been
+		// accessed, counters should be halved. This prevents
+		// objects from being admitted based on accesses that
+		// happened long ago.
+		agingPolicy := local.NewFrequencySketchAdmissionPolicy(16, 3, 0, "cas")
+		oldDigest := digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+		agingPolicy.RecordAccess(oldDigest)
+		agingPolicy.RecordAccess(oldDigest)
+		for i := 0; i < 200; i++ {
+			agingPolicy.RecordAccess(digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "00000000000000000000000000000000", 5))
+		}
+		require.False(t, agingPolicy.ShouldAdmit(oldDigest))
+	})
+}
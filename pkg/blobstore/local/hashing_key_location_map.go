@@ -65,6 +65,7 @@ type hashingKeyLocationMap struct {
 	putIgnoredOlder      prometheus.Observer
 	putTooManyAttempts   prometheus.Observer
 	putTooManyIterations prometheus.Counter
+	putDiscarded         prometheus.Counter
 }
 
 // NewHashingKeyLocationMap creates a KeyLocationMap backed by a hash
@@ -116,6 +117,7 @@ func NewHashingKeyLocationMap(recordArray LocationRecordArray, recordsCount int,
 		putIgnoredOlder:      hashingKeyLocationMapPutIterations.WithLabelValues(storageType, "IgnoredOlder"),
 		putTooManyAttempts:   hashingKeyLocationMapPutIterations.WithLabelValues(storageType, "TooManyAttempts"),
 		putTooManyIterations: hashingKeyLocationMapPutTooManyIterations.WithLabelValues(storageType),
+		putDiscarded:         newKeyLocationMapDiscardedRecordsCounter(storageType, "Hashing"),
 	}
 }
 
@@ -195,9 +197,11 @@ func (klm *hashingKeyLocationMap) Put(key Key, location Location) error {
 		if record.RecordKey.Attempt >= klm.maximumGetAttempts {
 			// No need to generate records that Get() cannot reach.
 			klm.putTooManyAttempts.Observe(float64(iteration))
+			klm.putDiscarded.Inc()
 			return nil
 		}
 	}
 	klm.putTooManyIterations.Inc()
+	klm.putDiscarded.Inc()
 	return nil
 }
@@ -3,6 +3,7 @@ package local
 import (
 	"context"
 	"io"
+	"iter"
 	"sync"
 
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
@@ -395,3 +396,7 @@ func (ba *hierarchicalCASBlobAccess) FindMissing(ctx context.Context, digests di
 	ba.lock.Unlock()
 	return missing.Build(), nil
 }
+
+func (ba *hierarchicalCASBlobAccess) FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+	return blobstore.FindMissingStreamInBatches(ctx, digests, reportMissing, ba.FindMissing)
+}
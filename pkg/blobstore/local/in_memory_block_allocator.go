@@ -42,6 +42,10 @@ func (ib *inMemoryBlock) Get(digest digest.Digest, offsetBytes, sizeBytes int64,
 	return buffer.NewValidatedBufferFromByteSlice(ib.data[offsetBytes : offsetBytes+sizeBytes])
 }
 
+func (ib *inMemoryBlock) GetRaw(offsetBytes, sizeBytes int64) buffer.Buffer {
+	return buffer.NewValidatedBufferFromByteSlice(ib.data[offsetBytes : offsetBytes+sizeBytes])
+}
+
 func (ib *inMemoryBlock) HasSpace(sizeBytes int64) bool {
 	return int64(len(ib.data)-ib.writeOffsetBytes) >= sizeBytes
 }
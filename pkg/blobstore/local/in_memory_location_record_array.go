@@ -16,7 +16,10 @@ type inMemoryLocationRecordArray struct {
 // stores its data in memory. HashingKeyLocationMap relies on being able
 // to store a mapping from Keys to a Location in memory or on disk. This
 // type implements a non-persistent storage of such a map in memory.
-func NewInMemoryLocationRecordArray(size int, resolver BlockReferenceResolver) LocationRecordArray {
+//
+// The contents of this LocationRecordArray can be written to a
+// snapshot, so that they can be reloaded after a restart.
+func NewInMemoryLocationRecordArray(size int, resolver BlockReferenceResolver) SnapshottableLocationRecordArray {
 	return &inMemoryLocationRecordArray{
 		records:  make([]inMemoryLocationRecord, size),
 		resolver: resolver,
@@ -49,3 +52,27 @@ func (lra *inMemoryLocationRecordArray) Put(index int, locationRecord LocationRe
 	}
 	return nil
 }
+
+func (lra *inMemoryLocationRecordArray) Clear(index int) error {
+	lra.records[index] = inMemoryLocationRecord{}
+	return nil
+}
+
+func (lra *inMemoryLocationRecordArray) SerializeRecords(index int, out []byte) {
+	for len(out) > 0 {
+		serializedRecord := (*[BlockDeviceBackedLocationRecordSize]byte)(out)
+		record := &lra.records[index]
+		if _, hashSeed, found := lra.resolver.BlockReferenceToBlockIndex(record.blockReference); found {
+			// Use the BlockReference that was obtained when
+			// the record was inserted, as opposed to
+			// obtaining a new one. This ensures the record
+			// is discarded upon reload if its data was not
+			// synchronized.
+			serializeLocationRecord(serializedRecord, record.blockReference, hashSeed, record.recordKey, record.offsetBytes, record.sizeBytes)
+		} else {
+			*serializedRecord = [BlockDeviceBackedLocationRecordSize]byte{}
+		}
+		out = out[BlockDeviceBackedLocationRecordSize:]
+		index++
+	}
+}
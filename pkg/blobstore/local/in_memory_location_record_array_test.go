@@ -91,4 +91,11 @@ func TestInMemoryLocationRecordArray(t *testing.T) {
 			SizeBytes:   58974582,
 		},
 	}, record)
+
+	// Clearing an entry should reset it to its initial state.
+	require.NoError(t, lra.Clear(123))
+	blockIndexResolver.EXPECT().BlockReferenceToBlockIndex(local.BlockReference{}).
+		Return(0, uint64(0), false)
+	_, err = lra.Get(123)
+	require.Equal(t, local.ErrLocationRecordInvalid, err)
 }
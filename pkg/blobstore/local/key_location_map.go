@@ -1,5 +1,24 @@
 package local
 
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	keyLocationMapPrometheusMetrics sync.Once
+
+	keyLocationMapDiscardedRecords = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "key_location_map_discarded_records_total",
+			Help:      "Number of records that Put() discarded while they still referred to valid data, because no slot could be found for them",
+		},
+		[]string{"storage_type", "key_location_map"})
+)
+
 // KeyLocationMap is equivalent to a map[Key]Location. It is used by
 // FlatBlobAccess and HierarchicalCASBlobAccess to track where blobs are
 // stored, so that they may be accessed. Implementations are permitted
@@ -9,3 +28,15 @@ type KeyLocationMap interface {
 	Get(key Key) (Location, error)
 	Put(key Key, location Location) error
 }
+
+// newKeyLocationMapDiscardedRecordsCounter returns a counter that
+// implementations of KeyLocationMap should increment whenever they
+// discard a record that still refers to valid data. This permits
+// comparing the effective loss rate of different implementations.
+func newKeyLocationMapDiscardedRecordsCounter(storageType, keyLocationMap string) prometheus.Counter {
+	keyLocationMapPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(keyLocationMapDiscardedRecords)
+	})
+
+	return keyLocationMapDiscardedRecords.WithLabelValues(storageType, keyLocationMap)
+}
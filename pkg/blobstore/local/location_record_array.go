@@ -30,4 +30,8 @@ type LocationRecord struct {
 type LocationRecordArray interface {
 	Get(index int) (LocationRecord, error)
 	Put(index int, locationRecord LocationRecord) error
+
+	// Clear the record stored at a given index, resetting it to the
+	// state it had prior to being set.
+	Clear(index int) error
 }
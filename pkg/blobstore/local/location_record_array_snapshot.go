@@ -0,0 +1,60 @@
+package local
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+)
+
+// SnapshottableLocationRecordArray is a LocationRecordArray whose
+// contents can be written to a snapshot. This permits the contents of
+// LocationRecordArrays that are not persistent (e.g., ones stored in
+// memory) to be reloaded after a restart.
+type SnapshottableLocationRecordArray interface {
+	LocationRecordArray
+
+	// SerializeRecords writes a range of records into a buffer,
+	// starting at a given index. The number of records written is
+	// determined by the size of the buffer, which must be a
+	// multiple of BlockDeviceBackedLocationRecordSize. Records are
+	// written using the same format as used by
+	// BlockDeviceBackedLocationRecordArray. Records that are
+	// invalid are written as zero bytes.
+	SerializeRecords(index int, out []byte)
+}
+
+// LoadLocationRecordArraySnapshot reads records from a snapshot that
+// was created by calling SnapshottableLocationRecordArray's
+// SerializeRecords() method, and inserts all valid records into a
+// KeyLocationMap. As records are validated using the hash seeds of the
+// epochs to which they belong, records corresponding to data that was
+// not synchronized prior to the snapshot being reloaded are discarded.
+//
+// Records are reinserted, as opposed to being copied into the
+// LocationRecordArray directly. This means that snapshots remain
+// usable if the size of the KeyLocationMap changes. This function
+// returns the number of records that were reinserted.
+func LoadLocationRecordArraySnapshot(r io.Reader, resolver BlockReferenceResolver, keyLocationMap KeyLocationMap) (int, error) {
+	br := bufio.NewReader(r)
+	recordsCount := 0
+	for index := 0; ; index++ {
+		var serializedRecord [BlockDeviceBackedLocationRecordSize]byte
+		if _, err := io.ReadFull(br, serializedRecord[:]); err == io.EOF {
+			return recordsCount, nil
+		} else if err != nil {
+			return recordsCount, util.StatusWrapf(err, "Failed to read record at index %d", index)
+		}
+
+		record, err := deserializeLocationRecord(&serializedRecord, resolver)
+		if err == ErrLocationRecordInvalid {
+			continue
+		} else if err != nil {
+			return recordsCount, util.StatusWrapf(err, "Failed to deserialize record at index %d", index)
+		}
+		if err := keyLocationMap.Put(record.RecordKey.Key, record.Location); err != nil {
+			return recordsCount, util.StatusWrap(err, "Failed to reinsert record")
+		}
+		recordsCount++
+	}
+}
@@ -0,0 +1,133 @@
+package local_test
+
+import (
+	"bytes"
+	"syscall"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestLocationRecordArraySnapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	blockReferenceResolver := mock.NewMockBlockReferenceResolver(ctrl)
+	lra := local.NewInMemoryLocationRecordArray(3, blockReferenceResolver)
+
+	// Store two records in the array. The first one refers to a
+	// block that is still present at the time the snapshot is
+	// created. The second one refers to a block that was released
+	// in the meantime, meaning it should not be part of the
+	// snapshot.
+	blockReferenceResolver.EXPECT().BlockIndexToBlockReference(12).Return(local.BlockReference{
+		EpochID:        2701,
+		BlocksFromLast: 5,
+	}, uint64(913043094821))
+	require.NoError(t, lra.Put(0, local.LocationRecord{
+		RecordKey: local.LocationRecordKey{
+			Key:     local.NewKeyFromString("3e25960a79dbc69b674cd4ec67a72c62-123-hello"),
+			Attempt: 2,
+		},
+		Location: local.Location{
+			BlockIndex:  12,
+			OffsetBytes: 456,
+			SizeBytes:   123,
+		},
+	}))
+	blockReferenceResolver.EXPECT().BlockIndexToBlockReference(3).Return(local.BlockReference{
+		EpochID:        2600,
+		BlocksFromLast: 14,
+	}, uint64(57393958742))
+	require.NoError(t, lra.Put(2, local.LocationRecord{
+		RecordKey: local.LocationRecordKey{
+			Key: local.NewKeyFromString("04da22ebda78f235062bea9c6786029a-456-hello"),
+		},
+		Location: local.Location{
+			BlockIndex:  3,
+			OffsetBytes: 32984729387,
+			SizeBytes:   456,
+		},
+	}))
+
+	blockReferenceResolver.EXPECT().BlockReferenceToBlockIndex(local.BlockReference{
+		EpochID:        2701,
+		BlocksFromLast: 5,
+	}).Return(12, uint64(913043094821), true)
+	blockReferenceResolver.EXPECT().BlockReferenceToBlockIndex(local.BlockReference{}).
+		Return(0, uint64(0), false)
+	blockReferenceResolver.EXPECT().BlockReferenceToBlockIndex(local.BlockReference{
+		EpochID:        2600,
+		BlocksFromLast: 14,
+	}).Return(0, uint64(0), false)
+	snapshot := make([]byte, 3*local.BlockDeviceBackedLocationRecordSize)
+	lra.SerializeRecords(0, snapshot)
+
+	t.Run("Success", func(t *testing.T) {
+		// Only the first record should be reinserted upon
+		// reload. Its block index may have changed, as blocks
+		// may have been rotated in the meantime.
+		keyLocationMap := mock.NewMockKeyLocationMap(ctrl)
+		blockReferenceResolver.EXPECT().BlockReferenceToBlockIndex(local.BlockReference{
+			EpochID:        2701,
+			BlocksFromLast: 5,
+		}).Return(8, uint64(913043094821), true)
+		blockReferenceResolver.EXPECT().BlockReferenceToBlockIndex(local.BlockReference{}).
+			Return(0, uint64(0), false).
+			Times(2)
+		keyLocationMap.EXPECT().Put(local.NewKeyFromString("3e25960a79dbc69b674cd4ec67a72c62-123-hello"), local.Location{
+			BlockIndex:  8,
+			OffsetBytes: 456,
+			SizeBytes:   123,
+		})
+
+		recordsCount, err := local.LoadLocationRecordArraySnapshot(bytes.NewBuffer(snapshot), blockReferenceResolver, keyLocationMap)
+		require.NoError(t, err)
+		require.Equal(t, 1, recordsCount)
+	})
+
+	t.Run("UnsynchronizedEpoch", func(t *testing.T) {
+		// If the epoch was never synchronized, the hash seed
+		// that is returned upon reload will differ. The record
+		// should then be discarded.
+		keyLocationMap := mock.NewMockKeyLocationMap(ctrl)
+		blockReferenceResolver.EXPECT().BlockReferenceToBlockIndex(local.BlockReference{
+			EpochID:        2701,
+			BlocksFromLast: 5,
+		}).Return(8, uint64(1234), true)
+		blockReferenceResolver.EXPECT().BlockReferenceToBlockIndex(local.BlockReference{}).
+			Return(0, uint64(0), false).
+			Times(2)
+
+		recordsCount, err := local.LoadLocationRecordArraySnapshot(bytes.NewBuffer(snapshot), blockReferenceResolver, keyLocationMap)
+		require.NoError(t, err)
+		require.Equal(t, 0, recordsCount)
+	})
+
+	t.Run("Truncated", func(t *testing.T) {
+		keyLocationMap := mock.NewMockKeyLocationMap(ctrl)
+
+		_, err := local.LoadLocationRecordArraySnapshot(bytes.NewBuffer(snapshot[:20]), blockReferenceResolver, keyLocationMap)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unknown, "Failed to read record at index 0: unexpected EOF"), err)
+	})
+
+	t.Run("PutFailure", func(t *testing.T) {
+		keyLocationMap := mock.NewMockKeyLocationMap(ctrl)
+		blockReferenceResolver.EXPECT().BlockReferenceToBlockIndex(local.BlockReference{
+			EpochID:        2701,
+			BlocksFromLast: 5,
+		}).Return(8, uint64(913043094821), true)
+		keyLocationMap.EXPECT().Put(local.NewKeyFromString("3e25960a79dbc69b674cd4ec67a72c62-123-hello"), gomock.Any()).
+			Return(status.Error(codes.Internal, syscall.EIO.Error()))
+
+		_, err := local.LoadLocationRecordArraySnapshot(bytes.NewBuffer(snapshot), blockReferenceResolver, keyLocationMap)
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Failed to reinsert record: input/output error"), err)
+	})
+}
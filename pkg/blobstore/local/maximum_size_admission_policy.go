@@ -0,0 +1,30 @@
+package local
+
+import (
+	"github.com/buildbarn/bb-storage/pkg/digest"
+)
+
+type maximumSizeAdmissionPolicy struct {
+	maximumSizeBytes int64
+	metrics          admissionPolicyMetrics
+}
+
+// NewMaximumSizeAdmissionPolicy creates an AdmissionPolicy that rejects
+// all objects whose size exceeds a fixed limit. As the size is
+// obtained from the digest of the object, this policy may only be used
+// for the Content Addressable Storage.
+func NewMaximumSizeAdmissionPolicy(maximumSizeBytes int64, storageType string) AdmissionPolicy {
+	return &maximumSizeAdmissionPolicy{
+		maximumSizeBytes: maximumSizeBytes,
+		metrics:          newAdmissionPolicyMetrics(storageType, "MaximumSize"),
+	}
+}
+
+func (ap *maximumSizeAdmissionPolicy) RecordAccess(blobDigest digest.Digest) {}
+
+func (ap *maximumSizeAdmissionPolicy) ShouldAdmit(blobDigest digest.Digest) bool {
+	if blobDigest.GetSizeBytes() > ap.maximumSizeBytes {
+		return ap.metrics.reject(blobDigest)
+	}
+	return true
+}
@@ -2,6 +2,7 @@ package local
 
 import (
 	"context"
+	"io"
 	"sync"
 	"time"
 
@@ -20,6 +21,8 @@ type PeriodicSyncer struct {
 	errorRetryInterval               time.Duration
 	minimumEpochInterval             time.Duration
 	keyLocationMapHashInitialization uint64
+	keyLocationMapRecordsCount       int
+	locationRecordArray              SnapshottableLocationRecordArray
 	dataSyncer                       DataSyncer
 
 	sourceLock *sync.RWMutex
@@ -42,13 +45,20 @@ type DataSyncer func() error
 
 // NewPeriodicSyncer creates a new PeriodicSyncer according to the
 // arguments provided.
-func NewPeriodicSyncer(source PersistentStateSource, sourceLock *sync.RWMutex, store PersistentStateStore, clock clock.Clock, errorLogger util.ErrorLogger, errorRetryInterval, minimumEpochInterval time.Duration, keyLocationMapHashInitialization uint64, dataSyncer DataSyncer) *PeriodicSyncer {
+//
+// If a SnapshottableLocationRecordArray is provided, a snapshot of its
+// contents is written every time the persistent state is updated after
+// data has been synchronized. This allows key-location maps that are
+// stored in memory to be reloaded after a restart.
+func NewPeriodicSyncer(source PersistentStateSource, sourceLock *sync.RWMutex, store PersistentStateStore, clock clock.Clock, errorLogger util.ErrorLogger, errorRetryInterval, minimumEpochInterval time.Duration, keyLocationMapHashInitialization uint64, keyLocationMapRecordsCount int, locationRecordArray SnapshottableLocationRecordArray, dataSyncer DataSyncer) *PeriodicSyncer {
 	return &PeriodicSyncer{
 		clock:                            clock,
 		errorLogger:                      errorLogger,
 		errorRetryInterval:               errorRetryInterval,
 		minimumEpochInterval:             minimumEpochInterval,
 		keyLocationMapHashInitialization: keyLocationMapHashInitialization,
+		keyLocationMapRecordsCount:       keyLocationMapRecordsCount,
+		locationRecordArray:              locationRecordArray,
 		dataSyncer:                       dataSyncer,
 
 		source:                  source,
@@ -80,6 +90,7 @@ func (ps *PeriodicSyncer) writePersistentState() error {
 		OldestEpochId:                    oldestEpochID,
 		Blocks:                           blocks,
 		KeyLocationMapHashInitialization: ps.keyLocationMapHashInitialization,
+		KeyLocationMapRecordsCount:       int64(ps.keyLocationMapRecordsCount),
 	}); err != nil {
 		return err
 	}
@@ -100,6 +111,30 @@ func (ps *PeriodicSyncer) writePersistentStateRetrying() {
 	}
 }
 
+// locationRecordArraySnapshotChunkSize is the number of records that
+// are serialized by PeriodicSyncer while holding the lock. Records are
+// serialized in chunks to prevent writes from being blocked for the
+// entire duration of writing a snapshot.
+const locationRecordArraySnapshotChunkSize = 1024
+
+func (ps *PeriodicSyncer) writeLocationRecordArraySnapshot() error {
+	return ps.store.WriteLocationRecordArraySnapshot(func(w io.Writer) error {
+		// As every record contains its own checksum, the
+		// snapshot does not need to be consistent across chunks.
+		serializedRecords := make([]byte, locationRecordArraySnapshotChunkSize*BlockDeviceBackedLocationRecordSize)
+		for index := 0; index < ps.keyLocationMapRecordsCount; index += locationRecordArraySnapshotChunkSize {
+			chunk := serializedRecords[:min(ps.keyLocationMapRecordsCount-index, locationRecordArraySnapshotChunkSize)*BlockDeviceBackedLocationRecordSize]
+			ps.sourceLock.RLock()
+			ps.locationRecordArray.SerializeRecords(index, chunk)
+			ps.sourceLock.RUnlock()
+			if _, err := w.Write(chunk); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 // ProcessBlockRelease waits for a single block to be released by a
 // PersistentBlockList. It causes the persistent state of the
 // PersistentBlockList to be extracted and written to a file.
@@ -193,5 +228,12 @@ func (ps *PeriodicSyncer) ProcessBlockPut(ctx context.Context) bool {
 	ps.sourceLock.Unlock()
 
 	ps.writePersistentStateRetrying()
+	if ps.locationRecordArray != nil {
+		// Failing to write a snapshot is not fatal, as the next
+		// snapshot will contain all records as well.
+		if err := ps.writeLocationRecordArraySnapshot(); err != nil {
+			ps.errorLogger.Log(util.StatusWrap(err, "Failed to write key-location map snapshot"))
+		}
+	}
 	return keepGoing
 }
@@ -37,6 +37,8 @@ func TestPeriodicSyncerProcessBlockRelease(t *testing.T) {
 		30*time.Second,
 		time.Minute,
 		0xdf280dd45b2c39e,
+		1021,
+		nil,
 		dataSyncer.Call)
 
 	blockReleaseWakeup := make(chan struct{}, 1)
@@ -76,6 +78,7 @@ func TestPeriodicSyncerProcessBlockRelease(t *testing.T) {
 				},
 			},
 			KeyLocationMapHashInitialization: 0xdf280dd45b2c39e,
+			KeyLocationMapRecordsCount:       1021,
 		}).Return(status.Error(codes.Internal, "Permission denied")),
 
 		// When the above fails, we should wait a bit before
@@ -109,6 +112,7 @@ func TestPeriodicSyncerProcessBlockRelease(t *testing.T) {
 				},
 			},
 			KeyLocationMapHashInitialization: 0xdf280dd45b2c39e,
+			KeyLocationMapRecordsCount:       1021,
 		}),
 
 		// Upon success, PersistentBlockList should be notified,
@@ -137,6 +141,8 @@ func TestPeriodicSyncerProcessBlockPut(t *testing.T) {
 		30*time.Second,
 		time.Minute,
 		0xdf280dd45b2c39e,
+		1021,
+		nil,
 		dataSyncer.Call)
 
 	exampleBlockState := []*pb.BlockState{
@@ -199,6 +205,7 @@ func TestPeriodicSyncerProcessBlockPut(t *testing.T) {
 				OldestEpochId:                    7,
 				Blocks:                           exampleBlockState,
 				KeyLocationMapHashInitialization: 0xdf280dd45b2c39e,
+				KeyLocationMapRecordsCount:       1021,
 			}),
 			source.EXPECT().NotifyPersistentStateWritten())
 
@@ -228,6 +235,7 @@ func TestPeriodicSyncerProcessBlockPut(t *testing.T) {
 				OldestEpochId:                    13,
 				Blocks:                           exampleBlockState,
 				KeyLocationMapHashInitialization: 0xdf280dd45b2c39e,
+				KeyLocationMapRecordsCount:       1021,
 			}),
 			source.EXPECT().NotifyPersistentStateWritten())
 
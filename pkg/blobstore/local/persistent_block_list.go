@@ -268,6 +268,12 @@ func (bl *PersistentBlockList) Get(index int, digest digest.Digest, offsetBytes,
 	return bl.blocks[index].block.Get(digest, offsetBytes, sizeBytes, dataIntegrityCallback)
 }
 
+// GetRaw obtains data from one of the blocks managed by this BlockList
+// as is, without performing any validation.
+func (bl *PersistentBlockList) GetRaw(index int, offsetBytes, sizeBytes int64) buffer.Buffer {
+	return bl.blocks[index].block.GetRaw(offsetBytes, sizeBytes)
+}
+
 // HasSpace returns whether a block with a given index has sufficient
 // space to store a blob of a given size.
 func (bl *PersistentBlockList) HasSpace(index int, sizeBytes int64) bool {
@@ -1,6 +1,8 @@
 package local
 
 import (
+	"io"
+
 	pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/local"
 )
 
@@ -11,4 +13,12 @@ import (
 type PersistentStateStore interface {
 	ReadPersistentState() (*pb.PersistentState, error)
 	WritePersistentState(persistentState *pb.PersistentState) error
+
+	// Methods for reading and writing snapshots of the contents of
+	// a SnapshottableLocationRecordArray. ReadLocationRecordArraySnapshot()
+	// fails with code NOT_FOUND if no snapshot has been written.
+	// These are also used by RehashLocationRecordArray() to retain
+	// records while they are being rehashed.
+	ReadLocationRecordArraySnapshot() (io.ReadCloser, error)
+	WriteLocationRecordArraySnapshot(writeSnapshot func(w io.Writer) error) error
 }
@@ -0,0 +1,108 @@
+package local
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RehashLocationRecordArray reinserts all valid records stored in the
+// first recordsCount entries of a LocationRecordArray into a
+// KeyLocationMap. This can be used to preserve the contents of a
+// HashingKeyLocationMap after its size has changed, as the slot in
+// which a record is stored depends on the number of records.
+//
+// The KeyLocationMap is permitted to be backed by the same
+// LocationRecordArray. To prevent records at their original locations
+// from interfering with the insertion of records at their new
+// locations, all valid records are cleared prior to being reinserted.
+//
+// To ensure no records are lost if the process crashes while
+// rehashing, all valid records are first written into a snapshot
+// using the PersistentStateStore. The snapshot is only discarded
+// after all records have been reinserted. If a non-empty snapshot is
+// present when this function is called, a previous attempt to rehash
+// was interrupted. In that case the records are reloaded from the
+// snapshot, as the LocationRecordArray may only contain some of them.
+// This function returns the number of records that were reinserted.
+func RehashLocationRecordArray(recordArray LocationRecordArray, recordsCount int, keyLocationMap KeyLocationMap, store PersistentStateStore, resolver BlockReferenceResolver) (int, error) {
+	interrupted, err := hasNonEmptyLocationRecordArraySnapshot(store)
+	if err != nil {
+		return 0, util.StatusWrap(err, "Failed to read snapshot of records to rehash")
+	}
+	if !interrupted {
+		if err := store.WriteLocationRecordArraySnapshot(func(w io.Writer) error {
+			bw := bufio.NewWriter(w)
+			for index := 0; index < recordsCount; index++ {
+				record, err := recordArray.Get(index)
+				if err == ErrLocationRecordInvalid {
+					continue
+				} else if err != nil {
+					return util.StatusWrapf(err, "Failed to read record at index %d", index)
+				}
+				blockReference, hashSeed := resolver.BlockIndexToBlockReference(record.Location.BlockIndex)
+				var serializedRecord [BlockDeviceBackedLocationRecordSize]byte
+				serializeLocationRecord(&serializedRecord, blockReference, hashSeed, record.RecordKey, record.Location.OffsetBytes, record.Location.SizeBytes)
+				if _, err := bw.Write(serializedRecord[:]); err != nil {
+					return err
+				}
+			}
+			return bw.Flush()
+		}); err != nil {
+			return 0, util.StatusWrap(err, "Failed to write snapshot of records to rehash")
+		}
+	}
+
+	for index := 0; index < recordsCount; index++ {
+		if _, err := recordArray.Get(index); err == ErrLocationRecordInvalid {
+			continue
+		} else if err != nil {
+			return 0, util.StatusWrapf(err, "Failed to read record at index %d", index)
+		}
+		if err := recordArray.Clear(index); err != nil {
+			return 0, util.StatusWrapf(err, "Failed to clear record at index %d", index)
+		}
+	}
+
+	r, err := store.ReadLocationRecordArraySnapshot()
+	if err != nil {
+		return 0, util.StatusWrap(err, "Failed to read snapshot of records to rehash")
+	}
+	reinsertedRecordsCount, err := LoadLocationRecordArraySnapshot(r, resolver, keyLocationMap)
+	r.Close()
+	if err != nil {
+		return 0, util.StatusWrap(err, "Failed to reinsert records")
+	}
+
+	// Discard the snapshot, so that a subsequent attempt to rehash
+	// uses the contents of the LocationRecordArray.
+	if err := store.WriteLocationRecordArraySnapshot(func(w io.Writer) error { return nil }); err != nil {
+		return 0, util.StatusWrap(err, "Failed to discard snapshot of records to rehash")
+	}
+	return reinsertedRecordsCount, nil
+}
+
+// hasNonEmptyLocationRecordArraySnapshot returns true if a snapshot of
+// a LocationRecordArray is present that contains at least one record.
+func hasNonEmptyLocationRecordArraySnapshot(store PersistentStateStore) (bool, error) {
+	r, err := store.ReadLocationRecordArraySnapshot()
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	defer r.Close()
+
+	var b [1]byte
+	if _, err := io.ReadFull(r, b[:]); err == io.EOF {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,160 @@
+package local_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestRehashLocationRecordArray(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	recordArray := mock.NewMockLocationRecordArray(ctrl)
+	keyLocationMap := mock.NewMockKeyLocationMap(ctrl)
+	store := mock.NewMockPersistentStateStore(ctrl)
+	resolver := mock.NewMockBlockReferenceResolver(ctrl)
+
+	record1 := local.LocationRecord{
+		RecordKey: local.LocationRecordKey{
+			Key:     local.Key{1},
+			Attempt: 3,
+		},
+		Location: local.Location{
+			BlockIndex:  4,
+			OffsetBytes: 100,
+			SizeBytes:   5,
+		},
+	}
+	blockReference1 := local.BlockReference{EpochID: 12, BlocksFromLast: 3}
+	record2 := local.LocationRecord{
+		RecordKey: local.LocationRecordKey{
+			Key: local.Key{2},
+		},
+		Location: local.Location{
+			BlockIndex:  7,
+			OffsetBytes: 200,
+			SizeBytes:   10,
+		},
+	}
+	blockReference2 := local.BlockReference{EpochID: 15, BlocksFromLast: 0}
+
+	// Helpers for letting the PersistentStateStore hold a snapshot.
+	var snapshot []byte
+	expectReadSnapshot := func() *gomock.Call {
+		return store.EXPECT().ReadLocationRecordArraySnapshot().DoAndReturn(func() (io.ReadCloser, error) {
+			if snapshot == nil {
+				return nil, status.Error(codes.NotFound, "Snapshot not found")
+			}
+			return io.NopCloser(bytes.NewBuffer(snapshot)), nil
+		})
+	}
+	expectWriteSnapshot := func() *gomock.Call {
+		return store.EXPECT().WriteLocationRecordArraySnapshot(gomock.Any()).DoAndReturn(func(writeSnapshot func(w io.Writer) error) error {
+			var b bytes.Buffer
+			if err := writeSnapshot(&b); err != nil {
+				return err
+			}
+			snapshot = b.Bytes()
+			return nil
+		})
+	}
+	expectReinsertion := func() {
+		resolver.EXPECT().BlockReferenceToBlockIndex(blockReference1).Return(4, uint64(123), true)
+		keyLocationMap.EXPECT().Put(local.Key{1}, record1.Location)
+		resolver.EXPECT().BlockReferenceToBlockIndex(blockReference2).Return(7, uint64(456), true)
+		keyLocationMap.EXPECT().Put(local.Key{2}, record2.Location)
+	}
+
+	t.Run("ReadFailure", func(t *testing.T) {
+		expectReadSnapshot()
+		expectWriteSnapshot()
+		recordArray.EXPECT().Get(0).Return(local.LocationRecord{}, local.ErrLocationRecordInvalid)
+		recordArray.EXPECT().Get(1).Return(local.LocationRecord{}, status.Error(codes.Internal, "Disk on fire"))
+
+		_, err := local.RehashLocationRecordArray(recordArray, 3, keyLocationMap, store, resolver)
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Failed to write snapshot of records to rehash: Failed to read record at index 1: Disk on fire"), err)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		// All valid records should be written into a snapshot
+		// before being cleared, so that they aren't lost if we
+		// crash while rehashing. They should all be cleared
+		// prior to being reinserted, so that they don't occupy
+		// any slots that are needed at their new locations.
+		gomock.InOrder(
+			expectReadSnapshot(),
+			expectWriteSnapshot(),
+			recordArray.EXPECT().Get(0).Return(record1, nil),
+			resolver.EXPECT().BlockIndexToBlockReference(4).Return(blockReference1, uint64(123)),
+			recordArray.EXPECT().Get(1).Return(local.LocationRecord{}, local.ErrLocationRecordInvalid),
+			recordArray.EXPECT().Get(2).Return(record2, nil),
+			resolver.EXPECT().BlockIndexToBlockReference(7).Return(blockReference2, uint64(456)),
+			recordArray.EXPECT().Get(0).Return(record1, nil),
+			recordArray.EXPECT().Clear(0),
+			recordArray.EXPECT().Get(1).Return(local.LocationRecord{}, local.ErrLocationRecordInvalid),
+			recordArray.EXPECT().Get(2).Return(record2, nil),
+			recordArray.EXPECT().Clear(2),
+			expectReadSnapshot())
+		expectReinsertion()
+		expectWriteSnapshot()
+
+		recordsCount, err := local.RehashLocationRecordArray(recordArray, 3, keyLocationMap, store, resolver)
+		require.NoError(t, err)
+		require.Equal(t, 2, recordsCount)
+
+		// The snapshot should be discarded afterwards.
+		require.Empty(t, snapshot)
+	})
+
+	t.Run("Interrupted", func(t *testing.T) {
+		// If a previous attempt to rehash was interrupted,
+		// records should be reloaded from the snapshot, as the
+		// LocationRecordArray may only contain some of them.
+		expectWriteSnapshot()
+		require.NoError(t, store.WriteLocationRecordArraySnapshot(func(w io.Writer) error {
+			for _, r := range []struct {
+				record         local.LocationRecord
+				blockReference local.BlockReference
+				hashSeed       uint64
+			}{
+				{record1, blockReference1, 123},
+				{record2, blockReference2, 456},
+			} {
+				lra := local.NewInMemoryLocationRecordArray(1, resolver)
+				resolver.EXPECT().BlockIndexToBlockReference(r.record.Location.BlockIndex).Return(r.blockReference, r.hashSeed)
+				require.NoError(t, lra.Put(0, r.record))
+				resolver.EXPECT().BlockReferenceToBlockIndex(r.blockReference).Return(r.record.Location.BlockIndex, r.hashSeed, true)
+				serializedRecord := make([]byte, local.BlockDeviceBackedLocationRecordSize)
+				lra.SerializeRecords(0, serializedRecord)
+				if _, err := w.Write(serializedRecord); err != nil {
+					return err
+				}
+			}
+			return nil
+		}))
+
+		gomock.InOrder(
+			expectReadSnapshot(),
+			recordArray.EXPECT().Get(0).Return(local.LocationRecord{}, local.ErrLocationRecordInvalid),
+			recordArray.EXPECT().Get(1).Return(record2, nil),
+			recordArray.EXPECT().Clear(1),
+			expectReadSnapshot())
+		expectReinsertion()
+		expectWriteSnapshot()
+
+		recordsCount, err := local.RehashLocationRecordArray(recordArray, 2, keyLocationMap, store, resolver)
+		require.NoError(t, err)
+		require.Equal(t, 2, recordsCount)
+		require.Empty(t, snapshot)
+	})
+}
@@ -0,0 +1,313 @@
+package local
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/klauspost/compress/zstd"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	scrubberPrometheusMetrics sync.Once
+
+	scrubberRecords = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "scrubber_records_total",
+			Help:      "Number of key-location map records processed by Scrubber, partitioned by outcome",
+		},
+		[]string{"storage_type", "outcome"})
+	scrubberReadBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "scrubber_read_bytes_total",
+			Help:      "Amount of data read by Scrubber to validate the contents of blobs, in bytes",
+		},
+		[]string{"storage_type"})
+	scrubberPassesCompleted = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "scrubber_passes_completed_total",
+			Help:      "Number of times Scrubber processed all records in the key-location map",
+		},
+		[]string{"storage_type"})
+	scrubberPassProgress = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "scrubber_pass_progress_ratio",
+			Help:      "Fraction of the records in the key-location map that Scrubber has processed during the current pass",
+		},
+		[]string{"storage_type"})
+)
+
+// Scrubber walks over all records stored in a LocationRecordArray
+// used by HashingKeyLocationMap, and validates the contents of the
+// blobs they reference. This allows data corruption to be detected
+// before clients attempt to read the corrupted blobs.
+//
+// As keys are irreversible, the contents of blobs are validated by
+// hashing them using all provided digest functions, and checking
+// whether any of the resulting digests corresponds to the key of the
+// record. This means that Scrubber can only be used for the Content
+// Addressable Storage, with keys that don't contain an instance name.
+//
+// Records whose contents no longer match or can no longer be read are
+// invalidated by overwriting their key. This causes the blob to be
+// reported as absent, so that clients upload it once again.
+type Scrubber struct {
+	recordArray                LocationRecordArray
+	recordsCount               int
+	blockList                  BlockList
+	lock                       *sync.RWMutex
+	digestFunctions            []digest.Function
+	zstdDecoder                *zstd.Decoder
+	maximumCompressedSizeBytes int64
+	clock                      clock.Clock
+	errorLogger                util.ErrorLogger
+	maximumBytesPerSecond      int64
+
+	nextIndex    int
+	nextReadTime time.Time
+
+	recordsInvalid    prometheus.Counter
+	recordsValid      prometheus.Counter
+	recordsCorrupted  prometheus.Counter
+	recordsFailed     prometheus.Counter
+	recordsUnreadable prometheus.Counter
+	readBytes         prometheus.Counter
+	passesCompleted   prometheus.Counter
+	passProgress      prometheus.Gauge
+}
+
+// NewScrubber creates a Scrubber for a LocationRecordArray containing
+// a given number of records. The BlockList and lock must be the ones
+// that are used by the LocationBlobMap that stores the blobs.
+//
+// If the maximum compressed size is non-zero, blobs up to this size
+// whose contents don't match their key are decompressed using
+// Zstandard prior to validation. Decompressed blobs may not exceed
+// this size either. This permits validating blobs that have been
+// stored using the BlobCompressor returned by NewZstdBlobCompressor().
+//
+// The rate at which data is read is limited to a configured number of
+// bytes per second. Every record in the key-location map is accounted
+// for as BlockDeviceBackedLocationRecordSize bytes, so that scanning
+// sparsely populated key-location maps is rate limited as well.
+func NewScrubber(recordArray LocationRecordArray, recordsCount int, blockList BlockList, lock *sync.RWMutex, digestFunctions []digest.Function, maximumCompressedSizeBytes int64, clock clock.Clock, errorLogger util.ErrorLogger, maximumBytesPerSecond int64, storageType string) (*Scrubber, error) {
+	scrubberPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(scrubberRecords)
+		prometheus.MustRegister(scrubberReadBytes)
+		prometheus.MustRegister(scrubberPassesCompleted)
+		prometheus.MustRegister(scrubberPassProgress)
+	})
+
+	var zstdDecoder *zstd.Decoder
+	if maximumCompressedSizeBytes > 0 {
+		var err error
+		zstdDecoder, err = zstd.NewReader(
+			nil,
+			zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderLowmem(true))
+		if err != nil {
+			return nil, util.StatusWrapWithCode(err, codes.Internal, "Failed to create Zstandard decoder")
+		}
+	}
+
+	return &Scrubber{
+		recordArray:                recordArray,
+		recordsCount:               recordsCount,
+		blockList:                  blockList,
+		lock:                       lock,
+		digestFunctions:            digestFunctions,
+		zstdDecoder:                zstdDecoder,
+		maximumCompressedSizeBytes: maximumCompressedSizeBytes,
+		clock:                      clock,
+		errorLogger:                errorLogger,
+		maximumBytesPerSecond:      maximumBytesPerSecond,
+
+		nextReadTime: clock.Now(),
+
+		recordsInvalid:    scrubberRecords.WithLabelValues(storageType, "Invalid"),
+		recordsValid:      scrubberRecords.WithLabelValues(storageType, "Valid"),
+		recordsCorrupted:  scrubberRecords.WithLabelValues(storageType, "Corrupted"),
+		recordsFailed:     scrubberRecords.WithLabelValues(storageType, "Failed"),
+		recordsUnreadable: scrubberRecords.WithLabelValues(storageType, "Unreadable"),
+		readBytes:         scrubberReadBytes.WithLabelValues(storageType),
+		passesCompleted:   scrubberPassesCompleted.WithLabelValues(storageType),
+		passProgress:      scrubberPassProgress.WithLabelValues(storageType),
+	}, nil
+}
+
+// waitForReadBudget blocks until reading a given amount of data does
+// not cause the maximum read rate to be exceeded. It returns false if
+// the provided context is canceled.
+func (s *Scrubber) waitForReadBudget(ctx context.Context, sizeBytes int64) bool {
+	now := s.clock.Now()
+	if s.nextReadTime.Before(now) {
+		s.nextReadTime = now
+	}
+	delay := s.nextReadTime.Sub(now)
+	s.nextReadTime = s.nextReadTime.Add(time.Duration(float64(sizeBytes) / float64(s.maximumBytesPerSecond) * float64(time.Second)))
+	if delay <= 0 {
+		return true
+	}
+
+	timer, t := s.clock.NewTimer(delay)
+	select {
+	case <-t:
+		return true
+	case <-ctx.Done():
+		timer.Stop()
+		return false
+	}
+}
+
+// contentsMatchKey computes the digests of a blob using all digest
+// functions, and returns whether any of them corresponds to a key.
+func (s *Scrubber) contentsMatchKey(r io.Reader, sizeBytes int64, key Key) (bool, error) {
+	generators := make([]*digest.Generator, 0, len(s.digestFunctions))
+	writers := make([]io.Writer, 0, len(s.digestFunctions))
+	for _, digestFunction := range s.digestFunctions {
+		generator := digestFunction.NewGenerator(sizeBytes)
+		generators = append(generators, generator)
+		writers = append(writers, generator)
+	}
+	if _, err := io.Copy(io.MultiWriter(writers...), r); err != nil {
+		return false, err
+	}
+	for _, generator := range generators {
+		if NewKeyFromString(generator.Sum().GetKey(digest.KeyWithoutInstance)) == key {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// storedContentsMatchKey returns whether the data referenced by a
+// location record, as stored in a block, corresponds to its key.
+func (s *Scrubber) storedContentsMatchKey(b buffer.Buffer, record LocationRecord) (bool, error) {
+	sizeBytes := record.Location.SizeBytes
+	if s.zstdDecoder == nil || sizeBytes > s.maximumCompressedSizeBytes {
+		// Blob is guaranteed to be stored as is.
+		r := b.ToReader()
+		defer r.Close()
+		return s.contentsMatchKey(r, sizeBytes, record.RecordKey.Key)
+	}
+
+	// Blob may have been stored in compressed form.
+	storedData, err := b.ToByteSlice(int(sizeBytes))
+	if err != nil {
+		return false, err
+	}
+	if matches, err := s.contentsMatchKey(bytes.NewReader(storedData), sizeBytes, record.RecordKey.Key); err != nil || matches {
+		return matches, err
+	}
+	if err := s.zstdDecoder.Reset(bytes.NewReader(storedData)); err != nil {
+		return false, nil
+	}
+	defer s.zstdDecoder.Reset(nil)
+	decompressedData, err := io.ReadAll(io.LimitReader(s.zstdDecoder, s.maximumCompressedSizeBytes+1))
+	if err != nil || int64(len(decompressedData)) > s.maximumCompressedSizeBytes {
+		return false, nil
+	}
+	return s.contentsMatchKey(bytes.NewReader(decompressedData), int64(len(decompressedData)), record.RecordKey.Key)
+}
+
+// ProcessRecord validates the contents of the blob referenced by the
+// next record in the key-location map. If the contents no longer
+// match or cannot be read, the record is invalidated.
+//
+// This function must generally be called in a loop in a separate
+// goroutine. It returns false if the provided context is canceled.
+func (s *Scrubber) ProcessRecord(ctx context.Context) bool {
+	index := s.nextIndex
+	s.nextIndex++
+	if s.nextIndex == s.recordsCount {
+		s.nextIndex = 0
+		s.passesCompleted.Inc()
+	}
+	s.passProgress.Set(float64(s.nextIndex) / float64(s.recordsCount))
+
+	if !s.waitForReadBudget(ctx, BlockDeviceBackedLocationRecordSize) {
+		return false
+	}
+	s.lock.RLock()
+	record, err := s.recordArray.Get(index)
+	if err != nil {
+		s.lock.RUnlock()
+		if err == ErrLocationRecordInvalid {
+			s.recordsInvalid.Inc()
+		} else {
+			s.recordsFailed.Inc()
+			s.errorLogger.Log(util.StatusWrapf(err, "Failed to read key-location map record at index %d", index))
+		}
+		return true
+	}
+	// Buffers returned by BlockList remain valid after releasing
+	// the lock, even if the block is released in the meantime.
+	b := s.blockList.GetRaw(record.Location.BlockIndex, record.Location.OffsetBytes, record.Location.SizeBytes)
+	s.lock.RUnlock()
+
+	if !s.waitForReadBudget(ctx, record.Location.SizeBytes) {
+		b.Discard()
+		return false
+	}
+	matches, err := s.storedContentsMatchKey(b, record)
+	if err != nil {
+		// Blobs that can no longer be read are of no use to
+		// clients either. Invalidate the record, so that the
+		// blob is uploaded once again.
+		if s.invalidateRecord(index, record) {
+			s.recordsUnreadable.Inc()
+			s.errorLogger.Log(util.StatusWrapfWithCode(err, codes.DataLoss, "Invalidated key-location map record at index %d, as the blob in block %d at offset %d with size %d could not be read", index, record.Location.BlockIndex, record.Location.OffsetBytes, record.Location.SizeBytes))
+		}
+		return true
+	}
+	s.readBytes.Add(float64(record.Location.SizeBytes))
+	if matches {
+		s.recordsValid.Inc()
+		return true
+	}
+
+	if s.invalidateRecord(index, record) {
+		s.recordsCorrupted.Inc()
+		s.errorLogger.Log(status.Errorf(codes.DataLoss, "Invalidated key-location map record at index %d, as the contents of the blob in block %d at offset %d with size %d no longer match its key", index, record.Location.BlockIndex, record.Location.OffsetBytes, record.Location.SizeBytes))
+	}
+	return true
+}
+
+// invalidateRecord invalidates a record in the key-location map, but
+// only if it has not been replaced or displaced in the meantime. Its
+// key is overwritten instead of clearing the record entirely, so that
+// HashingKeyLocationMap continues to probe past it when looking up
+// other keys. It returns true if the record was invalidated.
+func (s *Scrubber) invalidateRecord(index int, record LocationRecord) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if currentRecord, err := s.recordArray.Get(index); err != nil || currentRecord != record {
+		return false
+	}
+	invalidatedRecord := record
+	invalidatedRecord.RecordKey.Key = Key{}
+	if err := s.recordArray.Put(index, invalidatedRecord); err != nil {
+		s.recordsFailed.Inc()
+		s.errorLogger.Log(util.StatusWrapf(err, "Failed to invalidate key-location map record at index %d", index))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,185 @@
+package local_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestScrubber(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	recordArray := mock.NewMockLocationRecordArray(ctrl)
+	blockList := mock.NewMockBlockList(ctrl)
+	var lock sync.RWMutex
+	clock := mock.NewMockClock(ctrl)
+	now := time.Unix(1000, 0)
+	clock.EXPECT().Now().DoAndReturn(func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}).AnyTimes()
+	errorLogger := mock.NewMockErrorLogger(ctrl)
+	scrubber, err := local.NewScrubber(
+		recordArray,
+		4,
+		blockList,
+		&lock,
+		[]digest.Function{
+			digest.MustNewFunction("", remoteexecution.DigestFunction_MD5),
+			digest.MustNewFunction("", remoteexecution.DigestFunction_SHA256),
+		},
+		/* maximumCompressedSizeBytes = */ 100,
+		clock,
+		errorLogger,
+		/* maximumBytesPerSecond = */ 1000,
+		"cas")
+	require.NoError(t, err)
+
+	helloDigest := digest.MustNewDigest("", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+	helloRecord := local.LocationRecord{
+		RecordKey: local.LocationRecordKey{
+			Key:     local.NewKeyFromString(helloDigest.GetKey(digest.KeyWithoutInstance)),
+			Attempt: 3,
+		},
+		Location: local.Location{
+			BlockIndex:  2,
+			OffsetBytes: 100,
+			SizeBytes:   5,
+		},
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		recordArray.EXPECT().Get(0).Return(local.LocationRecord{}, local.ErrLocationRecordInvalid)
+
+		require.True(t, scrubber.ProcessRecord(ctx))
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		recordArray.EXPECT().Get(1).Return(helloRecord, nil)
+		blockList.EXPECT().GetRaw(2, int64(100), int64(5)).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		require.True(t, scrubber.ProcessRecord(ctx))
+	})
+
+	t.Run("ValidCompressed", func(t *testing.T) {
+		encoder, err := zstd.NewWriter(nil)
+		require.NoError(t, err)
+		compressed := encoder.EncodeAll([]byte("Hello"), nil)
+		compressedRecord := helloRecord
+		compressedRecord.Location.SizeBytes = int64(len(compressed))
+
+		recordArray.EXPECT().Get(2).Return(compressedRecord, nil)
+		blockList.EXPECT().GetRaw(2, int64(100), int64(len(compressed))).
+			Return(buffer.NewValidatedBufferFromByteSlice(compressed))
+
+		require.True(t, scrubber.ProcessRecord(ctx))
+	})
+
+	t.Run("Corrupted", func(t *testing.T) {
+		// The contents of the blob no longer match the key. The
+		// record should be invalidated by overwriting its key.
+		recordArray.EXPECT().Get(3).Return(helloRecord, nil).Times(2)
+		blockList.EXPECT().GetRaw(2, int64(100), int64(5)).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hellp")))
+		recordArray.EXPECT().Put(3, local.LocationRecord{
+			RecordKey: local.LocationRecordKey{Attempt: 3},
+			Location:  helloRecord.Location,
+		})
+		errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.DataLoss, "Invalidated key-location map record at index 3, as the contents of the blob in block 2 at offset 100 with size 5 no longer match its key")))
+
+		require.True(t, scrubber.ProcessRecord(ctx))
+	})
+
+	t.Run("CorruptedButReplaced", func(t *testing.T) {
+		// If the record is replaced while the blob is being
+		// read, the new record should be left alone. The
+		// scrubber should have wrapped around to index 0.
+		replacedRecord := helloRecord
+		replacedRecord.Location.BlockIndex = 3
+		gomock.InOrder(
+			recordArray.EXPECT().Get(0).Return(helloRecord, nil),
+			blockList.EXPECT().GetRaw(2, int64(100), int64(5)).
+				Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hellp"))),
+			recordArray.EXPECT().Get(0).Return(replacedRecord, nil))
+
+		require.True(t, scrubber.ProcessRecord(ctx))
+	})
+
+	t.Run("ReadFailure", func(t *testing.T) {
+		// Blobs that can no longer be read should be
+		// invalidated as well.
+		recordArray.EXPECT().Get(1).Return(helloRecord, nil).Times(2)
+		blockList.EXPECT().GetRaw(2, int64(100), int64(5)).
+			Return(buffer.NewBufferFromError(status.Error(codes.Internal, "Disk on fire")))
+		recordArray.EXPECT().Put(1, local.LocationRecord{
+			RecordKey: local.LocationRecordKey{Attempt: 3},
+			Location:  helloRecord.Location,
+		})
+		errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.DataLoss, "Invalidated key-location map record at index 1, as the blob in block 2 at offset 100 with size 5 could not be read: Disk on fire")))
+
+		require.True(t, scrubber.ProcessRecord(ctx))
+	})
+}
+
+func TestScrubberReadBudget(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	recordArray := mock.NewMockLocationRecordArray(ctrl)
+	blockList := mock.NewMockBlockList(ctrl)
+	var lock sync.RWMutex
+	clock := mock.NewMockClock(ctrl)
+	clock.EXPECT().Now().Return(time.Unix(1000, 0))
+	errorLogger := mock.NewMockErrorLogger(ctrl)
+	scrubber, err := local.NewScrubber(
+		recordArray,
+		10,
+		blockList,
+		&lock,
+		[]digest.Function{digest.MustNewFunction("", remoteexecution.DigestFunction_SHA256)},
+		/* maximumCompressedSizeBytes = */ 0,
+		clock,
+		errorLogger,
+		/* maximumBytesPerSecond = */ 66,
+		"cas")
+	require.NoError(t, err)
+
+	// Reading the first record may be performed immediately.
+	clock.EXPECT().Now().Return(time.Unix(1000, 0))
+	recordArray.EXPECT().Get(0).Return(local.LocationRecord{}, local.ErrLocationRecordInvalid)
+	require.True(t, scrubber.ProcessRecord(ctx))
+
+	// Reading the second record should only be performed after a
+	// second has passed, as a record is 66 bytes in size.
+	clock.EXPECT().Now().Return(time.Unix(1000, 500000000))
+	timer := mock.NewMockTimer(ctrl)
+	timerChannel := make(chan time.Time, 1)
+	timerChannel <- time.Unix(1001, 0)
+	clock.EXPECT().NewTimer(500*time.Millisecond).Return(timer, timerChannel)
+	recordArray.EXPECT().Get(1).Return(local.LocationRecord{}, local.ErrLocationRecordInvalid)
+	require.True(t, scrubber.ProcessRecord(ctx))
+
+	// Cancelation of the context should cause ProcessRecord() to
+	// return while waiting.
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	clock.EXPECT().Now().Return(time.Unix(1001, 0))
+	clock.EXPECT().NewTimer(time.Second).Return(timer, make(chan time.Time))
+	timer.EXPECT().Stop()
+	require.False(t, scrubber.ProcessRecord(canceledCtx))
+}
@@ -0,0 +1,299 @@
+package local
+
+import (
+	"bytes"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	tieredBlockAllocatorPrometheusMetrics sync.Once
+
+	tieredBlockAllocatorDemotions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "tiered_block_allocator_demotions_total",
+			Help:      "Number of times blocks managed by TieredBlockAllocator were demoted from memory to the underlying block allocator",
+		},
+		[]string{"storage_type"})
+	tieredBlockAllocatorDemotedBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "tiered_block_allocator_demoted_bytes_total",
+			Help:      "Amount of data copied by TieredBlockAllocator from memory to the underlying block allocator, in bytes",
+		},
+		[]string{"storage_type"})
+	tieredBlockAllocatorHotBlocks = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "tiered_block_allocator_hot_blocks",
+			Help:      "Number of blocks managed by TieredBlockAllocator that are stored in memory",
+		},
+		[]string{"storage_type"})
+)
+
+type tieredBlockAllocator struct {
+	coldBlockAllocator BlockAllocator
+	readBufferFactory  blobstore.ReadBufferFactory
+	blockSizeBytes     int64
+	maximumHotBlocks   int
+	lock               *sync.RWMutex
+
+	// Blocks that are stored in memory, in the order in which they
+	// were allocated.
+	hotBlocks []*tieredBlock
+	// The error of the most recent demotion that failed, to be
+	// returned by the next call to NewBlock().
+	demotionErr error
+
+	demotions      prometheus.Counter
+	demotedBytes   prometheus.Counter
+	hotBlocksCount prometheus.Gauge
+}
+
+// NewTieredBlockAllocator creates a BlockAllocator that stores the most
+// recently allocated blocks in memory. Once the number of blocks stored
+// in memory exceeds a configured limit, the contents of the oldest of
+// these blocks are copied into a block obtained from an underlying
+// BlockAllocator, which is typically backed by a block device. From
+// that point on, the block is served from the underlying BlockAllocator.
+//
+// Blocks are demoted in the order in which they were allocated,
+// regardless of how frequently their contents are accessed. "Hot"
+// blocks are thus the most recently allocated ones. Recently accessed
+// blobs that are refreshed by OldCurrentNewLocationBlobMap are written
+// into the newest blocks, meaning they are stored in memory as well.
+// As demoted blocks retain their identity, there is no need to update
+// entries in the key-location map when demotion occurs.
+//
+// Blocks to demote are selected while allocating new blocks. Their
+// contents are copied in the background without holding the lock,
+// so that other operations are not blocked while data is written to
+// the underlying BlockAllocator. Once copying has completed, the block
+// is switched over while the exclusive lock is held. The lock must be
+// the one that is used by the LocationBlobMap that stores the blobs.
+// Blocks to which writes are still in progress are not demoted until a
+// subsequent allocation. This means that the number of blocks stored
+// in memory may temporarily exceed the configured limit.
+//
+// As blocks stored in memory are lost upon restart, this
+// implementation does not support persistency.
+func NewTieredBlockAllocator(coldBlockAllocator BlockAllocator, readBufferFactory blobstore.ReadBufferFactory, blockSizeBytes int64, maximumHotBlocks int, lock *sync.RWMutex, storageType string) BlockAllocator {
+	tieredBlockAllocatorPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(tieredBlockAllocatorDemotions)
+		prometheus.MustRegister(tieredBlockAllocatorDemotedBytes)
+		prometheus.MustRegister(tieredBlockAllocatorHotBlocks)
+	})
+
+	return &tieredBlockAllocator{
+		coldBlockAllocator: coldBlockAllocator,
+		readBufferFactory:  readBufferFactory,
+		blockSizeBytes:     blockSizeBytes,
+		maximumHotBlocks:   maximumHotBlocks,
+		lock:               lock,
+
+		demotions:      tieredBlockAllocatorDemotions.WithLabelValues(storageType),
+		demotedBytes:   tieredBlockAllocatorDemotedBytes.WithLabelValues(storageType),
+		hotBlocksCount: tieredBlockAllocatorHotBlocks.WithLabelValues(storageType),
+	}
+}
+
+// demoteHotBlocks starts demotion of the oldest blocks stored in
+// memory, until no more than a given number of blocks remain stored in
+// memory once all demotions have completed.
+func (ta *tieredBlockAllocator) demoteHotBlocks(maximumHotBlocks int) error {
+	// Iterate over a copy, as blocks that are demoted are removed
+	// from the list.
+	for _, tb := range slices.Clone(ta.hotBlocks[:max(len(ta.hotBlocks)-maximumHotBlocks, 0)]) {
+		if tb.demoting {
+			continue
+		}
+		if tb.pendingWrites.Load() > 0 {
+			// Data is still being written into the block.
+			// Retry during the next allocation.
+			return nil
+		}
+
+		coldBlock, _, err := ta.coldBlockAllocator.NewBlock()
+		if err != nil {
+			return util.StatusWrap(err, "Failed to allocate block for demotion")
+		}
+		sizeBytes := tb.writeOffsetBytes
+		if sizeBytes == 0 {
+			// Block is empty, meaning no data needs to be
+			// copied.
+			ta.completeDemotion(tb, coldBlock, sizeBytes, nil)
+			continue
+		}
+
+		// Write all data as a single blob, so that it ends up
+		// at the same offsets within the block.
+		tb.demoting = true
+		putWriter := coldBlock.Put(sizeBytes)
+		hotData := tb.hotData[:sizeBytes]
+		go func() {
+			putFinalizer := putWriter(buffer.NewValidatedBufferFromByteSlice(hotData))
+
+			ta.lock.Lock()
+			defer ta.lock.Unlock()
+			_, err := putFinalizer()
+			ta.completeDemotion(tb, coldBlock, sizeBytes, err)
+		}()
+	}
+	return nil
+}
+
+// completeDemotion switches a block stored in memory over to a block
+// obtained from the underlying BlockAllocator, after its contents have
+// been copied. This function must be called while the exclusive lock
+// is held.
+func (ta *tieredBlockAllocator) completeDemotion(tb *tieredBlock, coldBlock Block, sizeBytes int64, err error) {
+	tb.demoting = false
+	if tb.released {
+		// Block was released while its contents were being
+		// copied.
+		coldBlock.Release()
+		return
+	}
+	if err != nil {
+		coldBlock.Release()
+		ta.demotionErr = util.StatusWrap(err, "Failed to copy block for demotion")
+		return
+	}
+	if tb.writeOffsetBytes != sizeBytes {
+		// Data was written into the block while its contents
+		// were being copied. Retry during the next allocation.
+		coldBlock.Release()
+		return
+	}
+
+	// Buffers returned by Get() hold on to the data stored in
+	// memory, meaning it remains valid for them.
+	tb.coldBlock = coldBlock
+	tb.hotData = nil
+	ta.removeHotBlock(tb)
+	ta.demotions.Inc()
+	ta.demotedBytes.Add(float64(sizeBytes))
+}
+
+// removeHotBlock removes a block from the list of blocks that are
+// stored in memory.
+func (ta *tieredBlockAllocator) removeHotBlock(tb *tieredBlock) {
+	for i, hotBlock := range ta.hotBlocks {
+		if hotBlock == tb {
+			ta.hotBlocks = append(ta.hotBlocks[:i], ta.hotBlocks[i+1:]...)
+			break
+		}
+	}
+	ta.hotBlocksCount.Set(float64(len(ta.hotBlocks)))
+}
+
+func (ta *tieredBlockAllocator) NewBlock() (Block, *pb.BlockLocation, error) {
+	if err := ta.demotionErr; err != nil {
+		ta.demotionErr = nil
+		return nil, nil, err
+	}
+	if err := ta.demoteHotBlocks(ta.maximumHotBlocks - 1); err != nil {
+		return nil, nil, err
+	}
+	tb := &tieredBlock{
+		blockAllocator: ta,
+		hotData:        make([]byte, ta.blockSizeBytes),
+	}
+	ta.hotBlocks = append(ta.hotBlocks, tb)
+	ta.hotBlocksCount.Set(float64(len(ta.hotBlocks)))
+	return tb, nil, nil
+}
+
+func (ta *tieredBlockAllocator) NewBlockAtLocation(location *pb.BlockLocation, writeOffsetBytes int64) (Block, bool) {
+	// Blocks stored in memory cannot be accessed again.
+	return nil, false
+}
+
+// tieredBlock is a block handed out by TieredBlockAllocator. Its
+// contents are either stored in memory (hotData), or in a block
+// obtained from the underlying BlockAllocator (coldBlock).
+//
+// Calls against this type need no additional locking, as replacing
+// hotData with coldBlock only happens while the exclusive lock is held.
+type tieredBlock struct {
+	blockAllocator *tieredBlockAllocator
+
+	hotData          []byte
+	writeOffsetBytes int64
+	pendingWrites    atomic.Int32
+	demoting         bool
+	released         bool
+
+	coldBlock Block
+}
+
+func (tb *tieredBlock) Get(digest digest.Digest, offsetBytes, sizeBytes int64, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer {
+	if tb.coldBlock != nil {
+		return tb.coldBlock.Get(digest, offsetBytes, sizeBytes, dataIntegrityCallback)
+	}
+	return tb.blockAllocator.readBufferFactory.NewBufferFromByteSlice(
+		digest,
+		tb.hotData[offsetBytes:offsetBytes+sizeBytes],
+		dataIntegrityCallback)
+}
+
+func (tb *tieredBlock) GetRaw(offsetBytes, sizeBytes int64) buffer.Buffer {
+	if tb.coldBlock != nil {
+		return tb.coldBlock.GetRaw(offsetBytes, sizeBytes)
+	}
+	return buffer.NewValidatedBufferFromByteSlice(tb.hotData[offsetBytes : offsetBytes+sizeBytes])
+}
+
+func (tb *tieredBlock) HasSpace(sizeBytes int64) bool {
+	if tb.coldBlock != nil {
+		return tb.coldBlock.HasSpace(sizeBytes)
+	}
+	return tb.blockAllocator.blockSizeBytes-tb.writeOffsetBytes >= sizeBytes
+}
+
+func (tb *tieredBlock) Put(sizeBytes int64) BlockPutWriter {
+	if tb.coldBlock != nil {
+		return tb.coldBlock.Put(sizeBytes)
+	}
+
+	// Allocate space. Prevent the block from being demoted until
+	// the data has been ingested.
+	hotData := tb.hotData
+	offsetBytes := tb.writeOffsetBytes
+	tb.writeOffsetBytes += sizeBytes
+	tb.pendingWrites.Add(1)
+	return func(b buffer.Buffer) BlockPutFinalizer {
+		err := b.IntoWriter(bytes.NewBuffer(hotData[offsetBytes:offsetBytes]))
+		tb.pendingWrites.Add(-1)
+		return func() (int64, error) {
+			return offsetBytes, err
+		}
+	}
+}
+
+func (tb *tieredBlock) Release() {
+	if tb.coldBlock != nil {
+		tb.coldBlock.Release()
+		return
+	}
+
+	// Block is still stored in memory. Remove it from the list of
+	// blocks that are candidates for demotion. If demotion is in
+	// progress, the underlying block is released once copying has
+	// completed.
+	tb.blockAllocator.removeHotBlock(tb)
+	tb.hotData = nil
+	tb.released = true
+}
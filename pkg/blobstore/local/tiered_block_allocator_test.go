@@ -0,0 +1,198 @@
+package local_test
+
+import (
+	"sync"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestTieredBlockAllocator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	coldBlockAllocator := mock.NewMockBlockAllocator(ctrl)
+	var lock sync.RWMutex
+	blockAllocator := local.NewTieredBlockAllocator(coldBlockAllocator, blobstore.CASReadBufferFactory, 100, 2, &lock, "cas")
+	newBlock := func() (local.Block, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		block, _, err := blockAllocator.NewBlock()
+		return block, err
+	}
+	// Demotion copies data in the background, and completes while
+	// holding the lock. Demotion has thus completed once the lock
+	// can be acquired after the BlockPutFinalizer has been called.
+	copyingBlockPutWriter := func(data *[]byte, err error) (local.BlockPutWriter, <-chan struct{}) {
+		finalized := make(chan struct{})
+		return func(b buffer.Buffer) local.BlockPutFinalizer {
+			*data, _ = b.ToByteSlice(100)
+			return func() (int64, error) {
+				close(finalized)
+				return 0, err
+			}
+		}, finalized
+	}
+	waitForDemotion := func(finalized <-chan struct{}) {
+		<-finalized
+		lock.Lock()
+		lock.Unlock()
+	}
+
+	helloDigest := digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	dataIntegrityCallback := mock.NewMockDataIntegrityCallback(ctrl)
+
+	// The first two blocks should be stored in memory.
+	block1, location, err := blockAllocator.NewBlock()
+	require.NoError(t, err)
+	require.Nil(t, location)
+	block2, err := newBlock()
+	require.NoError(t, err)
+
+	offsetBytes, err := block1.Put(5)(buffer.NewValidatedBufferFromByteSlice([]byte("World")))()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), offsetBytes)
+	offsetBytes, err = block1.Put(5)(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), offsetBytes)
+	require.True(t, block1.HasSpace(90))
+	require.False(t, block1.HasSpace(91))
+
+	// Reads of blocks stored in memory should be validated.
+	dataIntegrityCallback.EXPECT().Call(true)
+	data, err := block1.Get(helloDigest, 5, 5, dataIntegrityCallback.Call).ToByteSlice(100)
+	require.NoError(t, err)
+	require.Equal(t, []byte("Hello"), data)
+
+	dataIntegrityCallback.EXPECT().Call(false)
+	_, err = block1.Get(helloDigest, 0, 5, dataIntegrityCallback.Call).ToByteSlice(100)
+	testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Buffer has checksum f5a7924e621e84c9280a9a27e1bcb7f6, while 8b1a9953c4611296a827abf8c47804d7 was expected"), err)
+
+	// Start a write into the second block, but don't complete it.
+	// This should prevent the block from being demoted.
+	putWriter := block2.Put(5)
+
+	// Allocating a third block should cause the first block to be
+	// demoted. Its contents should be copied into a block from the
+	// underlying allocator in its entirety.
+	coldBlock1 := mock.NewMockBlock(ctrl)
+	coldBlockAllocator.EXPECT().NewBlock().Return(coldBlock1, nil, nil)
+	var copiedData1 []byte
+	coldBlockPutWriter1, finalized1 := copyingBlockPutWriter(&copiedData1, nil)
+	coldBlock1.EXPECT().Put(int64(10)).Return(coldBlockPutWriter1)
+	block3, err := newBlock()
+	require.NoError(t, err)
+	waitForDemotion(finalized1)
+	require.Equal(t, []byte("WorldHello"), copiedData1)
+
+	// Calls against the first block should now be forwarded to the
+	// block from the underlying allocator.
+	coldBlock1.EXPECT().Get(helloDigest, int64(5), int64(5), gomock.Any()).
+		Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+	data, err = block1.Get(helloDigest, 5, 5, dataIntegrityCallback.Call).ToByteSlice(100)
+	require.NoError(t, err)
+	require.Equal(t, []byte("Hello"), data)
+
+	coldBlock1.EXPECT().HasSpace(int64(90)).Return(true)
+	require.True(t, block1.HasSpace(90))
+
+	// Allocating a fourth block should not cause the second block
+	// to be demoted, as a write is still in progress.
+	block4, err := newBlock()
+	require.NoError(t, err)
+
+	// Once the write completes, both the second and third block
+	// should be demoted. As the third block is empty, no data needs
+	// to be copied.
+	offsetBytes, err = putWriter(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), offsetBytes)
+
+	coldBlock2 := mock.NewMockBlock(ctrl)
+	coldBlock3 := mock.NewMockBlock(ctrl)
+	var copiedData2 []byte
+	coldBlockPutWriter2, finalized2 := copyingBlockPutWriter(&copiedData2, nil)
+	gomock.InOrder(
+		coldBlockAllocator.EXPECT().NewBlock().Return(coldBlock2, nil, nil),
+		coldBlock2.EXPECT().Put(int64(5)).Return(coldBlockPutWriter2),
+		coldBlockAllocator.EXPECT().NewBlock().Return(coldBlock3, nil, nil))
+	block5, err := newBlock()
+	require.NoError(t, err)
+	waitForDemotion(finalized2)
+	require.Equal(t, []byte("Hello"), copiedData2)
+
+	// Releasing blocks should release the underlying block if the
+	// block was demoted.
+	coldBlock1.EXPECT().Release()
+	block1.Release()
+	coldBlock2.EXPECT().Release()
+	block2.Release()
+	coldBlock3.EXPECT().Release()
+	block3.Release()
+
+	// Releasing a block that is stored in memory should prevent it
+	// from being demoted. The next allocation should thus not
+	// require any demotion.
+	block4.Release()
+	_, err = newBlock()
+	require.NoError(t, err)
+
+	// Failures allocating blocks from the underlying allocator
+	// should be propagated.
+	_, err = block5.Put(5)(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))()
+	require.NoError(t, err)
+	coldBlockAllocator.EXPECT().NewBlock().Return(nil, nil, status.Error(codes.Unavailable, "No unused blocks available"))
+	_, err = newBlock()
+	testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Failed to allocate block for demotion: No unused blocks available"), err)
+
+	// Failures copying data into blocks from the underlying
+	// allocator should be propagated by the next allocation. The
+	// block should remain stored in memory.
+	coldBlock5 := mock.NewMockBlock(ctrl)
+	coldBlockAllocator.EXPECT().NewBlock().Return(coldBlock5, nil, nil)
+	var copiedData5 []byte
+	coldBlockPutWriter5, finalized5 := copyingBlockPutWriter(&copiedData5, status.Error(codes.Internal, "Disk on fire"))
+	coldBlock5.EXPECT().Put(int64(5)).Return(coldBlockPutWriter5)
+	coldBlock5.EXPECT().Release()
+	_, err = newBlock()
+	require.NoError(t, err)
+	waitForDemotion(finalized5)
+
+	_, err = newBlock()
+	testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Failed to copy block for demotion: Disk on fire"), err)
+
+	dataIntegrityCallback.EXPECT().Call(true)
+	data, err = block5.Get(helloDigest, 0, 5, dataIntegrityCallback.Call).ToByteSlice(100)
+	require.NoError(t, err)
+	require.Equal(t, []byte("Hello"), data)
+
+	// Blocks that are released while their contents are being
+	// copied should have their underlying block released once
+	// copying has completed. The sixth block is empty, meaning it
+	// can be demoted immediately.
+	coldBlock5 = mock.NewMockBlock(ctrl)
+	coldBlock6 := mock.NewMockBlock(ctrl)
+	coldBlockPutWriter5, finalized5 = copyingBlockPutWriter(&copiedData5, nil)
+	gomock.InOrder(
+		coldBlockAllocator.EXPECT().NewBlock().Return(coldBlock5, nil, nil),
+		coldBlock5.EXPECT().Put(int64(5)).Return(coldBlockPutWriter5),
+		coldBlockAllocator.EXPECT().NewBlock().Return(coldBlock6, nil, nil))
+	lock.Lock()
+	_, _, err = blockAllocator.NewBlock()
+	require.NoError(t, err)
+	block5.Release()
+	coldBlock5.EXPECT().Release()
+	lock.Unlock()
+	waitForDemotion(finalized5)
+}
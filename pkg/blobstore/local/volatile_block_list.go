@@ -79,6 +79,10 @@ func (bl *volatileBlockList) Get(index int, digest digest.Digest, offsetBytes, s
 	return bl.blocks[index].block.Get(digest, offsetBytes, sizeBytes, dataIntegrityCallback)
 }
 
+func (bl *volatileBlockList) GetRaw(index int, offsetBytes, sizeBytes int64) buffer.Buffer {
+	return bl.blocks[index].block.GetRaw(offsetBytes, sizeBytes)
+}
+
 func (bl *volatileBlockList) HasSpace(index int, sizeBytes int64) bool {
 	return bl.blocks[index].block.HasSpace(sizeBytes)
 }
@@ -2,6 +2,7 @@ package blobstore
 
 import (
 	"context"
+	"iter"
 	"sync"
 	"time"
 
@@ -38,6 +39,15 @@ var (
 			Buckets:   prometheus.ExponentialBuckets(1.0, 2.0, 17),
 		},
 		[]string{"storage_type", "backend_type"})
+	blobAccessOperationsFindMissingStreamDigestsCount = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "blob_access_operations_find_missing_stream_digests_count",
+			Help:      "Number of digests provided to FindMissingStream().",
+			Buckets:   prometheus.ExponentialBuckets(1.0, 2.0, 21),
+		},
+		[]string{"storage_type", "backend_type"})
 	blobAccessOperationsDurationSeconds = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: "buildbarn",
@@ -53,15 +63,17 @@ type metricsBlobAccess struct {
 	blobAccess BlobAccess
 	clock      clock.Clock
 
-	getBlobSizeBytes                prometheus.Observer
-	getDurationSeconds              prometheus.ObserverVec
-	getFromCompositeBlobSizeBytes   prometheus.Observer
-	getFromCompositeDurationSeconds prometheus.ObserverVec
-	putBlobSizeBytes                prometheus.Observer
-	putDurationSeconds              prometheus.ObserverVec
-	findMissingBatchSize            prometheus.Observer
-	findMissingDurationSeconds      prometheus.ObserverVec
-	getCapabilitiesSeconds          prometheus.ObserverVec
+	getBlobSizeBytes                 prometheus.Observer
+	getDurationSeconds               prometheus.ObserverVec
+	getFromCompositeBlobSizeBytes    prometheus.Observer
+	getFromCompositeDurationSeconds  prometheus.ObserverVec
+	putBlobSizeBytes                 prometheus.Observer
+	putDurationSeconds               prometheus.ObserverVec
+	findMissingBatchSize             prometheus.Observer
+	findMissingDurationSeconds       prometheus.ObserverVec
+	findMissingStreamDigestsCount    prometheus.Observer
+	findMissingStreamDurationSeconds prometheus.ObserverVec
+	getCapabilitiesSeconds           prometheus.ObserverVec
 }
 
 // NewMetricsBlobAccess creates an adapter for BlobAccess that adds
@@ -70,6 +82,7 @@ func NewMetricsBlobAccess(blobAccess BlobAccess, clock clock.Clock, storageType,
 	blobAccessOperationsPrometheusMetrics.Do(func() {
 		prometheus.MustRegister(blobAccessOperationsBlobSizeBytes)
 		prometheus.MustRegister(blobAccessOperationsFindMissingBatchSize)
+		prometheus.MustRegister(blobAccessOperationsFindMissingStreamDigestsCount)
 		prometheus.MustRegister(blobAccessOperationsDurationSeconds)
 	})
 
@@ -77,15 +90,17 @@ func NewMetricsBlobAccess(blobAccess BlobAccess, clock clock.Clock, storageType,
 		blobAccess: blobAccess,
 		clock:      clock,
 
-		getBlobSizeBytes:                blobAccessOperationsBlobSizeBytes.WithLabelValues(storageType, backendType, "Get"),
-		getDurationSeconds:              blobAccessOperationsDurationSeconds.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "Get"}),
-		getFromCompositeBlobSizeBytes:   blobAccessOperationsBlobSizeBytes.WithLabelValues(storageType, backendType, "GetFromComposite"),
-		getFromCompositeDurationSeconds: blobAccessOperationsDurationSeconds.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "GetFromComposite"}),
-		putBlobSizeBytes:                blobAccessOperationsBlobSizeBytes.WithLabelValues(storageType, backendType, "Put"),
-		putDurationSeconds:              blobAccessOperationsDurationSeconds.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "Put"}),
-		findMissingBatchSize:            blobAccessOperationsFindMissingBatchSize.WithLabelValues(storageType, backendType),
-		findMissingDurationSeconds:      blobAccessOperationsDurationSeconds.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "FindMissing"}),
-		getCapabilitiesSeconds:          blobAccessOperationsDurationSeconds.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "GetCapabilities"}),
+		getBlobSizeBytes:                 blobAccessOperationsBlobSizeBytes.WithLabelValues(storageType, backendType, "Get"),
+		getDurationSeconds:               blobAccessOperationsDurationSeconds.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "Get"}),
+		getFromCompositeBlobSizeBytes:    blobAccessOperationsBlobSizeBytes.WithLabelValues(storageType, backendType, "GetFromComposite"),
+		getFromCompositeDurationSeconds:  blobAccessOperationsDurationSeconds.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "GetFromComposite"}),
+		putBlobSizeBytes:                 blobAccessOperationsBlobSizeBytes.WithLabelValues(storageType, backendType, "Put"),
+		putDurationSeconds:               blobAccessOperationsDurationSeconds.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "Put"}),
+		findMissingBatchSize:             blobAccessOperationsFindMissingBatchSize.WithLabelValues(storageType, backendType),
+		findMissingDurationSeconds:       blobAccessOperationsDurationSeconds.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "FindMissing"}),
+		findMissingStreamDigestsCount:    blobAccessOperationsFindMissingStreamDigestsCount.WithLabelValues(storageType, backendType),
+		findMissingStreamDurationSeconds: blobAccessOperationsDurationSeconds.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "FindMissingStream"}),
+		getCapabilitiesSeconds:           blobAccessOperationsDurationSeconds.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "GetCapabilities"}),
 	}
 }
 
@@ -157,6 +172,29 @@ func (ba *metricsBlobAccess) FindMissing(ctx context.Context, digests digest.Set
 	return digests, err
 }
 
+func (ba *metricsBlobAccess) FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+	digestsCount := 0
+	timeStart := ba.clock.Now()
+	err := ba.blobAccess.FindMissingStream(
+		ctx,
+		func(yield func(digest.Digest) bool) {
+			for blobDigest := range digests {
+				digestsCount++
+				if !yield(blobDigest) {
+					return
+				}
+			}
+		},
+		reportMissing)
+
+	// Similar to FindMissing(), discard metrics for empty streams.
+	if digestsCount > 0 {
+		ba.findMissingStreamDigestsCount.Observe(float64(digestsCount))
+		ba.updateDurationSeconds(ba.findMissingStreamDurationSeconds, status.Code(err), timeStart)
+	}
+	return err
+}
+
 func (ba *metricsBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
 	timeStart := ba.clock.Now()
 	capabilities, err := ba.blobAccess.GetCapabilities(ctx, instanceName)
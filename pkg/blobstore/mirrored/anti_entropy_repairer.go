@@ -0,0 +1,297 @@
+package mirrored
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/replication"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	antiEntropyRepairerPrometheusMetrics sync.Once
+
+	antiEntropyRepairerBlobs = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "mirrored_anti_entropy_repairer_blobs_total",
+			Help:      "Number of blobs processed by the anti-entropy repairer of MirroredBlobAccess, partitioned by outcome",
+		},
+		[]string{"storage_type", "outcome"})
+)
+
+// AntiEntropyReport contains the number of blobs processed by
+// AntiEntropyRepairer, partitioned by outcome.
+type AntiEntropyReport struct {
+	// Blobs that were present in both backends.
+	Consistent int
+	// Blobs that were only present in backend A, and were
+	// replicated to backend B.
+	ReplicatedAToB int
+	// Blobs that were only present in backend B, and were
+	// replicated to backend A.
+	ReplicatedBToA int
+	// Blobs that were present in neither backend.
+	MissingFromBoth int
+	// Blobs for which an error occurred, either while checking for
+	// their existence or while replicating them.
+	Failed int
+}
+
+// Add the counts of another report to this report.
+func (r *AntiEntropyReport) Add(other AntiEntropyReport) {
+	r.Consistent += other.Consistent
+	r.ReplicatedAToB += other.ReplicatedAToB
+	r.ReplicatedBToA += other.ReplicatedBToA
+	r.MissingFromBoth += other.MissingFromBoth
+	r.Failed += other.Failed
+}
+
+// AntiEntropyRepairer can be used to make the backends of a mirrored
+// pair consistent, without depending on clients to access the blobs
+// that are only present in one of the backends. It does this by calling
+// FindMissing() on both backends for a sequence of digests, and
+// replicating any blobs that are only present in one of the backends
+// to the other.
+//
+// As keys of blobs cannot be enumerated through the BlobAccess
+// interface, the digests to check need to be obtained separately.
+// Repair() checks a sequence of digests provided by the caller, which
+// may, for example, be obtained by exporting the digests of all blobs
+// stored in a LocalBlobAccess using bb_local_inspect. RepairDigestRanges()
+// compares the contents of backends that are capable of enumerating
+// their contents, and only checks the digests of blobs that are
+// present in one of the backends.
+type AntiEntropyRepairer struct {
+	backendA              blobstore.BlobAccess
+	backendB              blobstore.BlobAccess
+	replicatorAToB        replication.BlobReplicator
+	replicatorBToA        replication.BlobReplicator
+	clock                 clock.Clock
+	errorLogger           util.ErrorLogger
+	batchSize             int
+	maximumBlobsPerSecond float64
+
+	nextBatchTime time.Time
+
+	blobsConsistent      prometheus.Counter
+	blobsReplicatedAToB  prometheus.Counter
+	blobsReplicatedBToA  prometheus.Counter
+	blobsMissingFromBoth prometheus.Counter
+	blobsFailed          prometheus.Counter
+}
+
+// NewAntiEntropyRepairer creates an AntiEntropyRepairer for a mirrored
+// pair of backends. Digests are checked in batches of a given size.
+// The rate at which digests are checked is limited to a configured
+// number of blobs per second.
+func NewAntiEntropyRepairer(backendA, backendB blobstore.BlobAccess, replicatorAToB, replicatorBToA replication.BlobReplicator, clock clock.Clock, errorLogger util.ErrorLogger, batchSize int, maximumBlobsPerSecond float64, storageType string) *AntiEntropyRepairer {
+	antiEntropyRepairerPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(antiEntropyRepairerBlobs)
+	})
+
+	return &AntiEntropyRepairer{
+		backendA:              backendA,
+		backendB:              backendB,
+		replicatorAToB:        replicatorAToB,
+		replicatorBToA:        replicatorBToA,
+		clock:                 clock,
+		errorLogger:           errorLogger,
+		batchSize:             batchSize,
+		maximumBlobsPerSecond: maximumBlobsPerSecond,
+
+		nextBatchTime: clock.Now(),
+
+		blobsConsistent:      antiEntropyRepairerBlobs.WithLabelValues(storageType, "Consistent"),
+		blobsReplicatedAToB:  antiEntropyRepairerBlobs.WithLabelValues(storageType, "ReplicatedAToB"),
+		blobsReplicatedBToA:  antiEntropyRepairerBlobs.WithLabelValues(storageType, "ReplicatedBToA"),
+		blobsMissingFromBoth: antiEntropyRepairerBlobs.WithLabelValues(storageType, "MissingFromBoth"),
+		blobsFailed:          antiEntropyRepairerBlobs.WithLabelValues(storageType, "Failed"),
+	}
+}
+
+// waitForBudget blocks until checking a given number of blobs does not
+// cause the maximum rate to be exceeded. It returns false if the
+// provided context is canceled.
+func (r *AntiEntropyRepairer) waitForBudget(ctx context.Context, blobsCount int) bool {
+	now := r.clock.Now()
+	if r.nextBatchTime.Before(now) {
+		r.nextBatchTime = now
+	}
+	delay := r.nextBatchTime.Sub(now)
+	r.nextBatchTime = r.nextBatchTime.Add(time.Duration(float64(blobsCount) / r.maximumBlobsPerSecond * float64(time.Second)))
+	if delay <= 0 {
+		return true
+	}
+
+	timer, t := r.clock.NewTimer(delay)
+	select {
+	case <-t:
+		return true
+	case <-ctx.Done():
+		timer.Stop()
+		return false
+	}
+}
+
+// repairBatch checks the existence of a single batch of blobs in both
+// backends, and repairs any inconsistencies.
+func (r *AntiEntropyRepairer) repairBatch(ctx context.Context, digests digest.Set, report *AntiEntropyReport) {
+	missingFromA, missingFromBoth, missingFromB, err := synchronize(ctx, r.backendA, r.backendB, r.replicatorAToB, r.replicatorBToA, digests)
+	if err != nil {
+		report.Failed += digests.Length()
+		r.blobsFailed.Add(float64(digests.Length()))
+		r.errorLogger.Log(util.StatusWrapf(err, "Failed to repair batch of %d blobs", digests.Length()))
+		return
+	}
+
+	consistent := digests.Length() - missingFromA.Length() - missingFromBoth.Length() - missingFromB.Length()
+	report.Consistent += consistent
+	report.ReplicatedAToB += missingFromB.Length()
+	report.ReplicatedBToA += missingFromA.Length()
+	report.MissingFromBoth += missingFromBoth.Length()
+	r.blobsConsistent.Add(float64(consistent))
+	r.blobsReplicatedAToB.Add(float64(missingFromB.Length()))
+	r.blobsReplicatedBToA.Add(float64(missingFromA.Length()))
+	r.blobsMissingFromBoth.Add(float64(missingFromBoth.Length()))
+}
+
+// Repair the backends of the mirrored pair for a sequence of digests.
+// Failures to repair individual batches are logged and counted, but do
+// not cause the process to stop. This function only returns an error
+// if the provided context is canceled. In that case the report only
+// accounts for the blobs processed up to that point.
+func (r *AntiEntropyRepairer) Repair(ctx context.Context, digests iter.Seq[digest.Digest]) (AntiEntropyReport, error) {
+	var report AntiEntropyReport
+	batch := digest.NewSetBuilder()
+	flush := func() bool {
+		batchDigests := batch.Build()
+		batch = digest.NewSetBuilder()
+		if !r.waitForBudget(ctx, batchDigests.Length()) {
+			return false
+		}
+		r.repairBatch(ctx, batchDigests, &report)
+		return true
+	}
+
+	for blobDigest := range digests {
+		batch.Add(blobDigest)
+		if batch.Length() >= r.batchSize && !flush() {
+			return report, util.StatusFromContext(ctx)
+		}
+	}
+	if batch.Length() > 0 && !flush() {
+		return report, util.StatusFromContext(ctx)
+	}
+	if err := ctx.Err(); err != nil {
+		return report, util.StatusFromContext(ctx)
+	}
+	return report, nil
+}
+
+// listDigestRanges obtains the keys of all blobs stored in a set of
+// ranges in one of the backends.
+func listDigestRanges(ctx context.Context, summarizer blobstore.DigestRangeSummarizer, instanceNamePrefix digest.InstanceName, rangeBits int, ranges []uint32) (map[string]digest.Digest, error) {
+	digests := map[string]digest.Digest{}
+	if err := summarizer.ListDigests(ctx, instanceNamePrefix, rangeBits, ranges, func(blobDigest digest.Digest) error {
+		digests[blobDigest.GetKey(digest.KeyWithInstance)] = blobDigest
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return digests, nil
+}
+
+// repairDigestRanges lists the blobs in a set of ranges in both
+// backends, and repairs the blobs that are only reported by one of
+// them.
+func (r *AntiEntropyRepairer) repairDigestRanges(ctx context.Context, summarizerA, summarizerB blobstore.DigestRangeSummarizer, instanceNamePrefix digest.InstanceName, rangeBits int, ranges []uint32, report *AntiEntropyReport) error {
+	digestsA, err := listDigestRanges(ctx, summarizerA, instanceNamePrefix, rangeBits, ranges)
+	if err != nil {
+		return util.StatusWrap(err, "Failed to list digests in backend A")
+	}
+	digestsB, err := listDigestRanges(ctx, summarizerB, instanceNamePrefix, rangeBits, ranges)
+	if err != nil {
+		return util.StatusWrap(err, "Failed to list digests in backend B")
+	}
+
+	var differingDigests []digest.Digest
+	for key, blobDigest := range digestsA {
+		if _, ok := digestsB[key]; ok {
+			delete(digestsB, key)
+		} else {
+			differingDigests = append(differingDigests, blobDigest)
+		}
+	}
+	for _, blobDigest := range digestsB {
+		differingDigests = append(differingDigests, blobDigest)
+	}
+
+	rangesReport, err := r.Repair(ctx, slices.Values(differingDigests))
+	report.Add(rangesReport)
+	return err
+}
+
+// RepairDigestRanges compares the contents of both backends of the
+// mirrored pair, and repairs the blobs that are only present in one of
+// them. Blobs are partitioned into 2^rangeBits ranges. Only the blobs
+// in ranges whose summaries differ are listed. Ranges are listed in
+// groups, such that the number of blobs listed at once does not exceed
+// maximumBlobsPerListing, unless a single range contains more blobs.
+//
+// Blobs that are reported by both backends are not checked, meaning
+// that they are not accounted for in the report. Blobs that are
+// written while the backends are compared may be reported by one of
+// the backends. These are checked like any other blob, meaning they
+// are reported as consistent if both backends contain them by the time
+// they are checked.
+func (r *AntiEntropyRepairer) RepairDigestRanges(ctx context.Context, summarizerA, summarizerB blobstore.DigestRangeSummarizer, instanceNamePrefix digest.InstanceName, rangeBits int, maximumBlobsPerListing uint64) (AntiEntropyReport, error) {
+	var report AntiEntropyReport
+	summariesA, err := summarizerA.SummarizeDigestRanges(ctx, instanceNamePrefix, rangeBits)
+	if err != nil {
+		return report, util.StatusWrap(err, "Failed to summarize digest ranges of backend A")
+	}
+	summariesB, err := summarizerB.SummarizeDigestRanges(ctx, instanceNamePrefix, rangeBits)
+	if err != nil {
+		return report, util.StatusWrap(err, "Failed to summarize digest ranges of backend B")
+	}
+	if len(summariesA) != len(summariesB) {
+		return report, status.Errorf(codes.Internal, "Backend A returned %d digest ranges, while backend B returned %d", len(summariesA), len(summariesB))
+	}
+
+	var ranges []uint32
+	var blobsCount uint64
+	for i, summaryA := range summariesA {
+		summaryB := summariesB[i]
+		if summaryA == summaryB {
+			continue
+		}
+		rangeBlobsCount := summaryA.BlobsCount + summaryB.BlobsCount
+		if len(ranges) > 0 && blobsCount+rangeBlobsCount > maximumBlobsPerListing {
+			if err := r.repairDigestRanges(ctx, summarizerA, summarizerB, instanceNamePrefix, rangeBits, ranges, &report); err != nil {
+				return report, err
+			}
+			ranges = ranges[:0]
+			blobsCount = 0
+		}
+		ranges = append(ranges, uint32(i))
+		blobsCount += rangeBlobsCount
+	}
+	if len(ranges) > 0 {
+		if err := r.repairDigestRanges(ctx, summarizerA, summarizerB, instanceNamePrefix, rangeBits, ranges, &report); err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
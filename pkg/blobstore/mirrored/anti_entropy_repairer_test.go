@@ -0,0 +1,157 @@
+package mirrored_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/mirrored"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestAntiEntropyRepairer(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	backendA := mock.NewMockBlobAccess(ctrl)
+	backendB := mock.NewMockBlobAccess(ctrl)
+	replicatorAToB := mock.NewMockBlobReplicator(ctrl)
+	replicatorBToA := mock.NewMockBlobReplicator(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	errorLogger := mock.NewMockErrorLogger(ctrl)
+
+	clock.EXPECT().Now().Return(time.Unix(1000, 0))
+	repairer := mirrored.NewAntiEntropyRepairer(backendA, backendB, replicatorAToB, replicatorBToA, clock, errorLogger, 3, 2, "cas")
+
+	digest1 := digest.MustNewDigest("default", remoteexecution.DigestFunction_MD5, "00000000000000000000000000000001", 1)
+	digest2 := digest.MustNewDigest("default", remoteexecution.DigestFunction_MD5, "00000000000000000000000000000002", 2)
+	digest3 := digest.MustNewDigest("default", remoteexecution.DigestFunction_MD5, "00000000000000000000000000000003", 3)
+	digest4 := digest.MustNewDigest("default", remoteexecution.DigestFunction_MD5, "00000000000000000000000000000004", 4)
+	digest5 := digest.MustNewDigest("default", remoteexecution.DigestFunction_MD5, "00000000000000000000000000000005", 5)
+
+	t.Run("Success", func(t *testing.T) {
+		// The first batch of three blobs can be processed
+		// immediately. It contains one blob that is missing
+		// from each backend, and one that is consistent.
+		batch1 := digest.NewSetBuilder().Add(digest1).Add(digest2).Add(digest3).Build()
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		backendA.EXPECT().FindMissing(gomock.Any(), batch1).Return(digest1.ToSingletonSet(), nil)
+		backendB.EXPECT().FindMissing(gomock.Any(), batch1).Return(digest2.ToSingletonSet(), nil)
+		replicatorAToB.EXPECT().ReplicateMultiple(gomock.Any(), digest2.ToSingletonSet())
+		replicatorBToA.EXPECT().ReplicateMultiple(gomock.Any(), digest1.ToSingletonSet())
+
+		// The second batch is smaller. As the maximum rate is
+		// two blobs per second, it may only be processed 1.5
+		// seconds after the first. It contains a blob that is
+		// missing from both backends.
+		batch2 := digest.NewSetBuilder().Add(digest4).Add(digest5).Build()
+		clock.EXPECT().Now().Return(time.Unix(1000, 500000000))
+		timer := mock.NewMockTimer(ctrl)
+		timerChannel := make(chan time.Time, 1)
+		timerChannel <- time.Unix(1001, 500000000)
+		clock.EXPECT().NewTimer(time.Second).Return(timer, timerChannel)
+		backendA.EXPECT().FindMissing(gomock.Any(), batch2).Return(digest5.ToSingletonSet(), nil)
+		backendB.EXPECT().FindMissing(gomock.Any(), batch2).Return(digest5.ToSingletonSet(), nil)
+		replicatorAToB.EXPECT().ReplicateMultiple(gomock.Any(), digest.EmptySet)
+		replicatorBToA.EXPECT().ReplicateMultiple(gomock.Any(), digest.EmptySet)
+
+		report, err := repairer.Repair(ctx, slices.Values([]digest.Digest{digest1, digest2, digest3, digest4, digest5}))
+		require.NoError(t, err)
+		require.Equal(t, mirrored.AntiEntropyReport{
+			Consistent:      2,
+			ReplicatedAToB:  1,
+			ReplicatedBToA:  1,
+			MissingFromBoth: 1,
+		}, report)
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		// Failures should be logged and counted, but should not
+		// cause the repairer to stop.
+		clock.EXPECT().Now().Return(time.Unix(1010, 0))
+		backendA.EXPECT().FindMissing(gomock.Any(), digest1.ToSingletonSet()).
+			Return(digest.EmptySet, status.Error(codes.Unavailable, "Server not reachable"))
+		backendB.EXPECT().FindMissing(gomock.Any(), digest1.ToSingletonSet()).
+			Return(digest.EmptySet, nil).
+			AnyTimes()
+		errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.Unavailable, "Failed to repair batch of 1 blobs: Backend A: Server not reachable")))
+
+		report, err := repairer.Repair(ctx, slices.Values([]digest.Digest{digest1}))
+		require.NoError(t, err)
+		require.Equal(t, mirrored.AntiEntropyReport{
+			Failed: 1,
+		}, report)
+	})
+
+	t.Run("RepairDigestRanges", func(t *testing.T) {
+		// Only ranges whose summaries differ should be listed.
+		// Ranges should be grouped, so that at most three blobs
+		// are listed at once.
+		summarizerA := mock.NewMockDigestRangeSummarizer(ctrl)
+		summarizerB := mock.NewMockDigestRangeSummarizer(ctrl)
+		summarizerA.EXPECT().SummarizeDigestRanges(ctx, digest.MustNewInstanceName("default"), 2).Return([]blobstore.DigestRangeSummary{
+			{BlobsCount: 1, Hash: [32]byte{1}},
+			{BlobsCount: 1, Hash: [32]byte{2}},
+			{BlobsCount: 1, Hash: [32]byte{3}},
+			{BlobsCount: 2, Hash: [32]byte{4}},
+		}, nil)
+		summarizerB.EXPECT().SummarizeDigestRanges(ctx, digest.MustNewInstanceName("default"), 2).Return([]blobstore.DigestRangeSummary{
+			{},
+			{BlobsCount: 1, Hash: [32]byte{5}},
+			{BlobsCount: 1, Hash: [32]byte{3}},
+			{BlobsCount: 1, Hash: [32]byte{6}},
+		}, nil)
+		reportDigests := func(digests ...digest.Digest) func(ctx context.Context, instanceNamePrefix digest.InstanceName, rangeBits int, ranges []uint32, reportDigest func(digest.Digest) error) error {
+			return func(ctx context.Context, instanceNamePrefix digest.InstanceName, rangeBits int, ranges []uint32, reportDigest func(digest.Digest) error) error {
+				for _, blobDigest := range digests {
+					if err := reportDigest(blobDigest); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+		}
+
+		// The first group consists of ranges 0 and 1. Only
+		// backend A contains digest 2.
+		summarizerA.EXPECT().ListDigests(ctx, digest.MustNewInstanceName("default"), 2, []uint32{0, 1}, gomock.Any()).
+			DoAndReturn(reportDigests(digest2, digest4))
+		summarizerB.EXPECT().ListDigests(ctx, digest.MustNewInstanceName("default"), 2, []uint32{0, 1}, gomock.Any()).
+			DoAndReturn(reportDigests(digest4))
+		clock.EXPECT().Now().Return(time.Unix(1100, 0))
+		backendA.EXPECT().FindMissing(gomock.Any(), digest2.ToSingletonSet()).Return(digest.EmptySet, nil)
+		backendB.EXPECT().FindMissing(gomock.Any(), digest2.ToSingletonSet()).Return(digest2.ToSingletonSet(), nil)
+		replicatorAToB.EXPECT().ReplicateMultiple(gomock.Any(), digest2.ToSingletonSet())
+		replicatorBToA.EXPECT().ReplicateMultiple(gomock.Any(), digest.EmptySet)
+
+		// The second group consists of range 3. Backend A
+		// contains digest 3, while backend B contains digest 5.
+		batch := digest.NewSetBuilder().Add(digest3).Add(digest5).Build()
+		summarizerA.EXPECT().ListDigests(ctx, digest.MustNewInstanceName("default"), 2, []uint32{3}, gomock.Any()).
+			DoAndReturn(reportDigests(digest3, digest4))
+		summarizerB.EXPECT().ListDigests(ctx, digest.MustNewInstanceName("default"), 2, []uint32{3}, gomock.Any()).
+			DoAndReturn(reportDigests(digest4, digest5))
+		clock.EXPECT().Now().Return(time.Unix(1200, 0))
+		backendA.EXPECT().FindMissing(gomock.Any(), batch).Return(digest5.ToSingletonSet(), nil)
+		backendB.EXPECT().FindMissing(gomock.Any(), batch).Return(digest3.ToSingletonSet(), nil)
+		replicatorAToB.EXPECT().ReplicateMultiple(gomock.Any(), digest3.ToSingletonSet())
+		replicatorBToA.EXPECT().ReplicateMultiple(gomock.Any(), digest5.ToSingletonSet())
+
+		report, err := repairer.RepairDigestRanges(ctx, summarizerA, summarizerB, digest.MustNewInstanceName("default"), 2, 3)
+		require.NoError(t, err)
+		require.Equal(t, mirrored.AntiEntropyReport{
+			ReplicatedAToB: 2,
+			ReplicatedBToA: 1,
+		}, report)
+	})
+}
@@ -2,6 +2,7 @@ package mirrored
 
 import (
 	"context"
+	"iter"
 	"sync"
 	"sync/atomic"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/blobstore/replication"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
 	"github.com/buildbarn/bb-storage/pkg/digest"
+	replicator_pb "github.com/buildbarn/bb-storage/pkg/proto/replicator"
 	"github.com/buildbarn/bb-storage/pkg/util"
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -131,12 +133,26 @@ func (ba *mirroredBlobAccess) Put(ctx context.Context, digest digest.Digest, b b
 }
 
 func (ba *mirroredBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
+	missingFromA, missingFromBoth, missingFromB, err := synchronize(ctx, ba.backendA, ba.backendB, ba.replicatorAToB, ba.replicatorBToA, digests)
+	if err != nil {
+		return digest.EmptySet, err
+	}
+	mirroredBlobAccessFindMissingSynchronizationsFromAToB.Observe(float64(missingFromB.Length()))
+	mirroredBlobAccessFindMissingSynchronizationsFromBToA.Observe(float64(missingFromA.Length()))
+	return missingFromBoth, nil
+}
+
+// synchronize calls FindMissing() on both backends of a mirrored pair,
+// and replicates blobs that are only present in one of the backends to
+// the other. It returns the sets of blobs that were missing from
+// backend A, both backends, and backend B, respectively.
+func synchronize(ctx context.Context, backendA, backendB blobstore.BlobAccess, replicatorAToB, replicatorBToA replication.BlobReplicator, digests digest.Set) (digest.Set, digest.Set, digest.Set, error) {
 	// Call FindMissing() on both backends.
 	findMissingGroup, findMissingCtx := errgroup.WithContext(ctx)
 	var resultsA, resultsB digest.Set
 	findMissingGroup.Go(func() error {
 		var err error
-		resultsA, err = ba.backendA.FindMissing(findMissingCtx, digests)
+		resultsA, err = backendA.FindMissing(findMissingCtx, digests)
 		if err != nil {
 			return util.StatusWrap(err, "Backend A")
 		}
@@ -144,25 +160,25 @@ func (ba *mirroredBlobAccess) FindMissing(ctx context.Context, digests digest.Se
 	})
 	findMissingGroup.Go(func() error {
 		var err error
-		resultsB, err = ba.backendB.FindMissing(findMissingCtx, digests)
+		resultsB, err = backendB.FindMissing(findMissingCtx, digests)
 		if err != nil {
 			return util.StatusWrap(err, "Backend B")
 		}
 		return nil
 	})
 	if err := findMissingGroup.Wait(); err != nil {
-		return digest.EmptySet, err
+		return digest.EmptySet, digest.EmptySet, digest.EmptySet, err
 	}
 
 	// Determine inconsistencies between both backends.
 	missingFromA, missingFromBoth, missingFromB := digest.GetDifferenceAndIntersection(resultsA, resultsB)
-	mirroredBlobAccessFindMissingSynchronizationsFromAToB.Observe(float64(missingFromB.Length()))
-	mirroredBlobAccessFindMissingSynchronizationsFromBToA.Observe(float64(missingFromA.Length()))
 
-	// Exchange objects back and forth.
-	replicateGroup, replicateCtx := errgroup.WithContext(ctx)
+	// Exchange objects back and forth. Repairing inconsistencies
+	// between backends is given a lower priority than replication
+	// performed on behalf of clients reading data.
+	replicateGroup, replicateCtx := errgroup.WithContext(replication.NewContextWithPriority(ctx, replicator_pb.Priority_BACKGROUND))
 	replicateGroup.Go(func() error {
-		if err := ba.replicatorAToB.ReplicateMultiple(replicateCtx, missingFromB); err != nil {
+		if err := replicatorAToB.ReplicateMultiple(replicateCtx, missingFromB); err != nil {
 			if status.Code(err) == codes.NotFound {
 				return util.StatusWrapWithCode(err, codes.Internal, "Backend A returned inconsistent results while synchronizing")
 			}
@@ -171,7 +187,7 @@ func (ba *mirroredBlobAccess) FindMissing(ctx context.Context, digests digest.Se
 		return nil
 	})
 	replicateGroup.Go(func() error {
-		if err := ba.replicatorBToA.ReplicateMultiple(replicateCtx, missingFromA); err != nil {
+		if err := replicatorBToA.ReplicateMultiple(replicateCtx, missingFromA); err != nil {
 			if status.Code(err) == codes.NotFound {
 				return util.StatusWrapWithCode(err, codes.Internal, "Backend B returned inconsistent results while synchronizing")
 			}
@@ -180,9 +196,16 @@ func (ba *mirroredBlobAccess) FindMissing(ctx context.Context, digests digest.Se
 		return nil
 	})
 	if err := replicateGroup.Wait(); err != nil {
-		return digest.EmptySet, err
+		return digest.EmptySet, digest.EmptySet, digest.EmptySet, err
 	}
-	return missingFromBoth, nil
+	return missingFromA, missingFromBoth, missingFromB, nil
+}
+
+func (ba *mirroredBlobAccess) FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+	// Replication between backends is performed on a per-batch
+	// basis, as it requires that the results of both backends are
+	// known.
+	return blobstore.FindMissingStreamInBatches(ctx, digests, reportMissing, ba.FindMissing)
 }
 
 func (ba *mirroredBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
@@ -0,0 +1,144 @@
+package blobstore
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// This file contains helper functions that are shared by BlobAccess
+// implementations that store objects in buckets of object storage
+// services, such as S3 and Google Cloud Storage.
+
+// objectSliceOffsetMetadataKey is the name of the user-defined
+// metadata field that is attached to slice objects. It contains the
+// offset at which the child object is stored inside its parent.
+const objectSliceOffsetMetadataKey = "offset-bytes"
+
+// getSliceObjectKey returns the name of the object that is used to
+// track that a child object is stored within a parent object.
+// GetFromComposite() creates these objects after slicing, so that
+// successive calls can fetch the child object using a range request.
+// Keys of digests always start with a digit, meaning these objects
+// never collide with ones storing data.
+func getSliceObjectKey(keyPrefix string, digestKeyFormat digest.KeyFormat, parentDigest, childDigest digest.Digest) string {
+	return keyPrefix + "slices/" + parentDigest.GetKey(digestKeyFormat) + "/" + childDigest.GetKey(digestKeyFormat)
+}
+
+// parseSliceObjectOffset extracts the offset of a child object within
+// its parent object from the user-defined metadata of a slice object.
+func parseSliceObjectOffset(metadata map[string]string) (int64, error) {
+	offsetBytes, err := strconv.ParseInt(metadata[objectSliceOffsetMetadataKey], 10, 64)
+	if err != nil {
+		return 0, util.StatusWrapWithCode(err, codes.Internal, "Invalid slice offset")
+	}
+	return offsetBytes, nil
+}
+
+// newSliceObjectMetadata returns the user-defined metadata that is
+// attached to a slice object.
+func newSliceObjectMetadata(offsetBytes int64) map[string]string {
+	return map[string]string{
+		objectSliceOffsetMetadataKey: strconv.FormatInt(offsetBytes, 10),
+	}
+}
+
+// sliceObjectStorage is implemented by BlobAccess implementations for
+// object storage services that use slice objects to implement
+// GetFromComposite().
+type sliceObjectStorage interface {
+	Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer
+
+	// getSliceObjectOffset returns the offset of a child object
+	// within its parent object, as stored in the slice object. It
+	// fails with NOT_FOUND if the slice object does not exist.
+	getSliceObjectOffset(ctx context.Context, parentDigest, childDigest digest.Digest) (int64, error)
+	// getSlice reads a non-empty child object from its parent
+	// object using a range request.
+	getSlice(ctx context.Context, parentDigest, childDigest digest.Digest, offsetBytes int64) buffer.Buffer
+	// putSliceObject creates a slice object, recording the offset
+	// of a child object within its parent object.
+	putSliceObject(ctx context.Context, parentDigest, childDigest digest.Digest, offsetBytes int64) error
+}
+
+// getFromCompositeUsingSliceObjects implements
+// BlobAccess.GetFromComposite() for object storage services. If the
+// parent object has already been sliced, the child object is loaded
+// using a range request. If not, the parent object is sliced, and
+// slice objects are created for all of its children with bounded
+// concurrency.
+func getFromCompositeUsingSliceObjects(ctx context.Context, storage sliceObjectStorage, readBufferFactory ReadBufferFactory, maximumConcurrentRequests int, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	if offsetBytes, err := storage.getSliceObjectOffset(ctx, parentDigest, childDigest); err == nil {
+		if childDigest.GetSizeBytes() == 0 {
+			// Range requests cannot be used to read empty
+			// objects.
+			return readBufferFactory.NewBufferFromByteSlice(childDigest, nil, buffer.Irreparable(childDigest))
+		}
+		return storage.getSlice(ctx, parentDigest, childDigest, offsetBytes)
+	} else if status.Code(err) != codes.NotFound {
+		return buffer.NewBufferFromError(util.StatusWrap(err, "Failed to get slice object"))
+	}
+
+	bChild, slices := slicer.Slice(storage.Get(ctx, parentDigest), childDigest)
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(maximumConcurrentRequests)
+	for _, slice := range slices {
+		group.Go(func() error {
+			return storage.putSliceObject(groupCtx, parentDigest, slice.Digest, slice.OffsetBytes)
+		})
+	}
+	if err := group.Wait(); err != nil {
+		bChild.Discard()
+		return buffer.NewBufferFromError(err)
+	}
+	return bChild
+}
+
+// findMissingUsingExistenceChecks implements BlobAccess.FindMissing()
+// for object storage services that do not provide an API for checking
+// the existence of multiple objects at once. It calls a function that
+// checks the existence of a single object for every digest, with
+// bounded concurrency. The function returns an error with code
+// NOT_FOUND if the object does not exist.
+func findMissingUsingExistenceChecks(ctx context.Context, digests digest.Set, maximumConcurrentRequests int, checkExistence func(ctx context.Context, blobDigest digest.Digest) error) (digest.Set, error) {
+	items := digests.Items()
+	missing := make([]bool, len(items))
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(maximumConcurrentRequests)
+	for i, blobDigest := range items {
+		if groupCtx.Err() != nil {
+			break
+		}
+		group.Go(func() error {
+			if err := checkExistence(groupCtx, blobDigest); err != nil {
+				if status.Code(err) != codes.NotFound {
+					return err
+				}
+				missing[i] = true
+			}
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return digest.EmptySet, err
+	}
+	if err := util.StatusFromContext(ctx); err != nil {
+		return digest.EmptySet, err
+	}
+
+	missingDigests := digest.NewSetBuilder()
+	for i, blobDigest := range items {
+		if missing[i] {
+			missingDigests.Add(blobDigest)
+		}
+	}
+	return missingDigests.Build(), nil
+}
@@ -0,0 +1,99 @@
+package pinning
+
+import (
+	"io"
+	"math"
+	"os"
+
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	pb "github.com/buildbarn/bb-storage/pkg/proto/pinning"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	componentPinnedBlobs    = path.MustNewComponent("pinned_blobs")
+	componentPinnedBlobsNew = path.MustNewComponent("pinned_blobs.new")
+)
+
+// PinnedBlobsStore is used by PinningBlobAccess to store the set of
+// pinned blobs, so that it is retained across restarts.
+type PinnedBlobsStore interface {
+	ReadPinnedBlobs() (digest.Set, error)
+	WritePinnedBlobs(blobs digest.Set) error
+}
+
+type directoryBackedPinnedBlobsStore struct {
+	directory filesystem.Directory
+}
+
+// NewDirectoryBackedPinnedBlobsStore creates a PinnedBlobsStore that
+// stores the digests of all pinned blobs in a file named
+// "pinned_blobs" inside a filesystem.Directory.
+func NewDirectoryBackedPinnedBlobsStore(directory filesystem.Directory) PinnedBlobsStore {
+	return directoryBackedPinnedBlobsStore{
+		directory: directory,
+	}
+}
+
+func (s directoryBackedPinnedBlobsStore) ReadPinnedBlobs() (digest.Set, error) {
+	f, err := s.directory.OpenRead(componentPinnedBlobs)
+	if os.IsNotExist(err) {
+		return digest.EmptySet, nil
+	}
+	if err != nil {
+		return digest.EmptySet, util.StatusWrapWithCode(err, codes.Internal, "Failed to open file")
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(io.NewSectionReader(f, 0, math.MaxInt64))
+	if err != nil {
+		return digest.EmptySet, util.StatusWrapWithCode(err, codes.Internal, "Failed to read from file")
+	}
+	var persistentState pb.PersistentState
+	if err := proto.Unmarshal(data, &persistentState); err != nil {
+		return digest.EmptySet, util.StatusWrapWithCode(err, codes.InvalidArgument, "Failed to unmarshal data")
+	}
+
+	blobs := digest.NewSetBuilder()
+	for i, blob := range persistentState.Blobs {
+		instanceName, err := digest.NewInstanceName(blob.InstanceName)
+		if err != nil {
+			return digest.EmptySet, util.StatusWrapf(err, "Invalid instance name %#v for blob at index %d", blob.InstanceName, i)
+		}
+		digestFunction, err := instanceName.GetDigestFunction(blob.DigestFunction, len(blob.Digest.GetHash()))
+		if err != nil {
+			return digest.EmptySet, util.StatusWrapf(err, "Invalid digest function for blob at index %d", i)
+		}
+		d, err := digestFunction.NewDigestFromProto(blob.Digest)
+		if err != nil {
+			return digest.EmptySet, util.StatusWrapf(err, "Invalid digest for blob at index %d", i)
+		}
+		blobs.Add(d)
+	}
+	return blobs.Build(), nil
+}
+
+func (s directoryBackedPinnedBlobsStore) WritePinnedBlobs(blobs digest.Set) error {
+	var persistentState pb.PersistentState
+	for _, blob := range blobs.Items() {
+		persistentState.Blobs = append(persistentState.Blobs, &pb.PersistentState_Blob{
+			InstanceName:   blob.GetInstanceName().String(),
+			DigestFunction: blob.GetDigestFunction().GetEnumValue(),
+			Digest:         blob.GetProto(),
+		})
+	}
+	data, err := proto.Marshal(&persistentState)
+	if err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to marshal data")
+	}
+
+	return filesystem.WriteFileAtomically(s.directory, componentPinnedBlobs, componentPinnedBlobsNew, func(w io.Writer) error {
+		_, err := w.Write(data)
+		return err
+	})
+}
@@ -0,0 +1,362 @@
+package pinning
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	pinningBlobAccessPrometheusMetrics sync.Once
+
+	pinningBlobAccessPinnedBlobs = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "pinning_blob_access_pinned_blobs",
+			Help:      "Number of blobs that are pinned",
+		},
+		[]string{"storage_type"})
+	pinningBlobAccessPinnedSizeBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "pinning_blob_access_pinned_size_bytes",
+			Help:      "Combined size of all blobs that are pinned, in bytes",
+		},
+		[]string{"storage_type"})
+	pinningBlobAccessMissingBlobs = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "pinning_blob_access_missing_blobs",
+			Help:      "Number of pinned blobs that were absent the last time pinned blobs were refreshed",
+		},
+		[]string{"storage_type"})
+	pinningBlobAccessSkippedPins = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "pinning_blob_access_skipped_pins_total",
+			Help:      "Number of blobs written using a matching instance name that were not pinned, because the limit on the combined size of pinned blobs would be exceeded",
+		},
+		[]string{"storage_type"})
+)
+
+// skippedPinsLogInterval is the minimum amount of time between log
+// messages about blobs that were written without being pinned. This
+// prevents logs from being flooded when the limit on the combined
+// size of pinned blobs has been reached.
+const skippedPinsLogInterval = time.Minute
+
+// PinnedUsage contains statistics on the blobs that are pinned by
+// PinningBlobAccess.
+type PinnedUsage struct {
+	BlobsCount        int
+	SizeBytes         int64
+	MissingBlobsCount int
+}
+
+// PinningBlobAccess is a decorator for BlobAccess that keeps track of
+// a set of pinned blobs. Pinned blobs are refreshed periodically by
+// calling FindMissing() against the backend.
+//
+// This decorator is intended to be placed on top of LocalBlobAccess,
+// which refreshes blobs that are stored in the "old" blocks when
+// FindMissing() is called, by copying them into new blocks. If pinned
+// blobs are refreshed sufficiently frequently, they are thus never
+// evicted.
+//
+// Blobs may either be pinned explicitly by calling Pin(), or
+// implicitly by writing them using an instance name that has one of
+// the configured prefixes. The combined size of pinned blobs may be
+// limited, so that pinned blobs cannot take up all space in the
+// backend.
+type PinningBlobAccess struct {
+	blobstore.BlobAccess
+	digestKeyFormat        digest.KeyFormat
+	instanceNamePrefixes   *digest.InstanceNameTrie
+	store                  PinnedBlobsStore
+	maximumPinnedSizeBytes int64
+	clock                  clock.Clock
+
+	storeLock sync.Mutex
+
+	lock              sync.Mutex
+	pinnedBlobs       map[string]digest.Digest
+	pinnedSizeBytes   int64
+	missingBlobsCount int
+	storeOutdated     bool
+
+	// Blobs that were not pinned since the last log message.
+	skippedPinsSinceLog  int
+	nextSkippedPinsLog   time.Time
+	skippedPinsCounter   prometheus.Counter
+	pinnedBlobsGauge     prometheus.Gauge
+	pinnedSizeBytesGauge prometheus.Gauge
+	missingBlobsGauge    prometheus.Gauge
+}
+
+// NewPinningBlobAccess creates a new PinningBlobAccess that initially
+// has a given set of blobs pinned. If a PinnedBlobsStore is provided,
+// the set of pinned blobs is written to it after changes are made.
+//
+// If the maximum pinned size is positive, requests to pin blobs that
+// would cause the combined size of pinned blobs to exceed it are
+// rejected. The initial set of pinned blobs is always accepted, so
+// that lowering the limit does not cause pinned blobs to be lost.
+func NewPinningBlobAccess(base blobstore.BlobAccess, digestKeyFormat digest.KeyFormat, instanceNamePrefixes *digest.InstanceNameTrie, store PinnedBlobsStore, initialPinnedBlobs digest.Set, maximumPinnedSizeBytes int64, clock clock.Clock, storageType string) *PinningBlobAccess {
+	pinningBlobAccessPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(pinningBlobAccessPinnedBlobs)
+		prometheus.MustRegister(pinningBlobAccessPinnedSizeBytes)
+		prometheus.MustRegister(pinningBlobAccessMissingBlobs)
+		prometheus.MustRegister(pinningBlobAccessSkippedPins)
+	})
+
+	ba := &PinningBlobAccess{
+		BlobAccess:             base,
+		digestKeyFormat:        digestKeyFormat,
+		instanceNamePrefixes:   instanceNamePrefixes,
+		store:                  store,
+		maximumPinnedSizeBytes: maximumPinnedSizeBytes,
+		clock:                  clock,
+		pinnedBlobs:            map[string]digest.Digest{},
+
+		skippedPinsCounter:   pinningBlobAccessSkippedPins.WithLabelValues(storageType),
+		pinnedBlobsGauge:     pinningBlobAccessPinnedBlobs.WithLabelValues(storageType),
+		pinnedSizeBytesGauge: pinningBlobAccessPinnedSizeBytes.WithLabelValues(storageType),
+		missingBlobsGauge:    pinningBlobAccessMissingBlobs.WithLabelValues(storageType),
+	}
+	for _, blobDigest := range initialPinnedBlobs.Items() {
+		ba.pinLocked(blobDigest)
+	}
+	ba.storeOutdated = false
+	ba.updateGaugesLocked()
+	return ba
+}
+
+func (ba *PinningBlobAccess) pinLocked(blobDigest digest.Digest) {
+	key := blobDigest.GetKey(ba.digestKeyFormat)
+	if _, ok := ba.pinnedBlobs[key]; !ok {
+		ba.pinnedBlobs[key] = blobDigest
+		ba.pinnedSizeBytes += blobDigest.GetSizeBytes()
+		ba.storeOutdated = true
+	}
+}
+
+// checkPinnedSizeLocked returns an error if pinning a set of blobs
+// would cause the combined size of pinned blobs to exceed the
+// configured limit.
+func (ba *PinningBlobAccess) checkPinnedSizeLocked(blobDigests digest.Set) error {
+	if ba.maximumPinnedSizeBytes <= 0 {
+		return nil
+	}
+	newPinnedSizeBytes := ba.pinnedSizeBytes
+	for _, blobDigest := range blobDigests.Items() {
+		if _, ok := ba.pinnedBlobs[blobDigest.GetKey(ba.digestKeyFormat)]; !ok {
+			newPinnedSizeBytes += blobDigest.GetSizeBytes()
+		}
+	}
+	if newPinnedSizeBytes > ba.maximumPinnedSizeBytes {
+		return status.Errorf(codes.ResourceExhausted, "Pinning these blobs would increase the combined size of pinned blobs to %d bytes, which exceeds the limit of %d bytes", newPinnedSizeBytes, ba.maximumPinnedSizeBytes)
+	}
+	return nil
+}
+
+func (ba *PinningBlobAccess) unpinLocked(blobDigest digest.Digest) {
+	key := blobDigest.GetKey(ba.digestKeyFormat)
+	if oldDigest, ok := ba.pinnedBlobs[key]; ok {
+		delete(ba.pinnedBlobs, key)
+		ba.pinnedSizeBytes -= oldDigest.GetSizeBytes()
+		ba.storeOutdated = true
+	}
+}
+
+func (ba *PinningBlobAccess) updateGaugesLocked() {
+	ba.pinnedBlobsGauge.Set(float64(len(ba.pinnedBlobs)))
+	ba.pinnedSizeBytesGauge.Set(float64(ba.pinnedSizeBytes))
+	ba.missingBlobsGauge.Set(float64(ba.missingBlobsCount))
+}
+
+func (ba *PinningBlobAccess) getPinnedBlobsLocked() digest.Set {
+	pinnedBlobs := digest.NewSetBuilder()
+	for _, blobDigest := range ba.pinnedBlobs {
+		pinnedBlobs.Add(blobDigest)
+	}
+	return pinnedBlobs.Build()
+}
+
+// writeStore writes the current set of pinned blobs to the
+// PinnedBlobsStore, if it has changed since it was last written.
+func (ba *PinningBlobAccess) writeStore() error {
+	if ba.store == nil {
+		return nil
+	}
+
+	ba.storeLock.Lock()
+	defer ba.storeLock.Unlock()
+
+	ba.lock.Lock()
+	if !ba.storeOutdated {
+		ba.lock.Unlock()
+		return nil
+	}
+	pinnedBlobs := ba.getPinnedBlobsLocked()
+	ba.storeOutdated = false
+	ba.lock.Unlock()
+
+	if err := ba.store.WritePinnedBlobs(pinnedBlobs); err != nil {
+		ba.lock.Lock()
+		ba.storeOutdated = true
+		ba.lock.Unlock()
+		return util.StatusWrap(err, "Failed to write pinned blobs")
+	}
+	return nil
+}
+
+// Put a blob in the backend. If the blob is written using an instance
+// name that has one of the configured prefixes, it is pinned. Such
+// blobs are written to the PinnedBlobsStore the next time pinned blobs
+// are refreshed.
+//
+// If pinning the blob would cause the limit on the combined size of
+// pinned blobs to be exceeded, the blob is stored without being
+// pinned. The write itself has already succeeded at that point, so
+// there is no point in reporting this to the client. Such blobs are
+// counted, and logged at most once per skippedPinsLogInterval.
+func (ba *PinningBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	if err := ba.BlobAccess.Put(ctx, blobDigest, b); err != nil {
+		return err
+	}
+	if ba.instanceNamePrefixes.ContainsPrefix(blobDigest.GetInstanceName()) {
+		ba.lock.Lock()
+		err := ba.checkPinnedSizeLocked(blobDigest.ToSingletonSet())
+		skippedPinsToLog := 0
+		if err == nil {
+			ba.pinLocked(blobDigest)
+			ba.updateGaugesLocked()
+		} else {
+			ba.skippedPinsCounter.Inc()
+			ba.skippedPinsSinceLog++
+			if now := ba.clock.Now(); !now.Before(ba.nextSkippedPinsLog) {
+				skippedPinsToLog = ba.skippedPinsSinceLog
+				ba.skippedPinsSinceLog = 0
+				ba.nextSkippedPinsLog = now.Add(skippedPinsLogInterval)
+			}
+		}
+		ba.lock.Unlock()
+		if skippedPinsToLog > 0 {
+			log.Printf("Not pinning blob %s: %s (%d blobs not pinned since the last message)", blobDigest, err, skippedPinsToLog)
+		}
+	}
+	return nil
+}
+
+// Pin a set of blobs. Blobs that are already stored in the backend are
+// refreshed immediately. This function returns the set of blobs that
+// have been pinned, but are not present in the backend.
+//
+// If pinning the blobs would cause the limit on the combined size of
+// pinned blobs to be exceeded, none of the blobs are pinned.
+func (ba *PinningBlobAccess) Pin(ctx context.Context, blobDigests digest.Set) (digest.Set, error) {
+	ba.lock.Lock()
+	if err := ba.checkPinnedSizeLocked(blobDigests); err != nil {
+		ba.lock.Unlock()
+		return digest.EmptySet, err
+	}
+	for _, blobDigest := range blobDigests.Items() {
+		ba.pinLocked(blobDigest)
+	}
+	ba.updateGaugesLocked()
+	ba.lock.Unlock()
+
+	if err := ba.writeStore(); err != nil {
+		return digest.EmptySet, err
+	}
+	missing, err := ba.BlobAccess.FindMissing(ctx, blobDigests)
+	if err != nil {
+		return digest.EmptySet, util.StatusWrap(err, "Failed to refresh pinned blobs")
+	}
+	return missing, nil
+}
+
+// Unpin a set of blobs. This does not remove the blobs from the
+// backend.
+func (ba *PinningBlobAccess) Unpin(blobDigests digest.Set) error {
+	ba.lock.Lock()
+	for _, blobDigest := range blobDigests.Items() {
+		ba.unpinLocked(blobDigest)
+	}
+	ba.updateGaugesLocked()
+	ba.lock.Unlock()
+
+	return ba.writeStore()
+}
+
+// GetPinnedUsage returns statistics on the blobs that are pinned.
+func (ba *PinningBlobAccess) GetPinnedUsage() PinnedUsage {
+	ba.lock.Lock()
+	defer ba.lock.Unlock()
+
+	return PinnedUsage{
+		BlobsCount:        len(ba.pinnedBlobs),
+		SizeBytes:         ba.pinnedSizeBytes,
+		MissingBlobsCount: ba.missingBlobsCount,
+	}
+}
+
+// RefreshPinnedBlobs calls FindMissing() against the backend for all
+// pinned blobs, causing them to be refreshed if needed. This function
+// should be called periodically, at an interval that is shorter than
+// the amount of time it takes for blobs to be evicted after they have
+// become eligible for refreshing.
+func (ba *PinningBlobAccess) RefreshPinnedBlobs(ctx context.Context) error {
+	if err := ba.writeStore(); err != nil {
+		return err
+	}
+
+	ba.lock.Lock()
+	pinnedBlobs := ba.getPinnedBlobsLocked()
+	ba.lock.Unlock()
+
+	missing, err := ba.BlobAccess.FindMissing(ctx, pinnedBlobs)
+	if err != nil {
+		return util.StatusWrap(err, "Failed to refresh pinned blobs")
+	}
+
+	ba.lock.Lock()
+	ba.missingBlobsCount = missing.Length()
+	ba.updateGaugesLocked()
+	ba.lock.Unlock()
+	return nil
+}
+
+// RefreshPinnedBlobsPeriodically calls RefreshPinnedBlobs() at a fixed
+// interval, until the provided context is canceled. Failures to
+// refresh pinned blobs are logged, but do not cause this function to
+// terminate.
+func (ba *PinningBlobAccess) RefreshPinnedBlobsPeriodically(ctx context.Context, clock clock.Clock, interval time.Duration, errorLogger util.ErrorLogger) {
+	for {
+		timer, t := clock.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-t:
+			if err := ba.RefreshPinnedBlobs(ctx); err != nil {
+				errorLogger.Log(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package pinning_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/pinning"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestPinningBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	instanceNamePrefixes := digest.NewInstanceNameTrie()
+	instanceNamePrefixes.Set(digest.MustNewInstanceName("release"), 0)
+	store := mock.NewMockPinnedBlobsStore(ctrl)
+	clock := mock.NewMockClock(ctrl)
+
+	digest1 := digest.MustNewDigest("release/foo", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	digest2 := digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "6fc422233a40a75a1f028e11c3cd1140", 7)
+	digest3 := digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "1f9f6a4ecdba2d5d5b9a1bb9b43d9f05", 11)
+	digest4 := digest.MustNewDigest("release/bar", remoteexecution.DigestFunction_MD5, "f4a6b1fb0a0dd04ce2b3a6f6f2f8b2c4", 20)
+
+	blobAccess := pinning.NewPinningBlobAccess(
+		baseBlobAccess,
+		digest.KeyWithoutInstance,
+		instanceNamePrefixes,
+		store,
+		digest.NewSetBuilder().Add(digest3).Build(),
+		/* maximumPinnedSizeBytes = */ 30,
+		clock,
+		"cas")
+
+	t.Run("InitialState", func(t *testing.T) {
+		require.Equal(t, pinning.PinnedUsage{
+			BlobsCount: 1,
+			SizeBytes:  11,
+		}, blobAccess.GetPinnedUsage())
+	})
+
+	t.Run("PutNotMatchingPrefix", func(t *testing.T) {
+		// Blobs written using instance names that don't match
+		// any of the prefixes should not be pinned.
+		baseBlobAccess.EXPECT().Put(ctx, digest2, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, digest2, buffer.NewValidatedBufferFromByteSlice([]byte("Goodbye"))))
+		require.Equal(t, 1, blobAccess.GetPinnedUsage().BlobsCount)
+	})
+
+	t.Run("PutFailure", func(t *testing.T) {
+		// Blobs that could not be written should not be pinned.
+		baseBlobAccess.EXPECT().Put(ctx, digest1, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return status.Error(codes.Internal, "Storage backend on fire")
+			})
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Internal, "Storage backend on fire"),
+			blobAccess.Put(ctx, digest1, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+		require.Equal(t, 1, blobAccess.GetPinnedUsage().BlobsCount)
+	})
+
+	t.Run("PutMatchingPrefix", func(t *testing.T) {
+		baseBlobAccess.EXPECT().Put(ctx, digest1, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, digest1, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+		require.Equal(t, pinning.PinnedUsage{
+			BlobsCount: 2,
+			SizeBytes:  16,
+		}, blobAccess.GetPinnedUsage())
+	})
+
+	t.Run("PutMatchingPrefixExceedingLimit", func(t *testing.T) {
+		// Blobs whose automatic pinning would cause the limit
+		// to be exceeded should still be written, but not be
+		// pinned. Repeated occurrences should only be logged
+		// periodically, which requires the current time to be
+		// obtained.
+		baseBlobAccess.EXPECT().Put(ctx, digest4, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			}).
+			Times(2)
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		clock.EXPECT().Now().Return(time.Unix(1010, 0))
+
+		require.NoError(t, blobAccess.Put(ctx, digest4, buffer.NewValidatedBufferFromByteSlice(make([]byte, 20))))
+		require.NoError(t, blobAccess.Put(ctx, digest4, buffer.NewValidatedBufferFromByteSlice(make([]byte, 20))))
+		require.Equal(t, pinning.PinnedUsage{
+			BlobsCount: 2,
+			SizeBytes:  16,
+		}, blobAccess.GetPinnedUsage())
+	})
+
+	t.Run("RefreshStoreFailure", func(t *testing.T) {
+		// Blobs pinned through Put() are only written to the
+		// store when refreshing. Failures should be propagated,
+		// and cause the write to be retried later on.
+		store.EXPECT().WritePinnedBlobs(digest.NewSetBuilder().Add(digest1).Add(digest3).Build()).
+			Return(status.Error(codes.Internal, "Disk on fire"))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Internal, "Failed to write pinned blobs: Disk on fire"),
+			blobAccess.RefreshPinnedBlobs(ctx))
+	})
+
+	t.Run("RefreshSuccess", func(t *testing.T) {
+		store.EXPECT().WritePinnedBlobs(digest.NewSetBuilder().Add(digest1).Add(digest3).Build())
+		baseBlobAccess.EXPECT().FindMissing(ctx, digest.NewSetBuilder().Add(digest1).Add(digest3).Build()).
+			Return(digest3.ToSingletonSet(), nil)
+
+		require.NoError(t, blobAccess.RefreshPinnedBlobs(ctx))
+		require.Equal(t, pinning.PinnedUsage{
+			BlobsCount:        2,
+			SizeBytes:         16,
+			MissingBlobsCount: 1,
+		}, blobAccess.GetPinnedUsage())
+	})
+
+	t.Run("RefreshFindMissingFailure", func(t *testing.T) {
+		// The store is up to date, so it should not be written
+		// again.
+		baseBlobAccess.EXPECT().FindMissing(ctx, digest.NewSetBuilder().Add(digest1).Add(digest3).Build()).
+			Return(digest.EmptySet, status.Error(codes.Unavailable, "Server not reachable"))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Unavailable, "Failed to refresh pinned blobs: Server not reachable"),
+			blobAccess.RefreshPinnedBlobs(ctx))
+	})
+
+	t.Run("PinExceedingLimit", func(t *testing.T) {
+		// Requests that would cause the limit to be exceeded
+		// should be rejected in their entirety.
+		_, err := blobAccess.Pin(ctx, digest.NewSetBuilder().Add(digest2).Add(digest4).Build())
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.ResourceExhausted, "Pinning these blobs would increase the combined size of pinned blobs to 43 bytes, which exceeds the limit of 30 bytes"),
+			err)
+		require.Equal(t, 2, blobAccess.GetPinnedUsage().BlobsCount)
+	})
+
+	t.Run("Pin", func(t *testing.T) {
+		// Explicitly pinning blobs should cause them to be
+		// written to the store immediately. The blobs that are
+		// absent should be returned.
+		store.EXPECT().WritePinnedBlobs(digest.NewSetBuilder().Add(digest1).Add(digest2).Add(digest3).Build())
+		baseBlobAccess.EXPECT().FindMissing(ctx, digest2.ToSingletonSet()).
+			Return(digest2.ToSingletonSet(), nil)
+
+		missing, err := blobAccess.Pin(ctx, digest2.ToSingletonSet())
+		require.NoError(t, err)
+		require.Equal(t, digest2.ToSingletonSet(), missing)
+		require.Equal(t, 3, blobAccess.GetPinnedUsage().BlobsCount)
+	})
+
+	t.Run("Unpin", func(t *testing.T) {
+		store.EXPECT().WritePinnedBlobs(digest1.ToSingletonSet())
+
+		require.NoError(t, blobAccess.Unpin(digest.NewSetBuilder().Add(digest2).Add(digest3).Build()))
+		require.Equal(t, pinning.PinnedUsage{
+			BlobsCount:        1,
+			SizeBytes:         5,
+			MissingBlobsCount: 1,
+		}, blobAccess.GetPinnedUsage())
+	})
+}
+
+func TestPinningBlobAccessRefreshPinnedBlobsPeriodically(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	digest1 := digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	clock := mock.NewMockClock(ctrl)
+	blobAccess := pinning.NewPinningBlobAccess(
+		baseBlobAccess,
+		digest.KeyWithoutInstance,
+		digest.NewInstanceNameTrie(),
+		/* store = */ nil,
+		digest1.ToSingletonSet(),
+		/* maximumPinnedSizeBytes = */ 0,
+		clock,
+		"cas")
+	errorLogger := mock.NewMockErrorLogger(ctrl)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// The first refresh fails, which should be logged. The second
+	// refresh succeeds. Refreshing stops once the context is
+	// canceled.
+	timer1 := mock.NewMockTimer(ctrl)
+	timerChannel1 := make(chan time.Time, 1)
+	timerChannel1 <- time.Unix(1000, 0)
+	clock.EXPECT().NewTimer(time.Minute).Return(timer1, timerChannel1)
+	baseBlobAccess.EXPECT().FindMissing(ctx, digest1.ToSingletonSet()).
+		Return(digest.EmptySet, status.Error(codes.Unavailable, "Server not reachable"))
+	errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.Unavailable, "Failed to refresh pinned blobs: Server not reachable")))
+
+	timer2 := mock.NewMockTimer(ctrl)
+	timerChannel2 := make(chan time.Time, 1)
+	timerChannel2 <- time.Unix(1060, 0)
+	clock.EXPECT().NewTimer(time.Minute).Return(timer2, timerChannel2)
+	baseBlobAccess.EXPECT().FindMissing(ctx, digest1.ToSingletonSet()).
+		Return(digest.EmptySet, nil)
+
+	timer3 := mock.NewMockTimer(ctrl)
+	clock.EXPECT().NewTimer(time.Minute).
+		Do(func(d time.Duration) { cancel() }).
+		Return(timer3, nil)
+	timer3.EXPECT().Stop()
+
+	blobAccess.RefreshPinnedBlobsPeriodically(ctx, clock, time.Minute, errorLogger)
+}
@@ -0,0 +1,84 @@
+package pinning
+
+import (
+	"context"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	pb "github.com/buildbarn/bb-storage/pkg/proto/pinning"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type pinningServer struct {
+	blobAccess *PinningBlobAccess
+}
+
+// NewPinningServer creates a gRPC stub for the Pinning service that
+// forwards all calls to PinningBlobAccess.
+func NewPinningServer(blobAccess *PinningBlobAccess) pb.PinningServer {
+	return pinningServer{
+		blobAccess: blobAccess,
+	}
+}
+
+func getDigestSet(instanceNameStr string, digestFunctionValue remoteexecution.DigestFunction_Value, blobDigests []*remoteexecution.Digest) (digest.Set, error) {
+	instanceName, err := digest.NewInstanceName(instanceNameStr)
+	if err != nil {
+		return digest.EmptySet, util.StatusWrapf(err, "Invalid instance name %#v", instanceNameStr)
+	}
+	digestFunction, err := instanceName.GetDigestFunction(digestFunctionValue, 0)
+	if err != nil {
+		return digest.EmptySet, err
+	}
+
+	digests := digest.NewSetBuilder()
+	for i, blobDigest := range blobDigests {
+		d, err := digestFunction.NewDigestFromProto(blobDigest)
+		if err != nil {
+			return digest.EmptySet, util.StatusWrapf(err, "Digest at index %d", i)
+		}
+		digests.Add(d)
+	}
+	return digests.Build(), nil
+}
+
+func (s pinningServer) PinBlobs(ctx context.Context, request *pb.PinBlobsRequest) (*pb.PinBlobsResponse, error) {
+	digests, err := getDigestSet(request.InstanceName, request.DigestFunction, request.BlobDigests)
+	if err != nil {
+		return nil, err
+	}
+	missing, err := s.blobAccess.Pin(ctx, digests)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.PinBlobsResponse{
+		MissingBlobDigests: make([]*remoteexecution.Digest, 0, missing.Length()),
+	}
+	for _, blobDigest := range missing.Items() {
+		response.MissingBlobDigests = append(response.MissingBlobDigests, blobDigest.GetProto())
+	}
+	return response, nil
+}
+
+func (s pinningServer) UnpinBlobs(ctx context.Context, request *pb.UnpinBlobsRequest) (*emptypb.Empty, error) {
+	digests, err := getDigestSet(request.InstanceName, request.DigestFunction, request.BlobDigests)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.blobAccess.Unpin(digests); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s pinningServer) GetPinnedUsage(ctx context.Context, request *emptypb.Empty) (*pb.GetPinnedUsageResponse, error) {
+	usage := s.blobAccess.GetPinnedUsage()
+	return &pb.GetPinnedUsageResponse{
+		BlobsCount:        int64(usage.BlobsCount),
+		SizeBytes:         usage.SizeBytes,
+		MissingBlobsCount: int64(usage.MissingBlobsCount),
+	}, nil
+}
@@ -2,6 +2,7 @@ package blobstore
 
 import (
 	"context"
+	"iter"
 	"sync"
 	"time"
 
@@ -191,6 +192,10 @@ func (ba *readCanaryingBlobAccess) FindMissing(ctx context.Context, digests dige
 	return digest.GetUnion(append(missingFromReplicas, missingFromSource)), nil
 }
 
+func (ba *readCanaryingBlobAccess) FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+	return FindMissingStreamInBatches(ctx, digests, reportMissing, ba.FindMissing)
+}
+
 // readCanaryingReplicaGetErrorHandler is the ErrorHandler that is
 // attached to all buffers read from the replica backend through the
 // Get() operation.
@@ -2,6 +2,7 @@ package readfallback
 
 import (
 	"context"
+	"iter"
 
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
@@ -103,3 +104,7 @@ func (ba *readFallbackBlobAccess) FindMissing(ctx context.Context, digests diges
 
 	return missingInBoth, nil
 }
+
+func (ba *readFallbackBlobAccess) FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+	return blobstore.FindMissingStreamInBatches(ctx, digests, reportMissing, ba.FindMissing)
+}
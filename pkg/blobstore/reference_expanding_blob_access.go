@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -207,6 +208,10 @@ func (ba *referenceExpandingBlobAccess) FindMissing(ctx context.Context, digests
 	return ba.indirectContentAddressableStorage.FindMissing(ctx, digests)
 }
 
+func (ba *referenceExpandingBlobAccess) FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+	return ba.indirectContentAddressableStorage.FindMissingStream(ctx, digests, reportMissing)
+}
+
 func errToStatus(err error) error {
 	if err == nil {
 		return nil
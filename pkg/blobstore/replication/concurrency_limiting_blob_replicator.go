@@ -2,34 +2,63 @@ package replication
 
 import (
 	"context"
+	"sync"
 
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/clock"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	concurrencyLimitingBlobReplicatorPrometheusMetrics sync.Once
 
-	"golang.org/x/sync/semaphore"
+	concurrencyLimitingBlobReplicatorQueueWaitSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "concurrency_limiting_blob_replicator_queue_wait_seconds",
+			Help:      "Amount of time replication requests spent waiting to be admitted by the concurrency limiting blob replicator, in seconds.",
+			Buckets:   util.DecimalExponentialBuckets(-3, 6, 2),
+		},
+		[]string{"storage", "priority"})
 )
 
 type concurrencyLimitingBlobReplicator struct {
 	base      BlobReplicator
 	sink      blobstore.BlobAccess
-	semaphore *semaphore.Weighted
+	scheduler *PriorityScheduler
+	clock     clock.Clock
+
+	queueWaitSeconds prometheus.ObserverVec
 }
 
 // NewConcurrencyLimitingBlobReplicator creates a decorator for
-// BlobReplicator that uses a semaphore to place a limit on the number
-// of concurrent replication requests. This can be used to prevent
-// excessive amounts of congestion on the network.
+// BlobReplicator that uses a PriorityScheduler to place a limit on the
+// number of concurrent replication requests. This can be used to
+// prevent excessive amounts of congestion on the network.
 //
-// The semaphore.Weighted type retains the original request order,
-// meaning that starvation is prevented.
-func NewConcurrencyLimitingBlobReplicator(base BlobReplicator, sink blobstore.BlobAccess, semaphore *semaphore.Weighted) BlobReplicator {
+// Requests are admitted based on the priority attached to the context
+// using NewContextWithPriority(). Requests of the same priority are
+// admitted in their original order, while PriorityScheduler ensures
+// that requests of lower priorities are not starved.
+func NewConcurrencyLimitingBlobReplicator(base BlobReplicator, sink blobstore.BlobAccess, scheduler *PriorityScheduler, clock clock.Clock, storageTypeName string) BlobReplicator {
+	concurrencyLimitingBlobReplicatorPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(concurrencyLimitingBlobReplicatorQueueWaitSeconds)
+	})
+
 	return &concurrencyLimitingBlobReplicator{
 		base:      base,
 		sink:      sink,
-		semaphore: semaphore,
+		scheduler: scheduler,
+		clock:     clock,
+
+		queueWaitSeconds: concurrencyLimitingBlobReplicatorQueueWaitSeconds.MustCurryWith(map[string]string{
+			"storage": storageTypeName,
+		}),
 	}
 }
 
@@ -56,10 +85,13 @@ func (br *concurrencyLimitingBlobReplicator) ReplicateComposite(ctx context.Cont
 }
 
 func (br *concurrencyLimitingBlobReplicator) ReplicateMultiple(ctx context.Context, digests digest.Set) error {
-	if err := util.AcquireSemaphore(ctx, br.semaphore, 1); err != nil {
+	priority := PriorityFromContext(ctx)
+	timeStart := br.clock.Now()
+	if err := br.scheduler.Acquire(ctx, priority); err != nil {
 		return err
 	}
+	br.queueWaitSeconds.WithLabelValues(priority.String()).Observe(br.clock.Now().Sub(timeStart).Seconds())
 	err := br.base.ReplicateMultiple(ctx, digests)
-	br.semaphore.Release(1)
+	br.scheduler.Release()
 	return err
 }
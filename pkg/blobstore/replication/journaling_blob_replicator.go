@@ -0,0 +1,777 @@
+package replication
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	replicator_pb "github.com/buildbarn/bb-storage/pkg/proto/replicator"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	journalingBlobReplicatorPrometheusMetrics sync.Once
+
+	journalingBlobReplicatorPendingBlobs = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "journaling_blob_replicator_pending_blobs",
+			Help:      "Number of blobs that are pending replication",
+		},
+		[]string{"storage_type"})
+	journalingBlobReplicatorBlobsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "journaling_blob_replicator_blobs_total",
+			Help:      "Number of blobs that were enqueued, replicated, purged, or discarded due to being missing from the source",
+		},
+		[]string{"storage_type", "operation"})
+	journalingBlobReplicatorOldestPendingBlobAgeSeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "journaling_blob_replicator_oldest_pending_blob_age_seconds",
+			Help:      "Amount of time the blob at the front of the queue of pending replications has been queued, in seconds, as observed when the last batch of blobs was processed",
+		},
+		[]string{"storage_type"})
+	journalingBlobReplicatorQueuedDurationSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "journaling_blob_replicator_queued_duration_seconds",
+			Help:      "Amount of time blobs were pending replication, in seconds",
+			Buckets:   util.DecimalExponentialBuckets(-3, 9, 2),
+		},
+		[]string{"storage_type"})
+)
+
+var (
+	componentJournal    = path.MustNewComponent("journal")
+	componentJournalNew = path.MustNewComponent("journal.new")
+)
+
+// The minimum number of records the journal needs to contain before
+// it is compacted.
+const journalingBlobReplicatorMinimumCompactionRecordsCount = 1024
+
+// PendingReplication contains information on a blob that is pending
+// replication by JournalingBlobReplicator.
+type PendingReplication struct {
+	Digest       digest.Digest
+	EnqueuedTime time.Time
+}
+
+func (pr *PendingReplication) toProto() *replicator_pb.PendingReplication {
+	digestFunction := pr.Digest.GetDigestFunction()
+	return &replicator_pb.PendingReplication{
+		InstanceName:   digestFunction.GetInstanceName().String(),
+		DigestFunction: digestFunction.GetEnumValue(),
+		BlobDigest:     pr.Digest.GetProto(),
+		EnqueuedTime:   timestamppb.New(pr.EnqueuedTime),
+	}
+}
+
+// journalCommit is a set of records that is appended to the journal
+// using a single write, on behalf of one or more calls to
+// ReplicateMultiple().
+type journalCommit struct {
+	pendingReplications []*PendingReplication
+	records             []*replicator_pb.JournalRecord
+	err                 error
+}
+
+// JournalingBlobReplicator is a decorator for BlobReplicator that
+// writes the digests of blobs that need to be replicated to an
+// append-only journal stored on disk, prior to replicating them in the
+// background. When restarted, the journal is replayed, causing any
+// pending replications to be resumed.
+//
+// As replication is performed asynchronously, calls to
+// ReplicateMultiple() complete as soon as the digests have been
+// written to the journal. The journal is written without holding the
+// lock that protects the set of pending replications. Records of calls
+// to ReplicateMultiple() that are made while the journal is being
+// written are combined, so that they are persisted using a single
+// write and synchronization (i.e., group commit).
+type JournalingBlobReplicator struct {
+	source           blobstore.BlobAccess
+	base             BlobReplicator
+	digestKeyFormat  digest.KeyFormat
+	directory        filesystem.Directory
+	clock            clock.Clock
+	errorLogger      util.ErrorLogger
+	maximumBatchSize int
+	retryInterval    time.Duration
+
+	// Lock that needs to be held while writing to the journal. The
+	// set of pending replications may only be modified while
+	// holding both locks, which ensures that it is consistent with
+	// the order in which records are written. journalLock needs to
+	// be acquired before lock.
+	journalLock         sync.Mutex
+	journal             filesystem.FileAppender
+	journalRecordsCount int
+
+	lock        sync.Mutex
+	pending     map[string]*PendingReplication
+	queue       []*PendingReplication
+	wakeup      chan struct{}
+	uncommitted map[string]*journalCommit
+	nextCommit  *journalCommit
+
+	pendingBlobs                prometheus.Gauge
+	enqueuedBlobs               prometheus.Counter
+	replicatedBlobs             prometheus.Counter
+	purgedBlobs                 prometheus.Counter
+	missingBlobs                prometheus.Counter
+	oldestPendingBlobAgeSeconds prometheus.Gauge
+	queuedDurationSeconds       prometheus.Observer
+}
+
+// NewJournalingBlobReplicator creates a new JournalingBlobReplicator
+// that stores its journal in the provided directory. Any pending
+// replications contained in an existing journal are reloaded.
+//
+// Pending replications are only processed while
+// ProcessPendingReplications() is being called.
+func NewJournalingBlobReplicator(source blobstore.BlobAccess, base BlobReplicator, digestKeyFormat digest.KeyFormat, directory filesystem.Directory, clock clock.Clock, errorLogger util.ErrorLogger, maximumBatchSize int, retryInterval time.Duration, storageType string) (*JournalingBlobReplicator, error) {
+	journalingBlobReplicatorPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(journalingBlobReplicatorPendingBlobs)
+		prometheus.MustRegister(journalingBlobReplicatorBlobsTotal)
+		prometheus.MustRegister(journalingBlobReplicatorOldestPendingBlobAgeSeconds)
+		prometheus.MustRegister(journalingBlobReplicatorQueuedDurationSeconds)
+	})
+
+	br := &JournalingBlobReplicator{
+		source:           source,
+		base:             base,
+		digestKeyFormat:  digestKeyFormat,
+		directory:        directory,
+		clock:            clock,
+		errorLogger:      errorLogger,
+		maximumBatchSize: maximumBatchSize,
+		retryInterval:    retryInterval,
+
+		pending:     map[string]*PendingReplication{},
+		wakeup:      make(chan struct{}, 1),
+		uncommitted: map[string]*journalCommit{},
+
+		pendingBlobs:                journalingBlobReplicatorPendingBlobs.WithLabelValues(storageType),
+		enqueuedBlobs:               journalingBlobReplicatorBlobsTotal.WithLabelValues(storageType, "Enqueued"),
+		replicatedBlobs:             journalingBlobReplicatorBlobsTotal.WithLabelValues(storageType, "Replicated"),
+		purgedBlobs:                 journalingBlobReplicatorBlobsTotal.WithLabelValues(storageType, "Purged"),
+		missingBlobs:                journalingBlobReplicatorBlobsTotal.WithLabelValues(storageType, "Missing"),
+		oldestPendingBlobAgeSeconds: journalingBlobReplicatorOldestPendingBlobAgeSeconds.WithLabelValues(storageType),
+		queuedDurationSeconds:       journalingBlobReplicatorQueuedDurationSeconds.WithLabelValues(storageType),
+	}
+	if err := br.replayJournal(); err != nil {
+		return nil, util.StatusWrap(err, "Failed to replay journal")
+	}
+	// Rewrite the journal, so that it only contains the pending
+	// replications. This also discards any partially written
+	// records at the end of the journal, which may be present if
+	// the process crashed while appending to it.
+	if err := br.compactJournal(); err != nil {
+		return nil, err
+	}
+	br.pendingBlobs.Add(float64(len(br.pending)))
+	if len(br.queue) > 0 {
+		br.wakeupProcessor()
+	}
+	return br, nil
+}
+
+// wakeupProcessor causes ProcessPendingReplications() to inspect the
+// queue of pending replications.
+func (br *JournalingBlobReplicator) wakeupProcessor() {
+	select {
+	case br.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// replayJournal reads all records from an existing journal, and
+// reconstructs the set of pending replications.
+//
+// If the process crashed while appending to the journal, it may end
+// with a partially written record. Depending on the file system, it
+// may also end with data that was never written (e.g., zeros). The
+// remainder of the journal is discarded when either is encountered,
+// as opposed to failing, so that the replicator can start up. It is
+// removed from the journal when it is compacted.
+func (br *JournalingBlobReplicator) replayJournal() error {
+	f, err := br.directory.OpenRead(componentJournal)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to open journal")
+	}
+	defer f.Close()
+
+	// Read the full journal up front, so that I/O errors can be
+	// distinguished from records that are corrupted.
+	data, err := io.ReadAll(io.NewSectionReader(f, 0, math.MaxInt64))
+	if err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to read journal")
+	}
+	r := bytes.NewReader(data)
+	for recordIndex := 0; r.Len() > 0; recordIndex++ {
+		offset := len(data) - r.Len()
+		if err := br.replayRecord(r); err == io.EOF || err == io.ErrUnexpectedEOF {
+			// Ignore records that were only written
+			// partially.
+			return nil
+		} else if err != nil {
+			br.errorLogger.Log(util.StatusWrapf(err, "Discarding the last %d bytes of the journal, starting at record index %d", len(data)-offset, recordIndex))
+			return nil
+		}
+	}
+	return nil
+}
+
+// replayRecord reads a single record from the journal, and applies it
+// to the set of pending replications.
+func (br *JournalingBlobReplicator) replayRecord(r *bytes.Reader) error {
+	var record replicator_pb.JournalRecord
+	if err := protodelim.UnmarshalFrom(r, &record); err == io.EOF || err == io.ErrUnexpectedEOF {
+		return err
+	} else if err != nil {
+		return util.StatusWrapWithCode(err, codes.InvalidArgument, "Failed to read record")
+	}
+
+	switch recordType := record.Type.(type) {
+	case *replicator_pb.JournalRecord_Enqueued:
+		pendingReplication, err := newPendingReplicationFromProto(recordType.Enqueued)
+		if err != nil {
+			return util.StatusWrap(err, "Invalid record")
+		}
+		key := pendingReplication.Digest.GetKey(br.digestKeyFormat)
+		if _, ok := br.pending[key]; !ok {
+			br.pending[key] = pendingReplication
+			br.queue = append(br.queue, pendingReplication)
+		}
+	case *replicator_pb.JournalRecord_Dequeued:
+		pendingReplication, err := newPendingReplicationFromProto(recordType.Dequeued)
+		if err != nil {
+			return util.StatusWrap(err, "Invalid record")
+		}
+		delete(br.pending, pendingReplication.Digest.GetKey(br.digestKeyFormat))
+	default:
+		return status.Error(codes.InvalidArgument, "Record has an unknown type")
+	}
+	return nil
+}
+
+func newPendingReplicationFromProto(pendingReplication *replicator_pb.PendingReplication) (*PendingReplication, error) {
+	instanceName, err := digest.NewInstanceName(pendingReplication.InstanceName)
+	if err != nil {
+		return nil, util.StatusWrapf(err, "Invalid instance name %#v", pendingReplication.InstanceName)
+	}
+	digestFunction, err := instanceName.GetDigestFunction(pendingReplication.DigestFunction, 0)
+	if err != nil {
+		return nil, err
+	}
+	blobDigest, err := digestFunction.NewDigestFromProto(pendingReplication.BlobDigest)
+	if err != nil {
+		return nil, util.StatusWrap(err, "Invalid digest")
+	}
+	if err := pendingReplication.EnqueuedTime.CheckValid(); err != nil {
+		return nil, util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid enqueued time")
+	}
+	return &PendingReplication{
+		Digest:       blobDigest,
+		EnqueuedTime: pendingReplication.EnqueuedTime.AsTime(),
+	}, nil
+}
+
+// getQueueLocked returns the list of pending replications in FIFO
+// order. Entries that have been replicated or purged are removed.
+func (br *JournalingBlobReplicator) getQueueLocked() []*PendingReplication {
+	queue := make([]*PendingReplication, 0, len(br.pending))
+	for _, pendingReplication := range br.queue {
+		if br.isPendingLocked(pendingReplication) {
+			queue = append(queue, pendingReplication)
+		}
+	}
+	br.queue = queue
+	return queue
+}
+
+// isPendingLocked returns whether an entry in the queue still
+// corresponds to a pending replication, as opposed to one that has
+// been replicated or purged.
+func (br *JournalingBlobReplicator) isPendingLocked(pendingReplication *PendingReplication) bool {
+	return br.pending[pendingReplication.Digest.GetKey(br.digestKeyFormat)] == pendingReplication
+}
+
+// trimQueueLocked removes entries at the front of the queue that are
+// no longer pending.
+func (br *JournalingBlobReplicator) trimQueueLocked() {
+	for len(br.queue) > 0 && !br.isPendingLocked(br.queue[0]) {
+		br.queue[0] = nil
+		br.queue = br.queue[1:]
+	}
+}
+
+// updateOldestPendingBlobAgeLocked updates the metric containing the
+// amount of time the blob at the front of the queue has been pending
+// replication.
+func (br *JournalingBlobReplicator) updateOldestPendingBlobAgeLocked(now time.Time) {
+	br.trimQueueLocked()
+	if len(br.queue) == 0 {
+		br.oldestPendingBlobAgeSeconds.Set(0)
+	} else {
+		br.oldestPendingBlobAgeSeconds.Set(now.Sub(br.queue[0].EnqueuedTime).Seconds())
+	}
+}
+
+// getBatchLocked returns the oldest pending replications, up to the
+// maximum batch size. Entries at the front of the queue that are no
+// longer pending are removed.
+func (br *JournalingBlobReplicator) getBatchLocked() []*PendingReplication {
+	br.trimQueueLocked()
+
+	var batch []*PendingReplication
+	for _, pendingReplication := range br.queue {
+		if br.isPendingLocked(pendingReplication) {
+			if len(batch) == br.maximumBatchSize {
+				// More replications are pending than fit
+				// in a single batch.
+				br.wakeupProcessor()
+				break
+			}
+			batch = append(batch, pendingReplication)
+		}
+	}
+	return batch
+}
+
+// compactJournal rewrites the journal, so that it only contains
+// records for replications that are still pending. The new journal is
+// written to a temporary file, so that the old journal remains intact
+// if a crash occurs while writing. The caller must hold journalLock.
+func (br *JournalingBlobReplicator) compactJournal() error {
+	if br.journal != nil {
+		br.journal.Close()
+		br.journal = nil
+	}
+
+	if err := br.directory.Remove(componentJournalNew); err != nil && !os.IsNotExist(err) {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to remove previous temporary journal")
+	}
+	f, err := br.directory.OpenAppend(componentJournalNew, filesystem.CreateExcl(0o666))
+	if err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to create temporary journal")
+	}
+
+	// The set of pending replications cannot change while
+	// journalLock is held, meaning it is safe to write it after
+	// releasing lock.
+	br.lock.Lock()
+	queue := slices.Clone(br.getQueueLocked())
+	br.lock.Unlock()
+
+	w := bufio.NewWriter(f)
+	for _, pendingReplication := range queue {
+		if _, err := protodelim.MarshalTo(w, &replicator_pb.JournalRecord{
+			Type: &replicator_pb.JournalRecord_Enqueued{
+				Enqueued: pendingReplication.toProto(),
+			},
+		}); err != nil {
+			f.Close()
+			return util.StatusWrapWithCode(err, codes.Internal, "Failed to write to temporary journal")
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to write to temporary journal")
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to synchronize temporary journal")
+	}
+	if err := br.directory.Rename(componentJournalNew, br.directory, componentJournal); err != nil {
+		f.Close()
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to rename temporary journal")
+	}
+	if err := br.directory.Sync(); err != nil {
+		f.Close()
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to synchronize directory")
+	}
+	br.journal = f
+	br.journalRecordsCount = len(queue)
+	return nil
+}
+
+// appendToJournal appends a set of records to the journal, and waits
+// for them to be persisted. The caller must hold journalLock.
+func (br *JournalingBlobReplicator) appendToJournal(records []*replicator_pb.JournalRecord) error {
+	if br.journal == nil {
+		// A previous attempt to compact the journal failed.
+		if err := br.compactJournal(); err != nil {
+			return err
+		}
+	}
+
+	var data bytes.Buffer
+	for _, record := range records {
+		if _, err := protodelim.MarshalTo(&data, record); err != nil {
+			return util.StatusWrapWithCode(err, codes.Internal, "Failed to marshal journal record")
+		}
+	}
+	// If writing fails, the journal may end with a partially
+	// written record. Force compaction upon the next write, so that
+	// subsequent records remain readable.
+	if _, err := br.journal.Write(data.Bytes()); err != nil {
+		br.journal.Close()
+		br.journal = nil
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to write to journal")
+	}
+	if err := br.journal.Sync(); err != nil {
+		br.journal.Close()
+		br.journal = nil
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to synchronize journal")
+	}
+	br.journalRecordsCount += len(records)
+	return nil
+}
+
+// dequeueLocked removes a set of pending replications. It returns the
+// records that need to be written to the journal to record their
+// removal, and whether the journal should be compacted instead. This
+// is the case if it contains considerably more records than there are
+// pending replications. The caller must hold journalLock as well.
+func (br *JournalingBlobReplicator) dequeueLocked(pendingReplications []*PendingReplication) ([]*replicator_pb.JournalRecord, bool) {
+	if len(pendingReplications) == 0 {
+		return nil, false
+	}
+	records := make([]*replicator_pb.JournalRecord, 0, len(pendingReplications))
+	for _, pendingReplication := range pendingReplications {
+		delete(br.pending, pendingReplication.Digest.GetKey(br.digestKeyFormat))
+		records = append(records, &replicator_pb.JournalRecord{
+			Type: &replicator_pb.JournalRecord_Dequeued{
+				Dequeued: pendingReplication.toProto(),
+			},
+		})
+	}
+	br.pendingBlobs.Sub(float64(len(pendingReplications)))
+
+	compact := br.journalRecordsCount+len(records) > journalingBlobReplicatorMinimumCompactionRecordsCount &&
+		br.journalRecordsCount+len(records) > 2*len(br.pending)
+	return records, compact
+}
+
+// writeDequeued records the removal of pending replications in the
+// journal, using the results of dequeueLocked(). The caller must hold
+// journalLock.
+func (br *JournalingBlobReplicator) writeDequeued(records []*replicator_pb.JournalRecord, compact bool) error {
+	if compact {
+		return br.compactJournal()
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	return br.appendToJournal(records)
+}
+
+func (br *JournalingBlobReplicator) ReplicateSingle(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	return br.source.Get(ctx, blobDigest).WithTask(func() error {
+		if err := br.ReplicateMultiple(ctx, blobDigest.ToSingletonSet()); err != nil {
+			return util.StatusWrap(err, "Replication failed")
+		}
+		return nil
+	})
+}
+
+func (br *JournalingBlobReplicator) ReplicateComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	return br.source.GetFromComposite(ctx, parentDigest, childDigest, slicer).WithTask(func() error {
+		if err := br.ReplicateMultiple(ctx, parentDigest.ToSingletonSet()); err != nil {
+			return util.StatusWrap(err, "Replication failed")
+		}
+		return nil
+	})
+}
+
+func (br *JournalingBlobReplicator) ReplicateMultiple(ctx context.Context, digests digest.Set) error {
+	now := br.clock.Now()
+
+	// Only enqueue blobs that are not pending replication already.
+	// Blobs that are about to be written to the journal on behalf
+	// of other callers need to be waited upon.
+	var commits []*journalCommit
+	br.lock.Lock()
+	for _, blobDigest := range digests.Items() {
+		key := blobDigest.GetKey(br.digestKeyFormat)
+		if _, ok := br.pending[key]; ok {
+			continue
+		}
+		commit, ok := br.uncommitted[key]
+		if !ok {
+			if br.nextCommit == nil {
+				br.nextCommit = &journalCommit{}
+			}
+			commit = br.nextCommit
+			pendingReplication := &PendingReplication{
+				Digest:       blobDigest,
+				EnqueuedTime: now,
+			}
+			commit.pendingReplications = append(commit.pendingReplications, pendingReplication)
+			commit.records = append(commit.records, &replicator_pb.JournalRecord{
+				Type: &replicator_pb.JournalRecord_Enqueued{
+					Enqueued: pendingReplication.toProto(),
+				},
+			})
+			br.uncommitted[key] = commit
+		}
+		if !slices.Contains(commits, commit) {
+			commits = append(commits, commit)
+		}
+	}
+	br.lock.Unlock()
+	if len(commits) == 0 {
+		return nil
+	}
+
+	// Write all records that have been scheduled up to this point,
+	// including ones of other callers. If another caller acquired
+	// journalLock first, it may have written our records already.
+	// Either way, all commits we depend on have completed once
+	// journalLock is released.
+	br.journalLock.Lock()
+	br.lock.Lock()
+	commit := br.nextCommit
+	br.nextCommit = nil
+	br.lock.Unlock()
+	if commit != nil {
+		commit.err = br.appendToJournal(commit.records)
+
+		br.lock.Lock()
+		for _, pendingReplication := range commit.pendingReplications {
+			key := pendingReplication.Digest.GetKey(br.digestKeyFormat)
+			delete(br.uncommitted, key)
+			if commit.err == nil {
+				br.pending[key] = pendingReplication
+				br.queue = append(br.queue, pendingReplication)
+			}
+		}
+		if commit.err == nil {
+			br.pendingBlobs.Add(float64(len(commit.pendingReplications)))
+			br.enqueuedBlobs.Add(float64(len(commit.pendingReplications)))
+			br.wakeupProcessor()
+		}
+		br.lock.Unlock()
+	}
+	br.journalLock.Unlock()
+
+	for _, commit := range commits {
+		if commit.err != nil {
+			return commit.err
+		}
+	}
+	return nil
+}
+
+// ProcessPendingReplications waits for blobs to become pending
+// replication, and replicates a single batch of them.
+//
+// If replication of the batch fails, its blobs are replicated
+// individually, so that a single blob that cannot be replicated does
+// not prevent others from being replicated. Blobs that are not present
+// in the source are discarded, as replicating them will never succeed.
+// Blobs that fail to replicate for other reasons are moved to the back
+// of the queue, followed by waiting for the configured retry interval
+// to pass. This function returns false if the provided context is
+// cancelled.
+func (br *JournalingBlobReplicator) ProcessPendingReplications(ctx context.Context) bool {
+	// Wait for replications to be enqueued.
+	var batch []*PendingReplication
+	for len(batch) == 0 {
+		select {
+		case <-br.wakeup:
+		case <-ctx.Done():
+			return false
+		}
+		br.lock.Lock()
+		batch = br.getBatchLocked()
+		if len(batch) > 0 {
+			br.updateOldestPendingBlobAgeLocked(br.clock.Now())
+		}
+		br.lock.Unlock()
+	}
+
+	digests := digest.NewSetBuilder()
+	for _, pendingReplication := range batch {
+		digests.Add(pendingReplication.Digest)
+	}
+	// Callers of ReplicateMultiple() do not wait for replication to
+	// complete, meaning it can be performed in the background,
+	// unless the caller of this method requested otherwise.
+	replicateCtx := ctx
+	if _, ok := LookupPriorityFromContext(ctx); !ok {
+		replicateCtx = NewContextWithPriority(ctx, replicator_pb.Priority_BACKGROUND)
+	}
+	var replicated, missing, failed []*PendingReplication
+	if err := br.base.ReplicateMultiple(replicateCtx, digests.Build()); err == nil {
+		replicated = batch
+	} else {
+		if ctx.Err() != nil {
+			return false
+		}
+		br.errorLogger.Log(util.StatusWrap(err, "Failed to replicate blobs"))
+
+		for _, pendingReplication := range batch {
+			if len(batch) > 1 {
+				err = br.base.ReplicateMultiple(replicateCtx, pendingReplication.Digest.ToSingletonSet())
+				if ctx.Err() != nil {
+					return false
+				}
+			}
+			if err == nil {
+				replicated = append(replicated, pendingReplication)
+			} else if status.Code(err) == codes.NotFound {
+				br.errorLogger.Log(util.StatusWrapf(err, "Discarding blob %#v, as it is not present in the source", pendingReplication.Digest.String()))
+				missing = append(missing, pendingReplication)
+			} else {
+				if len(batch) > 1 {
+					br.errorLogger.Log(util.StatusWrapf(err, "Failed to replicate blob %#v", pendingReplication.Digest.String()))
+				}
+				failed = append(failed, pendingReplication)
+			}
+		}
+	}
+
+	// Remove the replicated and missing blobs from the queue. Skip
+	// blobs that have been purged or enqueued again in the
+	// meantime.
+	now := br.clock.Now()
+	br.journalLock.Lock()
+	br.lock.Lock()
+	var dequeued []*PendingReplication
+	replicatedCount := 0
+	for _, pendingReplication := range replicated {
+		if br.isPendingLocked(pendingReplication) {
+			dequeued = append(dequeued, pendingReplication)
+			br.queuedDurationSeconds.Observe(now.Sub(pendingReplication.EnqueuedTime).Seconds())
+			replicatedCount++
+		}
+	}
+	br.replicatedBlobs.Add(float64(replicatedCount))
+	for _, pendingReplication := range missing {
+		if br.isPendingLocked(pendingReplication) {
+			dequeued = append(dequeued, pendingReplication)
+			br.missingBlobs.Inc()
+		}
+	}
+	// Move blobs that failed to replicate to the back of the
+	// queue. There is no need to record this in the journal, as
+	// the order in which blobs are replicated is not guaranteed
+	// across restarts.
+	for _, pendingReplication := range failed {
+		if br.isPendingLocked(pendingReplication) {
+			requeued := *pendingReplication
+			br.pending[pendingReplication.Digest.GetKey(br.digestKeyFormat)] = &requeued
+			br.queue = append(br.queue, &requeued)
+		}
+	}
+	records, compact := br.dequeueLocked(dequeued)
+	br.updateOldestPendingBlobAgeLocked(now)
+	br.lock.Unlock()
+	if err := br.writeDequeued(records, compact); err != nil {
+		// Failing to record completion in the journal is not
+		// fatal. The blobs are merely replicated once more
+		// after a restart.
+		br.errorLogger.Log(util.StatusWrap(err, "Failed to record replicated blobs in journal"))
+	}
+	br.journalLock.Unlock()
+
+	if len(failed) > 0 {
+		// Retry after the retry interval.
+		br.wakeupProcessor()
+		timer, t := br.clock.NewTimer(br.retryInterval)
+		select {
+		case <-t:
+		case <-ctx.Done():
+			timer.Stop()
+			return false
+		}
+	}
+	return true
+}
+
+// ListPendingReplications returns the blobs that are pending
+// replication, in the order in which they are replicated. The number
+// of results is limited to the provided page size, unless it is zero.
+// The total number of pending replications is returned as well.
+func (br *JournalingBlobReplicator) ListPendingReplications(pageSize int) ([]PendingReplication, int) {
+	br.lock.Lock()
+	defer br.lock.Unlock()
+
+	queue := br.getQueueLocked()
+	if pageSize > 0 && len(queue) > pageSize {
+		queue = queue[:pageSize]
+	}
+	pendingReplications := make([]PendingReplication, 0, len(queue))
+	for _, pendingReplication := range queue {
+		pendingReplications = append(pendingReplications, *pendingReplication)
+	}
+	return pendingReplications, len(br.pending)
+}
+
+// PurgePendingReplications removes a set of blobs from the queue,
+// meaning they will not be replicated. Blobs that are not pending
+// replication are ignored.
+func (br *JournalingBlobReplicator) PurgePendingReplications(digests digest.Set) error {
+	now := br.clock.Now()
+	br.journalLock.Lock()
+	defer br.journalLock.Unlock()
+	br.lock.Lock()
+
+	var purged []*PendingReplication
+	for _, blobDigest := range digests.Items() {
+		if pendingReplication, ok := br.pending[blobDigest.GetKey(br.digestKeyFormat)]; ok {
+			purged = append(purged, pendingReplication)
+		}
+	}
+	br.purgedBlobs.Add(float64(len(purged)))
+	records, compact := br.dequeueLocked(purged)
+	br.updateOldestPendingBlobAgeLocked(now)
+	br.lock.Unlock()
+	return br.writeDequeued(records, compact)
+}
+
+// PurgeAllPendingReplications removes all blobs from the queue.
+func (br *JournalingBlobReplicator) PurgeAllPendingReplications() error {
+	br.journalLock.Lock()
+	defer br.journalLock.Unlock()
+	br.lock.Lock()
+
+	purged := len(br.pending)
+	br.pending = map[string]*PendingReplication{}
+	br.queue = nil
+	br.pendingBlobs.Sub(float64(purged))
+	br.purgedBlobs.Add(float64(purged))
+	br.oldestPendingBlobAgeSeconds.Set(0)
+	br.lock.Unlock()
+	return br.compactJournal()
+}
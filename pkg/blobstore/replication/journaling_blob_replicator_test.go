@@ -0,0 +1,271 @@
+package replication_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/replication"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	replicator_pb "github.com/buildbarn/bb-storage/pkg/proto/replicator"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestJournalingBlobReplicator(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	source := mock.NewMockBlobAccess(ctrl)
+	baseReplicator := mock.NewMockBlobReplicator(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	errorLogger := mock.NewMockErrorLogger(ctrl)
+	directory, err := filesystem.NewLocalDirectory(path.LocalFormat.NewParser(t.TempDir()))
+	require.NoError(t, err)
+	defer directory.Close()
+
+	newReplicator := func() *replication.JournalingBlobReplicator {
+		replicator, err := replication.NewJournalingBlobReplicator(
+			source,
+			baseReplicator,
+			digest.KeyWithoutInstance,
+			directory,
+			clock,
+			errorLogger,
+			2,
+			time.Minute,
+			"cas")
+		require.NoError(t, err)
+		return replicator
+	}
+
+	digest1 := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	digest2 := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "6fc422233a40a75a1f028e11c3cd1140", 7)
+	digest3 := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "1f9f6a4ecdba2d5d5b9a1bb9b43d9f05", 11)
+
+	replicator := newReplicator()
+
+	t.Run("Enqueue", func(t *testing.T) {
+		// Replication requests should complete immediately.
+		// Requests for blobs that are already pending should be
+		// merged.
+		clock.EXPECT().Now().Return(time.Unix(1000, 0).UTC())
+		require.NoError(t, replicator.ReplicateMultiple(ctx, digest.NewSetBuilder().Add(digest1).Add(digest2).Build()))
+		clock.EXPECT().Now().Return(time.Unix(1001, 0).UTC())
+		require.NoError(t, replicator.ReplicateMultiple(ctx, digest.NewSetBuilder().Add(digest2).Add(digest3).Build()))
+
+		pendingReplications, totalCount := replicator.ListPendingReplications(0)
+		require.Equal(t, []replication.PendingReplication{
+			{Digest: digest2, EnqueuedTime: time.Unix(1000, 0).UTC()},
+			{Digest: digest1, EnqueuedTime: time.Unix(1000, 0).UTC()},
+			{Digest: digest3, EnqueuedTime: time.Unix(1001, 0).UTC()},
+		}, pendingReplications)
+		require.Equal(t, 3, totalCount)
+
+		pendingReplications, totalCount = replicator.ListPendingReplications(1)
+		require.Len(t, pendingReplications, 1)
+		require.Equal(t, 3, totalCount)
+	})
+
+	t.Run("ReplicationFailure", func(t *testing.T) {
+		// Blobs should be replicated in batches of the
+		// configured size. If replicating a batch fails, its
+		// blobs should be retried individually. Failures should
+		// be logged, followed by waiting for the retry interval.
+		// Replication should be performed with a background
+		// priority, as no clients are waiting for it.
+		clock.EXPECT().Now().Return(time.Unix(1050, 0).UTC())
+		baseReplicator.EXPECT().ReplicateMultiple(gomock.Any(), digest.NewSetBuilder().Add(digest1).Add(digest2).Build()).
+			DoAndReturn(func(ctx context.Context, digests digest.Set) error {
+				require.Equal(t, replicator_pb.Priority_BACKGROUND, replication.PriorityFromContext(ctx))
+				return status.Error(codes.Unavailable, "Server not reachable")
+			})
+		errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.Unavailable, "Failed to replicate blobs: Server not reachable")))
+		baseReplicator.EXPECT().ReplicateMultiple(gomock.Any(), digest1.ToSingletonSet()).
+			Return(status.Error(codes.Unavailable, "Server not reachable"))
+		errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.Unavailable, "Failed to replicate blob \"3-8b1a9953c4611296a827abf8c47804d7-5-hello\": Server not reachable")))
+		baseReplicator.EXPECT().ReplicateMultiple(gomock.Any(), digest2.ToSingletonSet()).
+			Return(status.Error(codes.Unavailable, "Server not reachable"))
+		errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.Unavailable, "Failed to replicate blob \"3-6fc422233a40a75a1f028e11c3cd1140-7-hello\": Server not reachable")))
+		clock.EXPECT().Now().Return(time.Unix(1055, 0).UTC())
+		timer := mock.NewMockTimer(ctrl)
+		timerChannel := make(chan time.Time, 1)
+		timerChannel <- time.Unix(1060, 0).UTC()
+		clock.EXPECT().NewTimer(time.Minute).Return(timer, timerChannel)
+
+		require.True(t, replicator.ProcessPendingReplications(ctx))
+
+		// The blobs that failed to replicate should have been
+		// moved to the back of the queue.
+		pendingReplications, totalCount := replicator.ListPendingReplications(0)
+		require.Equal(t, []replication.PendingReplication{
+			{Digest: digest3, EnqueuedTime: time.Unix(1001, 0).UTC()},
+			{Digest: digest2, EnqueuedTime: time.Unix(1000, 0).UTC()},
+			{Digest: digest1, EnqueuedTime: time.Unix(1000, 0).UTC()},
+		}, pendingReplications)
+		require.Equal(t, 3, totalCount)
+	})
+
+	t.Run("Restart", func(t *testing.T) {
+		// Pending replications should be reloaded from the
+		// journal after a restart.
+		replicator = newReplicator()
+
+		pendingReplications, totalCount := replicator.ListPendingReplications(0)
+		require.Equal(t, []replication.PendingReplication{
+			{Digest: digest2, EnqueuedTime: time.Unix(1000, 0).UTC()},
+			{Digest: digest1, EnqueuedTime: time.Unix(1000, 0).UTC()},
+			{Digest: digest3, EnqueuedTime: time.Unix(1001, 0).UTC()},
+		}, pendingReplications)
+		require.Equal(t, 3, totalCount)
+	})
+
+	t.Run("ReplicationSuccess", func(t *testing.T) {
+		// If the caller provides an explicit priority, it
+		// should be used instead of the background priority.
+		clock.EXPECT().Now().Return(time.Unix(1065, 0).UTC())
+		baseReplicator.EXPECT().ReplicateMultiple(gomock.Any(), digest.NewSetBuilder().Add(digest1).Add(digest2).Build()).
+			DoAndReturn(func(ctx context.Context, digests digest.Set) error {
+				require.Equal(t, replicator_pb.Priority_BULK, replication.PriorityFromContext(ctx))
+				return nil
+			})
+		clock.EXPECT().Now().Return(time.Unix(1070, 0).UTC())
+
+		require.True(t, replicator.ProcessPendingReplications(replication.NewContextWithPriority(ctx, replicator_pb.Priority_BULK)))
+
+		pendingReplications, totalCount := replicator.ListPendingReplications(0)
+		require.Equal(t, []replication.PendingReplication{
+			{Digest: digest3, EnqueuedTime: time.Unix(1001, 0).UTC()},
+		}, pendingReplications)
+		require.Equal(t, 1, totalCount)
+	})
+
+	t.Run("Purge", func(t *testing.T) {
+		clock.EXPECT().Now().Return(time.Unix(1080, 0).UTC())
+		require.NoError(t, replicator.PurgePendingReplications(digest3.ToSingletonSet()))
+
+		_, totalCount := replicator.ListPendingReplications(0)
+		require.Equal(t, 0, totalCount)
+
+		// Replicated and purged blobs should not be reloaded
+		// after a restart.
+		replicator = newReplicator()
+
+		_, totalCount = replicator.ListPendingReplications(0)
+		require.Equal(t, 0, totalCount)
+	})
+
+	t.Run("MissingBlob", func(t *testing.T) {
+		// Blobs that are not present in the source can never be
+		// replicated. They should be discarded, so that they
+		// don't prevent other blobs from being replicated.
+		clock.EXPECT().Now().Return(time.Unix(1090, 0).UTC())
+		require.NoError(t, replicator.ReplicateMultiple(ctx, digest.NewSetBuilder().Add(digest1).Add(digest2).Build()))
+
+		clock.EXPECT().Now().Return(time.Unix(1091, 0).UTC())
+		baseReplicator.EXPECT().ReplicateMultiple(gomock.Any(), digest.NewSetBuilder().Add(digest1).Add(digest2).Build()).
+			Return(status.Error(codes.NotFound, "Object not found"))
+		errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.NotFound, "Failed to replicate blobs: Object not found")))
+		baseReplicator.EXPECT().ReplicateMultiple(gomock.Any(), digest1.ToSingletonSet())
+		baseReplicator.EXPECT().ReplicateMultiple(gomock.Any(), digest2.ToSingletonSet()).
+			Return(status.Error(codes.NotFound, "Object not found"))
+		errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.NotFound, "Discarding blob \"3-6fc422233a40a75a1f028e11c3cd1140-7-hello\", as it is not present in the source: Object not found")))
+		clock.EXPECT().Now().Return(time.Unix(1092, 0).UTC())
+
+		require.True(t, replicator.ProcessPendingReplications(ctx))
+
+		_, totalCount := replicator.ListPendingReplications(0)
+		require.Equal(t, 0, totalCount)
+
+		// The discarded blob should not be reloaded after a
+		// restart.
+		replicator = newReplicator()
+
+		_, totalCount = replicator.ListPendingReplications(0)
+		require.Equal(t, 0, totalCount)
+	})
+
+	t.Run("PurgeAll", func(t *testing.T) {
+		clock.EXPECT().Now().Return(time.Unix(1100, 0).UTC())
+		require.NoError(t, replicator.ReplicateMultiple(ctx, digest1.ToSingletonSet()))
+		require.NoError(t, replicator.PurgeAllPendingReplications())
+
+		_, totalCount := replicator.ListPendingReplications(0)
+		require.Equal(t, 0, totalCount)
+	})
+
+	t.Run("GarbageAtEndOfJournal", func(t *testing.T) {
+		// If the process crashes while appending to the
+		// journal, the journal may end with data that was never
+		// written. This should not prevent pending replications
+		// from being reloaded.
+		clock.EXPECT().Now().Return(time.Unix(1200, 0).UTC())
+		require.NoError(t, replicator.ReplicateMultiple(ctx, digest1.ToSingletonSet()))
+
+		f, err := directory.OpenAppend(path.MustNewComponent("journal"), filesystem.DontCreate)
+		require.NoError(t, err)
+		_, err = f.Write(make([]byte, 16))
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.InvalidArgument, "Discarding the last 16 bytes of the journal, starting at record index 1: Record has an unknown type")))
+		replicator = newReplicator()
+
+		pendingReplications, totalCount := replicator.ListPendingReplications(0)
+		require.Equal(t, []replication.PendingReplication{
+			{Digest: digest1, EnqueuedTime: time.Unix(1200, 0).UTC()},
+		}, pendingReplications)
+		require.Equal(t, 1, totalCount)
+
+		// The garbage should have been removed by compacting
+		// the journal, meaning that it is not reported again.
+		replicator = newReplicator()
+
+		require.NoError(t, replicator.PurgeAllPendingReplications())
+	})
+
+	t.Run("ConcurrentEnqueue", func(t *testing.T) {
+		// Concurrent calls may have their records written to
+		// the journal by other callers. All of them should only
+		// complete after their blobs have been persisted.
+		const callsCount = 10
+		clock.EXPECT().Now().Return(time.Unix(1300, 0).UTC()).Times(callsCount)
+		errs := make(chan error, callsCount)
+		for i := 0; i < callsCount; i++ {
+			go func() {
+				blobDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, fmt.Sprintf("%032x", i), 1)
+				errs <- replicator.ReplicateMultiple(ctx, digest.NewSetBuilder().Add(blobDigest).Add(digest1).Build())
+			}()
+		}
+		for i := 0; i < callsCount; i++ {
+			require.NoError(t, <-errs)
+		}
+
+		_, totalCount := replicator.ListPendingReplications(0)
+		require.Equal(t, callsCount+1, totalCount)
+
+		replicator = newReplicator()
+
+		_, totalCount = replicator.ListPendingReplications(0)
+		require.Equal(t, callsCount+1, totalCount)
+		require.NoError(t, replicator.PurgeAllPendingReplications())
+	})
+
+	t.Run("Cancellation", func(t *testing.T) {
+		// If no replications are pending, processing should
+		// block until the context is cancelled.
+		ctxCancelled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		require.False(t, replicator.ProcessPendingReplications(ctxCancelled))
+	})
+}
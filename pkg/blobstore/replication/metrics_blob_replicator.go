@@ -27,7 +27,7 @@ var (
 			Help:      "Amount of time spent per operation on blob replicator, in seconds.",
 			Buckets:   util.DecimalExponentialBuckets(-3, 6, 2),
 		},
-		[]string{"operation"})
+		[]string{"operation", "storage", "grpc_code"})
 
 	blobReplicatorOperationsBlobSizeBytes = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -37,7 +37,7 @@ var (
 			Help:      "Size of blobs being replicated, in bytes.",
 			Buckets:   prometheus.ExponentialBuckets(1.0, 2.0, 33),
 		},
-		[]string{"operation"})
+		[]string{"operation", "storage"})
 
 	blobReplicatorOperationsBatchSize = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -47,7 +47,7 @@ var (
 			Help:      "Number of blobs in batch replication requests.",
 			Buckets:   prometheus.ExponentialBuckets(1.0, 2.0, 17),
 		},
-		[]string{"operation"})
+		[]string{"operation", "storage"})
 )
 
 type metricsBlobReplicator struct {
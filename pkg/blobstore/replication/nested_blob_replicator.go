@@ -14,22 +14,56 @@ import (
 	"google.golang.org/protobuf/encoding/protowire"
 )
 
+// NestedObjectCompletionFunc is called by NestedBlobReplicator when an
+// object that was enqueued and all of the objects it references have
+// been processed. If replication of any of these objects failed, the
+// first error that occurred is provided.
+type NestedObjectCompletionFunc func(err error)
+
+// nestedObject keeps track of the progress of replicating an object,
+// either one that was enqueued by the caller or one that is referenced
+// by it. An object is finished once it has been replicated itself, and
+// all of the objects it references have finished.
+type nestedObject struct {
+	// Number of operations that need to complete before the object
+	// is finished. This includes replicating the object itself and
+	// any objects it references.
+	pending int
+	err     error
+	// Objects that are waiting for this object to finish.
+	parents  []*nestedObject
+	finished bool
+
+	// Only set for objects enqueued by the caller.
+	completionFunc NestedObjectCompletionFunc
+	isRoot         bool
+}
+
 type blobToReplicate struct {
-	digest       digest.Digest
-	expanderFunc func(ctx context.Context, b buffer.Buffer) error
+	digest        digest.Digest
+	object        *nestedObject
+	replicateFunc func(ctx context.Context) error
 }
 
 // NestedBlobReplicator is a helper type for BlobReplicator that can be
 // used to copy nested hierarchies of objects stored in the Content
 // Addressable Storage (CAS). In the case of the REv2 protocol, these
-// are Action, Directory and Tree messages.
+// are Action, Directory and Tree messages. In addition to that, it can
+// replicate the objects referenced by ActionResult messages stored in
+// the Action Cache (AC).
+//
+// Objects that are referenced by multiple enqueued objects are only
+// replicated once. Enqueued objects are only reported as completed
+// once all objects they reference have finished replicating, even if
+// replication of those objects was initiated on behalf of other
+// enqueued objects. Failures are reported to all of them.
 type NestedBlobReplicator struct {
 	replicator              BlobReplicator
 	digestKeyFormat         digest.KeyFormat
 	maximumMessageSizeBytes int
 
 	lock             sync.Mutex
-	blobsSeen        map[string]struct{}
+	blobsSeen        map[string]*nestedObject
 	blobsToReplicate []blobToReplicate
 	blobsReplicating int
 	wakeupChan       chan struct{}
@@ -43,22 +77,97 @@ func NewNestedBlobReplicator(replicator BlobReplicator, digestKeyFormat digest.K
 		digestKeyFormat:         digestKeyFormat,
 		maximumMessageSizeBytes: maximumMessageSizeBytes,
 
-		blobsSeen: map[string]struct{}{},
+		blobsSeen: map[string]*nestedObject{},
 	}
 }
 
-func (nr *NestedBlobReplicator) enqueue(blobDigest digest.Digest, expanderFunc func(ctx context.Context, b buffer.Buffer) error) {
+// enqueue an object to be replicated on behalf of a parent object. If
+// the object was already enqueued before, the parent merely waits for
+// it to finish.
+func (nr *NestedBlobReplicator) enqueue(blobDigest digest.Digest, parent *nestedObject, expanderFunc func(ctx context.Context, object *nestedObject, b buffer.Buffer) error) {
 	nr.lock.Lock()
 	defer nr.lock.Unlock()
 
 	key := blobDigest.GetKey(nr.digestKeyFormat)
-	if _, ok := nr.blobsSeen[key]; !ok {
-		nr.blobsSeen[key] = struct{}{}
-		nr.blobsToReplicate = append(nr.blobsToReplicate, blobToReplicate{
-			digest:       blobDigest,
-			expanderFunc: expanderFunc,
-		})
-		nr.maybeWakeUpLocked()
+	if object, ok := nr.blobsSeen[key]; ok {
+		nr.addDependencyLocked(parent, object)
+		return
+	}
+	object := &nestedObject{}
+	nr.blobsSeen[key] = object
+	nr.addDependencyLocked(parent, object)
+	nr.enqueueLocked(blobDigest, object, func(ctx context.Context) error {
+		return expanderFunc(ctx, object, nr.replicator.ReplicateSingle(ctx, blobDigest))
+	})
+}
+
+// addDependencyLocked causes a parent object to only finish after a
+// child object has finished. If the child has finished already, its
+// failure is propagated to the parent immediately.
+func (nr *NestedBlobReplicator) addDependencyLocked(parent, child *nestedObject) {
+	if child.finished {
+		if parent.err == nil {
+			parent.err = child.err
+		}
+		return
+	}
+	parent.pending++
+	child.parents = append(child.parents, parent)
+}
+
+func (nr *NestedBlobReplicator) enqueueLocked(blobDigest digest.Digest, object *nestedObject, replicateFunc func(ctx context.Context) error) {
+	nr.blobsToReplicate = append(nr.blobsToReplicate, blobToReplicate{
+		digest:        blobDigest,
+		object:        object,
+		replicateFunc: replicateFunc,
+	})
+	object.pending++
+	nr.maybeWakeUpLocked()
+}
+
+// completeLocked decrements the number of pending operations of an
+// object. If no operations remain, the object is finished, and its
+// parents are notified. Enqueued objects that finish are returned,
+// so that the caller can report their completion without holding the
+// lock.
+func (nr *NestedBlobReplicator) completeLocked(object *nestedObject, err error, finishedRoots []*nestedObject) []*nestedObject {
+	if err != nil && object.err == nil {
+		object.err = err
+	}
+	object.pending--
+	if object.pending > 0 {
+		return finishedRoots
+	}
+	object.finished = true
+	if object.isRoot {
+		finishedRoots = append(finishedRoots, object)
+	}
+	parents := object.parents
+	object.parents = nil
+	for _, parent := range parents {
+		finishedRoots = nr.completeLocked(parent, object.err, finishedRoots)
+	}
+	return finishedRoots
+}
+
+// enqueueRoot enqueues an object on behalf of the caller. If the
+// object was enqueued before, completion is reported as soon as it has
+// finished, which may be immediately.
+func (nr *NestedBlobReplicator) enqueueRoot(enqueueFunc func(blobDigest digest.Digest, parent *nestedObject), blobDigest digest.Digest, completionFunc NestedObjectCompletionFunc) {
+	// Hold on to the root object while enqueueing, so that it
+	// cannot finish before the object is enqueued.
+	root := &nestedObject{
+		pending:        1,
+		completionFunc: completionFunc,
+		isRoot:         true,
+	}
+	enqueueFunc(blobDigest, root)
+
+	nr.lock.Lock()
+	finishedRoots := nr.completeLocked(root, nil, nil)
+	nr.lock.Unlock()
+	if len(finishedRoots) > 0 && completionFunc != nil {
+		completionFunc(root.err)
 	}
 }
 
@@ -71,22 +180,31 @@ func (nr *NestedBlobReplicator) maybeWakeUpLocked() {
 
 // EnqueueAction enqueues an REv2 Action to be replicated. The
 // referenced input root and Command message will be replicated as well.
-func (nr *NestedBlobReplicator) EnqueueAction(actionDigest digest.Digest) {
+//
+// If a completion function is provided, it is called once the Action
+// and all of the objects it references have been processed. Failures
+// are then reported through the completion function, as opposed to
+// causing Replicate() to fail.
+func (nr *NestedBlobReplicator) EnqueueAction(actionDigest digest.Digest, completionFunc NestedObjectCompletionFunc) {
+	nr.enqueueRoot(nr.enqueueAction, actionDigest, completionFunc)
+}
+
+func (nr *NestedBlobReplicator) enqueueAction(actionDigest digest.Digest, parent *nestedObject) {
 	digestFunction := actionDigest.GetDigestFunction()
-	nr.enqueue(actionDigest, func(ctx context.Context, b buffer.Buffer) error {
+	nr.enqueue(actionDigest, parent, func(ctx context.Context, action *nestedObject, b buffer.Buffer) error {
 		actionMessage, err := b.ToProto(&remoteexecution.Action{}, nr.maximumMessageSizeBytes)
 		if err != nil {
 			return err
 		}
-		action := actionMessage.(*remoteexecution.Action)
+		actionProto := actionMessage.(*remoteexecution.Action)
 
-		inputRootDigest, err := digestFunction.NewDigestFromProto(action.InputRootDigest)
+		inputRootDigest, err := digestFunction.NewDigestFromProto(actionProto.InputRootDigest)
 		if err != nil {
 			return util.StatusWrap(err, "Invalid input root digest")
 		}
-		nr.EnqueueDirectory(inputRootDigest)
+		nr.enqueueDirectory(inputRootDigest, action)
 
-		commandDigest, err := digestFunction.NewDigestFromProto(action.CommandDigest)
+		commandDigest, err := digestFunction.NewDigestFromProto(actionProto.CommandDigest)
 		if err != nil {
 			return util.StatusWrap(err, "Invalid command digest")
 		}
@@ -99,10 +217,15 @@ func (nr *NestedBlobReplicator) EnqueueAction(actionDigest digest.Digest) {
 
 // EnqueueDirectory enqueues an REv2 Directory to be replicated. Any
 // referenced file or child Directory message will be replicated as
-// well, recursively.
-func (nr *NestedBlobReplicator) EnqueueDirectory(directoryDigest digest.Digest) {
+// well, recursively. The completion function behaves the same as for
+// EnqueueAction().
+func (nr *NestedBlobReplicator) EnqueueDirectory(directoryDigest digest.Digest, completionFunc NestedObjectCompletionFunc) {
+	nr.enqueueRoot(nr.enqueueDirectory, directoryDigest, completionFunc)
+}
+
+func (nr *NestedBlobReplicator) enqueueDirectory(directoryDigest digest.Digest, parent *nestedObject) {
 	digestFunction := directoryDigest.GetDigestFunction()
-	nr.enqueue(directoryDigest, func(ctx context.Context, b buffer.Buffer) error {
+	nr.enqueue(directoryDigest, parent, func(ctx context.Context, object *nestedObject, b buffer.Buffer) error {
 		directoryMessage, err := b.ToProto(&remoteexecution.Directory{}, nr.maximumMessageSizeBytes)
 		if err != nil {
 			return err
@@ -114,7 +237,7 @@ func (nr *NestedBlobReplicator) EnqueueDirectory(directoryDigest digest.Digest)
 			if err != nil {
 				return util.StatusWrapf(err, "Invalid digest for directory at index %d", i)
 			}
-			nr.EnqueueDirectory(childDigest)
+			nr.enqueueDirectory(childDigest, object)
 		}
 
 		childFileDigests := digest.NewSetBuilder()
@@ -133,10 +256,15 @@ func (nr *NestedBlobReplicator) EnqueueDirectory(directoryDigest digest.Digest)
 }
 
 // EnqueueTree enqueues an REv2 Tree to be replicated. Any referenced
-// file will be replicated as well.
-func (nr *NestedBlobReplicator) EnqueueTree(treeDigest digest.Digest) {
+// file will be replicated as well. The completion function behaves the
+// same as for EnqueueAction().
+func (nr *NestedBlobReplicator) EnqueueTree(treeDigest digest.Digest, completionFunc NestedObjectCompletionFunc) {
+	nr.enqueueRoot(nr.enqueueTree, treeDigest, completionFunc)
+}
+
+func (nr *NestedBlobReplicator) enqueueTree(treeDigest digest.Digest, parent *nestedObject) {
 	digestFunction := treeDigest.GetDigestFunction()
-	nr.enqueue(treeDigest, func(ctx context.Context, b buffer.Buffer) error {
+	nr.enqueue(treeDigest, parent, func(ctx context.Context, object *nestedObject, b buffer.Buffer) error {
 		r := b.ToReader()
 		defer r.Close()
 
@@ -180,9 +308,74 @@ func (nr *NestedBlobReplicator) EnqueueTree(treeDigest digest.Digest) {
 	})
 }
 
+// EnqueueActionResult enqueues the objects referenced by an REv2
+// ActionResult to be replicated. These include output files, the
+// standard output and error streams, and output directories. As
+// ActionResult messages are stored in the Action Cache, the message
+// itself is not replicated. The completion function may be used to
+// store the ActionResult after all of the objects it references have
+// been replicated.
+func (nr *NestedBlobReplicator) EnqueueActionResult(actionDigest digest.Digest, actionResult *remoteexecution.ActionResult, completionFunc NestedObjectCompletionFunc) {
+	digestFunction := actionDigest.GetDigestFunction()
+	root := &nestedObject{
+		completionFunc: completionFunc,
+		isRoot:         true,
+	}
+
+	nr.lock.Lock()
+	defer nr.lock.Unlock()
+
+	nr.enqueueLocked(actionDigest, root, func(ctx context.Context) error {
+		for i, outputDirectory := range actionResult.OutputDirectories {
+			treeDigest, err := digestFunction.NewDigestFromProto(outputDirectory.TreeDigest)
+			if err != nil {
+				return util.StatusWrapf(err, "Invalid tree digest for output directory at index %d", i)
+			}
+			nr.enqueueTree(treeDigest, root)
+			if outputDirectory.RootDirectoryDigest != nil {
+				rootDirectoryDigest, err := digestFunction.NewDigestFromProto(outputDirectory.RootDirectoryDigest)
+				if err != nil {
+					return util.StatusWrapf(err, "Invalid root directory digest for output directory at index %d", i)
+				}
+				nr.enqueueDirectory(rootDirectoryDigest, root)
+			}
+		}
+
+		fileDigests := digest.NewSetBuilder()
+		for i, outputFile := range actionResult.OutputFiles {
+			outputFileDigest, err := digestFunction.NewDigestFromProto(outputFile.Digest)
+			if err != nil {
+				return util.StatusWrapf(err, "Invalid digest for output file at index %d", i)
+			}
+			fileDigests.Add(outputFileDigest)
+		}
+		if actionResult.StdoutDigest != nil {
+			stdoutDigest, err := digestFunction.NewDigestFromProto(actionResult.StdoutDigest)
+			if err != nil {
+				return util.StatusWrap(err, "Invalid standard output digest")
+			}
+			fileDigests.Add(stdoutDigest)
+		}
+		if actionResult.StderrDigest != nil {
+			stderrDigest, err := digestFunction.NewDigestFromProto(actionResult.StderrDigest)
+			if err != nil {
+				return util.StatusWrap(err, "Invalid standard error digest")
+			}
+			fileDigests.Add(stderrDigest)
+		}
+		if err := nr.replicator.ReplicateMultiple(ctx, fileDigests.Build()); err != nil {
+			return util.StatusWrap(err, "Failed to replicate files")
+		}
+		return nil
+	})
+}
+
 // Replicate objects that are enqueued. This method will continue to run
 // until all enqueued objects are replicated. It is safe to call this
 // method from multiple goroutines, to increase parallelism.
+//
+// Failures to replicate objects that were enqueued without a
+// completion function cause this method to return immediately.
 func (nr *NestedBlobReplicator) Replicate(ctx context.Context) error {
 	nr.lock.Lock()
 	for {
@@ -214,10 +407,7 @@ func (nr *NestedBlobReplicator) Replicate(ctx context.Context) error {
 		// Replicate a single object.
 		nr.blobsReplicating++
 		nr.lock.Unlock()
-		err := blobToReplicate.expanderFunc(
-			ctx,
-			nr.replicator.ReplicateSingle(ctx, blobToReplicate.digest),
-		)
+		err := blobToReplicate.replicateFunc(ctx)
 		nr.lock.Lock()
 		nr.blobsReplicating--
 
@@ -229,8 +419,26 @@ func (nr *NestedBlobReplicator) Replicate(ctx context.Context) error {
 		}
 
 		if err != nil {
+			err = util.StatusWrapf(err, "Failed to replicate nested object %#v", blobToReplicate.digest)
+		}
+
+		// Report completion of enqueued objects once all of
+		// the objects they reference have finished.
+		finishedRoots := nr.completeLocked(blobToReplicate.object, err, nil)
+		if len(finishedRoots) > 0 {
 			nr.lock.Unlock()
-			return util.StatusWrapf(err, "Failed to replicate nested object %#v", blobToReplicate.digest)
+			var rootErr error
+			for _, root := range finishedRoots {
+				if root.completionFunc != nil {
+					root.completionFunc(root.err)
+				} else if rootErr == nil {
+					rootErr = root.err
+				}
+			}
+			if rootErr != nil {
+				return rootErr
+			}
+			nr.lock.Lock()
 		}
 	}
 }
@@ -11,6 +11,9 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/stretchr/testify/require"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"go.uber.org/mock/gomock"
 )
 
@@ -27,10 +30,10 @@ func TestNestedBlobReplicator(t *testing.T) {
 
 	t.Run("Example", func(t *testing.T) {
 		// Enqueue some objects that can be replicated.
-		nestedReplicator.EnqueueAction(digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "3cd3b79f60145bdb838c8fda08b0f6a4", 1))
-		nestedReplicator.EnqueueDirectory(digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "006a8fcea3babf8b029e14faba3553f4", 2))
-		nestedReplicator.EnqueueDirectory(digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "73586ba4d59d7503bda905048f2ac409", 3))
-		nestedReplicator.EnqueueTree(digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "7c44eaf20479782e179eb32f9aac16d9", 4))
+		nestedReplicator.EnqueueAction(digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "3cd3b79f60145bdb838c8fda08b0f6a4", 1), nil)
+		nestedReplicator.EnqueueDirectory(digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "006a8fcea3babf8b029e14faba3553f4", 2), nil)
+		nestedReplicator.EnqueueDirectory(digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "73586ba4d59d7503bda905048f2ac409", 3), nil)
+		nestedReplicator.EnqueueTree(digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "7c44eaf20479782e179eb32f9aac16d9", 4), nil)
 
 		// Replicating data should all of the objects above, but
 		// also their transitive dependencies to be replicated.
@@ -154,4 +157,231 @@ func TestNestedBlobReplicator(t *testing.T) {
 
 		require.NoError(t, nestedReplicator.Replicate(ctx))
 	})
+	t.Run("CompletionFunc", func(t *testing.T) {
+		// When objects are enqueued with a completion function,
+		// failures should be reported through it, as opposed to
+		// causing replication to stop.
+		var errA, errB, errC error
+		completedA, completedB, completedC := false, false, false
+		nestedReplicator.EnqueueDirectory(
+			digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "4cf4ed2d8a7cbca8af7bd7e6a0e8c5a1", 13),
+			func(err error) {
+				errA, completedA = err, true
+			})
+		nestedReplicator.EnqueueDirectory(
+			digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "a50d19d7d2a1fbd4e5c71d6b5b2c0a0b", 14),
+			func(err error) {
+				errB, completedB = err, true
+			})
+
+		// Objects that were enqueued before should be reported
+		// as completed immediately.
+		nestedReplicator.EnqueueDirectory(
+			digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "73586ba4d59d7503bda905048f2ac409", 3),
+			func(err error) {
+				errC, completedC = err, true
+			})
+		require.True(t, completedC)
+		require.NoError(t, errC)
+
+		replicator.EXPECT().ReplicateSingle(ctx, digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "4cf4ed2d8a7cbca8af7bd7e6a0e8c5a1", 13)).
+			Return(buffer.NewProtoBufferFromProto(&remoteexecution.Directory{
+				Directories: []*remoteexecution.DirectoryNode{
+					{
+						Name: "directory4",
+						Digest: &remoteexecution.Digest{
+							Hash:      "0f4a1b8e2c6d3f5a7b9c1d3e5f7a9b1c",
+							SizeBytes: 15,
+						},
+					},
+				},
+			}, buffer.UserProvided))
+		replicator.EXPECT().ReplicateSingle(ctx, digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "0f4a1b8e2c6d3f5a7b9c1d3e5f7a9b1c", 15)).
+			Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+		replicator.EXPECT().ReplicateSingle(ctx, digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "a50d19d7d2a1fbd4e5c71d6b5b2c0a0b", 14)).
+			Return(buffer.NewProtoBufferFromProto(&remoteexecution.Directory{}, buffer.UserProvided))
+
+		require.NoError(t, nestedReplicator.Replicate(ctx))
+		require.True(t, completedA)
+		require.Equal(t, codes.NotFound, status.Code(errA))
+		require.True(t, completedB)
+		require.NoError(t, errB)
+	})
+	t.Run("SharedObjects", func(t *testing.T) {
+		// Objects that are referenced by multiple enqueued
+		// objects are only replicated once. Completion of all
+		// enqueued objects should only be reported after the
+		// shared object finished, and failures should be
+		// reported to all of them.
+		var errA, errB, errC, errD error
+		completedA, completedB, completedC, completedD := false, false, false, false
+		nestedReplicator.EnqueueDirectory(
+			digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "19a1c3e5b7d9f1a3c5e7b9d1f3a5c7e9", 21),
+			func(err error) {
+				errA, completedA = err, true
+			})
+		nestedReplicator.EnqueueDirectory(
+			digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "2ab2d4f6c8e0a2b4d6f8c0e2a4b6d8f0", 22),
+			func(err error) {
+				errB, completedB = err, true
+			})
+
+		// Enqueueing an object that was enqueued before, but
+		// has not been replicated yet, should not cause
+		// completion to be reported immediately.
+		nestedReplicator.EnqueueDirectory(
+			digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "19a1c3e5b7d9f1a3c5e7b9d1f3a5c7e9", 21),
+			func(err error) {
+				errC, completedC = err, true
+			})
+		require.False(t, completedC)
+
+		sharedDirectory := &remoteexecution.Directory{
+			Directories: []*remoteexecution.DirectoryNode{
+				{
+					Name: "shared",
+					Digest: &remoteexecution.Digest{
+						Hash:      "3bc3e5a7d9f1b3c5e7a9d1f3b5c7e9a1",
+						SizeBytes: 23,
+					},
+				},
+			},
+		}
+		replicator.EXPECT().ReplicateSingle(ctx, digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "19a1c3e5b7d9f1a3c5e7b9d1f3a5c7e9", 21)).
+			Return(buffer.NewProtoBufferFromProto(sharedDirectory, buffer.UserProvided))
+		replicator.EXPECT().ReplicateSingle(ctx, digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "2ab2d4f6c8e0a2b4d6f8c0e2a4b6d8f0", 22)).
+			Return(buffer.NewProtoBufferFromProto(sharedDirectory, buffer.UserProvided))
+		replicator.EXPECT().ReplicateSingle(ctx, digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "3bc3e5a7d9f1b3c5e7a9d1f3b5c7e9a1", 23)).
+			Return(buffer.NewBufferFromError(status.Error(codes.Internal, "Disk on fire")))
+
+		require.NoError(t, nestedReplicator.Replicate(ctx))
+		require.True(t, completedA)
+		require.Equal(t, codes.Internal, status.Code(errA))
+		require.True(t, completedB)
+		require.Equal(t, codes.Internal, status.Code(errB))
+		require.True(t, completedC)
+		require.Equal(t, codes.Internal, status.Code(errC))
+
+		// Enqueueing an object that failed before should report
+		// the same failure immediately.
+		nestedReplicator.EnqueueDirectory(
+			digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "2ab2d4f6c8e0a2b4d6f8c0e2a4b6d8f0", 22),
+			func(err error) {
+				errD, completedD = err, true
+			})
+		require.True(t, completedD)
+		require.Equal(t, codes.Internal, status.Code(errD))
+	})
+	t.Run("ActionResult", func(t *testing.T) {
+		// Enqueueing an ActionResult should cause its output
+		// files and directories to be replicated. The completion
+		// function should only be called afterwards.
+		completed := false
+		nestedReplicator.EnqueueActionResult(
+			digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "b8d3c9a7e2f1a0b9c8d7e6f5a4b3c2d1", 16),
+			&remoteexecution.ActionResult{
+				OutputFiles: []*remoteexecution.OutputFile{
+					{
+						Path: "file5",
+						Digest: &remoteexecution.Digest{
+							Hash:      "c1d2e3f4a5b6c7d8e9f0a1b2c3d4e5f6",
+							SizeBytes: 17,
+						},
+					},
+				},
+				OutputDirectories: []*remoteexecution.OutputDirectory{
+					{
+						Path: "directory5",
+						TreeDigest: &remoteexecution.Digest{
+							Hash:      "d2e3f4a5b6c7d8e9f0a1b2c3d4e5f6a7",
+							SizeBytes: 18,
+						},
+					},
+				},
+				StdoutDigest: &remoteexecution.Digest{
+					Hash:      "e3f4a5b6c7d8e9f0a1b2c3d4e5f6a7b8",
+					SizeBytes: 19,
+				},
+			},
+			func(err error) {
+				require.NoError(t, err)
+				completed = true
+			})
+
+		replicator.EXPECT().ReplicateMultiple(
+			ctx,
+			digest.NewSetBuilder().
+				Add(digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "c1d2e3f4a5b6c7d8e9f0a1b2c3d4e5f6", 17)).
+				Add(digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "e3f4a5b6c7d8e9f0a1b2c3d4e5f6a7b8", 19)).
+				Build())
+		replicator.EXPECT().ReplicateSingle(ctx, digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "d2e3f4a5b6c7d8e9f0a1b2c3d4e5f6a7", 18)).
+			Return(buffer.NewProtoBufferFromProto(&remoteexecution.Tree{
+				Root: &remoteexecution.Directory{
+					Files: []*remoteexecution.FileNode{
+						{
+							Name: "file6",
+							Digest: &remoteexecution.Digest{
+								Hash:      "f4a5b6c7d8e9f0a1b2c3d4e5f6a7b8c9",
+								SizeBytes: 20,
+							},
+						},
+					},
+				},
+			}, buffer.UserProvided))
+		replicator.EXPECT().ReplicateMultiple(
+			ctx,
+			digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "f4a5b6c7d8e9f0a1b2c3d4e5f6a7b8c9", 20).ToSingletonSet())
+
+		require.NoError(t, nestedReplicator.Replicate(ctx))
+		require.True(t, completed)
+	})
+
+	t.Run("ActionResultSharedTree", func(t *testing.T) {
+		// When multiple ActionResults reference the same output
+		// directory, the completion functions of all of them
+		// should be called after the tree has been replicated.
+		// This prevents Action Cache entries from being stored
+		// while the objects they reference are missing.
+		actionResult := &remoteexecution.ActionResult{
+			OutputDirectories: []*remoteexecution.OutputDirectory{
+				{
+					Path: "directory7",
+					TreeDigest: &remoteexecution.Digest{
+						Hash:      "4cd4f6b8e0a2c4d6f8b0e2a4c6d8f0b2",
+						SizeBytes: 24,
+					},
+				},
+			},
+		}
+		var errA, errB error
+		completedA, completedB := false, false
+		nestedReplicator.EnqueueActionResult(
+			digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "5de5a7c9f1b3d5e7a9c1f3b5d7e9a1c3", 25),
+			actionResult,
+			func(err error) {
+				errA, completedA = err, true
+			})
+		nestedReplicator.EnqueueActionResult(
+			digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "6ef6b8d0a2c4e6f8b0d2a4c6e8f0b2d4", 26),
+			actionResult,
+			func(err error) {
+				errB, completedB = err, true
+			})
+
+		replicator.EXPECT().ReplicateSingle(ctx, digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "4cd4f6b8e0a2c4d6f8b0e2a4c6d8f0b2", 24)).
+			DoAndReturn(func(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+				// Neither ActionResult may be completed
+				// while its output directory is still
+				// being replicated.
+				require.False(t, completedA)
+				require.False(t, completedB)
+				return buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found"))
+			})
+
+		require.NoError(t, nestedReplicator.Replicate(ctx))
+		require.True(t, completedA)
+		require.Equal(t, codes.NotFound, status.Code(errA))
+		require.True(t, completedB)
+		require.Equal(t, codes.NotFound, status.Code(errB))
+	})
 }
@@ -0,0 +1,39 @@
+package replication
+
+import (
+	"context"
+
+	replicator_pb "github.com/buildbarn/bb-storage/pkg/proto/replicator"
+)
+
+type priorityKey struct{}
+
+// NewContextWithPriority creates a new Context object that has a
+// replication priority attached to it. Replication requests issued
+// using this context are scheduled according to this priority by
+// replicators that limit concurrency.
+func NewContextWithPriority(ctx context.Context, priority replicator_pb.Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+// PriorityFromContext reobtains the replication priority that was
+// attached to the Context object.
+//
+// If the Context object contains no priority, INTERACTIVE is returned.
+// This ensures that replication performed on behalf of clients that
+// are waiting for data is never deprioritized unintentionally.
+func PriorityFromContext(ctx context.Context) replicator_pb.Priority {
+	if priority, ok := LookupPriorityFromContext(ctx); ok {
+		return priority
+	}
+	return replicator_pb.Priority_INTERACTIVE
+}
+
+// LookupPriorityFromContext reobtains the replication priority that
+// was attached to the Context object. The boolean return value
+// indicates whether the Context object contained a priority. This
+// permits callers to pick a different default priority.
+func LookupPriorityFromContext(ctx context.Context) (replicator_pb.Priority, bool) {
+	priority, ok := ctx.Value(priorityKey{}).(replicator_pb.Priority)
+	return priority, ok
+}
@@ -0,0 +1,144 @@
+package replication
+
+import (
+	"container/list"
+	"context"
+	"sync"
+
+	replicator_pb "github.com/buildbarn/bb-storage/pkg/proto/replicator"
+	"github.com/buildbarn/bb-storage/pkg/util"
+)
+
+// priorityStrideNumerator is divided by the weight of a priority class
+// to obtain the amount by which its pass value is advanced every time
+// one of its requests is admitted.
+const priorityStrideNumerator = 1 << 32
+
+type priorityClass struct {
+	stride  uint64
+	pass    uint64
+	waiters list.List
+}
+
+type priorityWaiter struct {
+	ready chan struct{}
+}
+
+// PriorityScheduler is a counting semaphore that admits waiting
+// requests based on their priority class. It is used by
+// ConcurrencyLimitingBlobReplicator to let replication performed on
+// behalf of interactive clients take precedence over background
+// repairs and bulk copying.
+//
+// Priority classes are scheduled using stride scheduling. Each class
+// has a weight, and under contention every class is admitted a share
+// of the capacity that is proportional to its weight. This means that
+// lower priority classes are never starved entirely. Requests within
+// the same class are admitted in the order in which they arrived.
+type PriorityScheduler struct {
+	lock          sync.Mutex
+	available     int64
+	classes       []priorityClass
+	waitersCount  int
+	schedulerPass uint64
+}
+
+// NewPriorityScheduler creates a PriorityScheduler that admits up to a
+// given number of concurrent requests. Classes for which no positive
+// weight is provided are given a weight of one.
+func NewPriorityScheduler(maximumConcurrency int64, weights map[replicator_pb.Priority]uint32) *PriorityScheduler {
+	classes := make([]priorityClass, len(replicator_pb.Priority_name))
+	for i := range classes {
+		weight := uint64(1)
+		if w, ok := weights[replicator_pb.Priority(i)]; ok && w > 0 {
+			weight = uint64(w)
+		}
+		classes[i].stride = priorityStrideNumerator / weight
+	}
+	return &PriorityScheduler{
+		available: maximumConcurrency,
+		classes:   classes,
+	}
+}
+
+func (s *PriorityScheduler) getClass(priority replicator_pb.Priority) *priorityClass {
+	if priority < 0 || int(priority) >= len(s.classes) {
+		// Treat unknown priorities as the lowest priority.
+		return &s.classes[len(s.classes)-1]
+	}
+	return &s.classes[priority]
+}
+
+// Acquire blocks until a request of a given priority is admitted.
+// Every successful call to Acquire() must be followed by a call to
+// Release().
+func (s *PriorityScheduler) Acquire(ctx context.Context, priority replicator_pb.Priority) error {
+	if ctx.Err() != nil {
+		return util.StatusFromContext(ctx)
+	}
+
+	s.lock.Lock()
+	if s.available > 0 {
+		s.available--
+		s.lock.Unlock()
+		return nil
+	}
+
+	// Prevent classes that were idle from being admitted in bursts
+	// by letting them catch up with the classes that were active.
+	class := s.getClass(priority)
+	if class.waiters.Len() == 0 && class.pass < s.schedulerPass {
+		class.pass = s.schedulerPass
+	}
+	waiter := &priorityWaiter{ready: make(chan struct{})}
+	element := class.waiters.PushBack(waiter)
+	s.waitersCount++
+	s.lock.Unlock()
+
+	select {
+	case <-waiter.ready:
+		return nil
+	case <-ctx.Done():
+		s.lock.Lock()
+		select {
+		case <-waiter.ready:
+			// The request was admitted concurrently with the
+			// context being canceled. Hand the capacity over
+			// to the next waiter.
+			s.lock.Unlock()
+			s.Release()
+		default:
+			class.waiters.Remove(element)
+			s.waitersCount--
+			s.lock.Unlock()
+		}
+		return util.StatusFromContext(ctx)
+	}
+}
+
+// Release capacity that was obtained by calling Acquire(), admitting
+// the next waiting request if any.
+func (s *PriorityScheduler) Release() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.waitersCount == 0 {
+		s.available++
+		return
+	}
+
+	// Admit the first request of the class with the lowest pass
+	// value. Ties are broken in favor of higher priority classes.
+	var selectedClass *priorityClass
+	for i := range s.classes {
+		class := &s.classes[i]
+		if class.waiters.Len() > 0 && (selectedClass == nil || class.pass < selectedClass.pass) {
+			selectedClass = class
+		}
+	}
+	s.schedulerPass = selectedClass.pass
+	selectedClass.pass += selectedClass.stride
+	waiter := selectedClass.waiters.Remove(selectedClass.waiters.Front()).(*priorityWaiter)
+	s.waitersCount--
+	close(waiter.ready)
+}
@@ -0,0 +1,112 @@
+package replication_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/replication"
+	replicator_pb "github.com/buildbarn/bb-storage/pkg/proto/replicator"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// enqueueNotifyingContext is a Context that reports when
+// PriorityScheduler.Acquire() starts waiting on it. This happens after
+// the request has been queued, allowing tests to control the order in
+// which requests arrive.
+type enqueueNotifyingContext struct {
+	context.Context
+	enqueued chan struct{}
+}
+
+func (ctx enqueueNotifyingContext) Done() <-chan struct{} {
+	close(ctx.enqueued)
+	return ctx.Context.Done()
+}
+
+type admission struct {
+	name string
+	err  error
+}
+
+func acquireInBackground(ctx context.Context, scheduler *replication.PriorityScheduler, priority replicator_pb.Priority, name string, admissions chan<- admission) {
+	enqueued := make(chan struct{})
+	go func() {
+		err := scheduler.Acquire(enqueueNotifyingContext{Context: ctx, enqueued: enqueued}, priority)
+		admissions <- admission{name: name, err: err}
+	}()
+	<-enqueued
+}
+
+func TestPriorityScheduler(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Immediate", func(t *testing.T) {
+		// Requests should be admitted immediately as long as
+		// the maximum concurrency is not reached.
+		scheduler := replication.NewPriorityScheduler(2, nil)
+		require.NoError(t, scheduler.Acquire(ctx, replicator_pb.Priority_BULK))
+		require.NoError(t, scheduler.Acquire(ctx, replicator_pb.Priority_INTERACTIVE))
+	})
+
+	t.Run("Weighted", func(t *testing.T) {
+		// Under contention, requests should be admitted in
+		// proportion to the weights of their priority classes.
+		// Requests of the same class should be admitted in the
+		// order in which they arrived.
+		scheduler := replication.NewPriorityScheduler(1, map[replicator_pb.Priority]uint32{
+			replicator_pb.Priority_INTERACTIVE: 2,
+			replicator_pb.Priority_BACKGROUND:  1,
+			replicator_pb.Priority_BULK:        1,
+		})
+		require.NoError(t, scheduler.Acquire(ctx, replicator_pb.Priority_INTERACTIVE))
+
+		admissions := make(chan admission, 8)
+		acquireInBackground(ctx, scheduler, replicator_pb.Priority_BULK, "Bulk1", admissions)
+		acquireInBackground(ctx, scheduler, replicator_pb.Priority_BULK, "Bulk2", admissions)
+		acquireInBackground(ctx, scheduler, replicator_pb.Priority_BACKGROUND, "Background1", admissions)
+		acquireInBackground(ctx, scheduler, replicator_pb.Priority_BACKGROUND, "Background2", admissions)
+		acquireInBackground(ctx, scheduler, replicator_pb.Priority_INTERACTIVE, "Interactive1", admissions)
+		acquireInBackground(ctx, scheduler, replicator_pb.Priority_INTERACTIVE, "Interactive2", admissions)
+		acquireInBackground(ctx, scheduler, replicator_pb.Priority_INTERACTIVE, "Interactive3", admissions)
+		acquireInBackground(ctx, scheduler, replicator_pb.Priority_INTERACTIVE, "Interactive4", admissions)
+
+		var order []string
+		for range 8 {
+			scheduler.Release()
+			a := <-admissions
+			require.NoError(t, a.err)
+			order = append(order, a.name)
+		}
+		require.Equal(t, []string{
+			"Interactive1",
+			"Background1",
+			"Bulk1",
+			"Interactive2",
+			"Interactive3",
+			"Background2",
+			"Bulk2",
+			"Interactive4",
+		}, order)
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		// Requests whose context is canceled while waiting
+		// should be removed from the queue, so that they don't
+		// consume any capacity.
+		scheduler := replication.NewPriorityScheduler(1, nil)
+		require.NoError(t, scheduler.Acquire(ctx, replicator_pb.Priority_INTERACTIVE))
+
+		canceledCtx, cancel := context.WithCancel(ctx)
+		admissions := make(chan admission, 1)
+		acquireInBackground(canceledCtx, scheduler, replicator_pb.Priority_BULK, "Bulk", admissions)
+		cancel()
+		testutil.RequireEqualStatus(t, status.Error(codes.Canceled, "context canceled"), (<-admissions).err)
+
+		scheduler.Release()
+		require.NoError(t, scheduler.Acquire(ctx, replicator_pb.Priority_BACKGROUND))
+	})
+}
@@ -36,6 +36,7 @@ func (br *remoteBlobReplicator) ReplicateSingle(ctx context.Context, digest dige
 		_, err := br.replicatorClient.ReplicateBlobs(ctx, &replicator.ReplicateBlobsRequest{
 			InstanceName:   digestFunction.GetInstanceName().String(),
 			DigestFunction: digestFunction.GetEnumValue(),
+			Priority:       PriorityFromContext(ctx),
 			BlobDigests: []*remoteexecution.Digest{
 				digest.GetProto(),
 			},
@@ -50,6 +51,7 @@ func (br *remoteBlobReplicator) ReplicateComposite(ctx context.Context, parentDi
 		_, err := br.replicatorClient.ReplicateBlobs(ctx, &replicator.ReplicateBlobsRequest{
 			InstanceName:   digestFunction.GetInstanceName().String(),
 			DigestFunction: digestFunction.GetEnumValue(),
+			Priority:       PriorityFromContext(ctx),
 			BlobDigests: []*remoteexecution.Digest{
 				parentDigest.GetProto(),
 			},
@@ -75,6 +77,7 @@ func (br *remoteBlobReplicator) ReplicateMultiple(ctx context.Context, digests d
 			InstanceName:   digestFunction.GetInstanceName().String(),
 			DigestFunction: digestFunction.GetEnumValue(),
 			BlobDigests:    blobDigests,
+			Priority:       PriorityFromContext(ctx),
 		}
 		if _, err := br.replicatorClient.ReplicateBlobs(ctx, &request); err != nil {
 			return err
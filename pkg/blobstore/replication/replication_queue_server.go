@@ -0,0 +1,67 @@
+package replication
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	replicator_pb "github.com/buildbarn/bb-storage/pkg/proto/replicator"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type replicationQueueServer struct {
+	replicator *JournalingBlobReplicator
+}
+
+// NewReplicationQueueServer creates a gRPC stub for the
+// ReplicationQueue service that forwards all calls to
+// JournalingBlobReplicator.
+func NewReplicationQueueServer(replicator *JournalingBlobReplicator) replicator_pb.ReplicationQueueServer {
+	return replicationQueueServer{
+		replicator: replicator,
+	}
+}
+
+func (rqs replicationQueueServer) ListPendingReplications(ctx context.Context, request *replicator_pb.ListPendingReplicationsRequest) (*replicator_pb.ListPendingReplicationsResponse, error) {
+	pendingReplications, totalCount := rqs.replicator.ListPendingReplications(int(request.PageSize))
+	response := &replicator_pb.ListPendingReplicationsResponse{
+		PendingReplications: make([]*replicator_pb.PendingReplication, 0, len(pendingReplications)),
+		TotalCount:          uint64(totalCount),
+	}
+	for _, pendingReplication := range pendingReplications {
+		response.PendingReplications = append(response.PendingReplications, pendingReplication.toProto())
+	}
+	return response, nil
+}
+
+func (rqs replicationQueueServer) PurgePendingReplications(ctx context.Context, request *replicator_pb.PurgePendingReplicationsRequest) (*emptypb.Empty, error) {
+	if request.All {
+		if err := rqs.replicator.PurgeAllPendingReplications(); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}
+
+	instanceName, err := digest.NewInstanceName(request.InstanceName)
+	if err != nil {
+		return nil, util.StatusWrapf(err, "Invalid instance name %#v", request.InstanceName)
+	}
+	digestFunction, err := instanceName.GetDigestFunction(request.DigestFunction, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	digests := digest.NewSetBuilder()
+	for i, blobDigest := range request.BlobDigests {
+		d, err := digestFunction.NewDigestFromProto(blobDigest)
+		if err != nil {
+			return nil, util.StatusWrapf(err, "Digest at index %d", i)
+		}
+		digests.Add(d)
+	}
+	if err := rqs.replicator.PurgePendingReplications(digests.Build()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -40,5 +40,10 @@ func (rs replicatorServer) ReplicateBlobs(ctx context.Context, request *replicat
 		}
 		digests.Add(d)
 	}
+
+	// Preserve the priority with which the client requested
+	// replication, so that it is respected by replicators that
+	// limit concurrency.
+	ctx = NewContextWithPriority(ctx, request.Priority)
 	return &emptypb.Empty{}, rs.replicator.ReplicateMultiple(ctx, digests.Build())
 }
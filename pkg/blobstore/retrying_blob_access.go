@@ -0,0 +1,182 @@
+package blobstore
+
+import (
+	"context"
+	"iter"
+	"sync"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	retryingBlobAccessPrometheusMetrics sync.Once
+
+	retryingBlobAccessRetries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "retrying_blob_access_retries_total",
+			Help:      "Number of times RetryingBlobAccess retried a request after it failed with a retriable error.",
+		},
+		[]string{"storage_type", "operation"})
+)
+
+type retryingBlobAccess struct {
+	BlobAccess
+	clock           clock.Clock
+	maximumAttempts int
+	initialBackoff  time.Duration
+	maximumBackoff  time.Duration
+
+	getRetries              prometheus.Counter
+	getFromCompositeRetries prometheus.Counter
+	findMissingRetries      prometheus.Counter
+	getCapabilitiesRetries  prometheus.Counter
+}
+
+// NewRetryingBlobAccess creates a decorator for BlobAccess that retries
+// read requests that fail with UNAVAILABLE, or with DEADLINE_EXCEEDED
+// while the caller's context has not expired. Retries are performed
+// with exponential backoff.
+//
+// Calls to Get() and GetFromComposite() are retried through
+// buffer.ErrorHandler. This means that streams that fail after data
+// has been returned to the caller are resumed at the offset at which
+// they failed, and that streams that cannot be resumed (e.g.,
+// compressed streams) are not retried after returning data. Data is
+// thus never returned twice. Put() is never retried, as the provided
+// buffer is consumed by the first attempt.
+func NewRetryingBlobAccess(base BlobAccess, clock clock.Clock, maximumAttempts int, initialBackoff, maximumBackoff time.Duration, storageType string) BlobAccess {
+	retryingBlobAccessPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(retryingBlobAccessRetries)
+	})
+
+	return &retryingBlobAccess{
+		BlobAccess:      base,
+		clock:           clock,
+		maximumAttempts: maximumAttempts,
+		initialBackoff:  initialBackoff,
+		maximumBackoff:  maximumBackoff,
+
+		getRetries:              retryingBlobAccessRetries.WithLabelValues(storageType, "Get"),
+		getFromCompositeRetries: retryingBlobAccessRetries.WithLabelValues(storageType, "GetFromComposite"),
+		findMissingRetries:      retryingBlobAccessRetries.WithLabelValues(storageType, "FindMissing"),
+		getCapabilitiesRetries:  retryingBlobAccessRetries.WithLabelValues(storageType, "GetCapabilities"),
+	}
+}
+
+// shouldRetry returns whether a request should be retried, given the
+// error returned by the previous attempt. If so, it waits for the
+// backoff duration to pass.
+func (ba *retryingBlobAccess) shouldRetry(ctx context.Context, err error, attempt int, retries prometheus.Counter) bool {
+	if attempt >= ba.maximumAttempts || ctx.Err() != nil {
+		return false
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+	default:
+		return false
+	}
+
+	backoff := ba.initialBackoff
+	for i := 1; i < attempt && backoff < ba.maximumBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > ba.maximumBackoff {
+		backoff = ba.maximumBackoff
+	}
+	timer, t := ba.clock.NewTimer(backoff)
+	select {
+	case <-t:
+		retries.Inc()
+		return true
+	case <-ctx.Done():
+		timer.Stop()
+		return false
+	}
+}
+
+func (ba *retryingBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	return buffer.WithErrorHandler(
+		ba.BlobAccess.Get(ctx, blobDigest),
+		&retryingErrorHandler{
+			blobAccess: ba,
+			context:    ctx,
+			retries:    ba.getRetries,
+			get: func() buffer.Buffer {
+				return ba.BlobAccess.Get(ctx, blobDigest)
+			},
+			attempt: 1,
+		})
+}
+
+func (ba *retryingBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	return buffer.WithErrorHandler(
+		ba.BlobAccess.GetFromComposite(ctx, parentDigest, childDigest, slicer),
+		&retryingErrorHandler{
+			blobAccess: ba,
+			context:    ctx,
+			retries:    ba.getFromCompositeRetries,
+			get: func() buffer.Buffer {
+				return ba.BlobAccess.GetFromComposite(ctx, parentDigest, childDigest, slicer)
+			},
+			attempt: 1,
+		})
+}
+
+func (ba *retryingBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
+	for attempt := 1; ; attempt++ {
+		missing, err := ba.BlobAccess.FindMissing(ctx, digests)
+		if err == nil || !ba.shouldRetry(ctx, err, attempt, ba.findMissingRetries) {
+			return missing, err
+		}
+	}
+}
+
+func (ba *retryingBlobAccess) FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+	return FindMissingStreamInBatches(ctx, digests, reportMissing, ba.FindMissing)
+}
+
+func (ba *retryingBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
+	for attempt := 1; ; attempt++ {
+		capabilities, err := ba.BlobAccess.GetCapabilities(ctx, instanceName)
+		if err == nil || !ba.shouldRetry(ctx, err, attempt, ba.getCapabilitiesRetries) {
+			return capabilities, err
+		}
+	}
+}
+
+type retryingErrorHandler struct {
+	blobAccess *retryingBlobAccess
+	context    context.Context
+	retries    prometheus.Counter
+	get        func() buffer.Buffer
+	attempt    int
+}
+
+func (eh *retryingErrorHandler) OnError(err error) (buffer.Buffer, error) {
+	if !eh.blobAccess.shouldRetry(eh.context, err, eh.attempt, eh.retries) {
+		return nil, err
+	}
+	eh.attempt++
+	return eh.get(), nil
+}
+
+// OnNonResumableError is called for streams that have already returned
+// data, and cannot be resumed at the offset at which they failed.
+// Retrying is pointless in that case, so the original error is returned
+// without backing off.
+func (eh *retryingErrorHandler) OnNonResumableError(err error) error {
+	return err
+}
+
+func (eh *retryingErrorHandler) Done() {}
@@ -0,0 +1,161 @@
+package blobstore_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestRetryingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	blobAccess := blobstore.NewRetryingBlobAccess(
+		baseBlobAccess,
+		clock,
+		/* maximumAttempts = */ 3,
+		/* initialBackoff = */ 100*time.Millisecond,
+		/* maximumBackoff = */ 150*time.Millisecond,
+		"cas")
+
+	helloDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	expectBackoff := func(d time.Duration) {
+		timer := mock.NewMockTimer(ctrl)
+		timerChan := make(chan time.Time, 1)
+		timerChan <- time.Unix(1000, 0)
+		clock.EXPECT().NewTimer(d).Return(timer, timerChan)
+	}
+
+	t.Run("GetNonRetriableError", func(t *testing.T) {
+		// Errors like NOT_FOUND should be returned immediately.
+		baseBlobAccess.EXPECT().Get(ctx, helloDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+
+		_, err := blobAccess.Get(ctx, helloDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object not found"), err)
+	})
+
+	t.Run("GetRetrySuccess", func(t *testing.T) {
+		baseBlobAccess.EXPECT().Get(ctx, helloDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.Unavailable, "Server offline")))
+		expectBackoff(100 * time.Millisecond)
+		baseBlobAccess.EXPECT().Get(ctx, helloDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := blobAccess.Get(ctx, helloDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("GetResumeAfterData", func(t *testing.T) {
+		// If a stream fails after data has already been
+		// returned, the retry should continue at the offset
+		// at which the previous attempt failed. Data should
+		// never be returned twice.
+		baseBlobAccess.EXPECT().Get(ctx, helloDigest).
+			Return(buffer.NewCASBufferFromReader(
+				helloDigest,
+				&failingReader{data: []byte("He"), err: status.Error(codes.Unavailable, "Connection reset")},
+				buffer.BackendProvided(buffer.Irreparable(helloDigest))))
+		expectBackoff(100 * time.Millisecond)
+		baseBlobAccess.EXPECT().Get(ctx, helloDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		r := blobAccess.Get(ctx, helloDigest).ToChunkReader(0, 10)
+		chunk, err := r.Read()
+		require.NoError(t, err)
+		require.Equal(t, []byte("He"), chunk)
+		chunk, err = r.Read()
+		require.NoError(t, err)
+		require.Equal(t, []byte("llo"), chunk)
+		_, err = r.Read()
+		require.Equal(t, io.EOF, err)
+		r.Close()
+	})
+
+	t.Run("GetCompressedNoRetryAfterData", func(t *testing.T) {
+		// Compressed streams cannot be resumed at an arbitrary
+		// offset. If such a stream fails after data has already
+		// been returned, the original error should be returned
+		// immediately, without backing off or issuing another
+		// request.
+		compressedHello := []byte{0x28, 0xb5, 0x2f, 0xfd, 0x04, 0x00, 0x29, 0x00, 0x00, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x44, 0x7d, 0xb2, 0x75}
+		baseBlobAccess.EXPECT().Get(ctx, helloDigest).
+			Return(buffer.NewCASBufferFromCompressedReader(
+				helloDigest,
+				&failingReader{data: compressedHello, err: status.Error(codes.Unavailable, "Connection reset")},
+				remoteexecution.Compressor_ZSTD,
+				buffer.BackendProvided(buffer.Irreparable(helloDigest))))
+
+		r := blobAccess.Get(ctx, helloDigest).ToCompressedChunkReader(remoteexecution.Compressor_ZSTD, 1)
+		chunk, err := r.Read()
+		require.NoError(t, err)
+		require.Equal(t, compressedHello[:1], chunk)
+		for err == nil {
+			_, err = r.Read()
+		}
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Connection reset"), err)
+		r.Close()
+	})
+
+	t.Run("FindMissingExhausted", func(t *testing.T) {
+		// The backoff should be doubled, but not exceed the
+		// maximum. After three attempts, the error should be
+		// returned.
+		baseBlobAccess.EXPECT().FindMissing(ctx, helloDigest.ToSingletonSet()).
+			Return(digest.EmptySet, status.Error(codes.Unavailable, "Server offline")).
+			Times(3)
+		expectBackoff(100 * time.Millisecond)
+		expectBackoff(150 * time.Millisecond)
+
+		_, err := blobAccess.FindMissing(ctx, helloDigest.ToSingletonSet())
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server offline"), err)
+	})
+
+	t.Run("FindMissingRetrySuccess", func(t *testing.T) {
+		baseBlobAccess.EXPECT().FindMissing(ctx, helloDigest.ToSingletonSet()).
+			Return(digest.EmptySet, status.Error(codes.DeadlineExceeded, "Request timed out"))
+		expectBackoff(100 * time.Millisecond)
+		baseBlobAccess.EXPECT().FindMissing(ctx, helloDigest.ToSingletonSet()).
+			Return(helloDigest.ToSingletonSet(), nil)
+
+		missing, err := blobAccess.FindMissing(ctx, helloDigest.ToSingletonSet())
+		require.NoError(t, err)
+		require.Equal(t, helloDigest.ToSingletonSet(), missing)
+	})
+}
+
+// failingReader returns some data, followed by an error.
+type failingReader struct {
+	data []byte
+	err  error
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if len(r.data) > 0 {
+		n := copy(p, r.data)
+		r.data = r.data[n:]
+		return n, nil
+	}
+	return 0, r.err
+}
+
+func (r *failingReader) Close() error {
+	return nil
+}
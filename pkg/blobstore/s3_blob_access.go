@@ -0,0 +1,297 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"iter"
+	"log"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/capabilities"
+	cloud_aws "github.com/buildbarn/bb-storage/pkg/cloud/aws"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// S3MinimumMultipartUploadPartSizeBytes is the smallest part
+	// size that S3 permits for all but the last part of a multipart
+	// upload.
+	S3MinimumMultipartUploadPartSizeBytes = 5 * 1024 * 1024
+
+	// s3MaximumMultipartUploadParts is the maximum number of parts
+	// that a single multipart upload may consist of.
+	s3MaximumMultipartUploadParts = 10000
+)
+
+type s3BlobAccess struct {
+	capabilities.Provider
+	readBufferFactory            ReadBufferFactory
+	digestKeyFormat              digest.KeyFormat
+	s3Client                     cloud_aws.S3Client
+	bucket                       *string
+	keyPrefix                    string
+	multipartUploadPartSizeBytes int64
+	maximumConcurrentRequests    int
+}
+
+// NewS3BlobAccess creates a BlobAccess that stores objects in an S3
+// bucket, or a bucket in any other storage service that provides an
+// S3-compatible API. Objects are named after their key, as returned
+// by digest.Digest.GetKey(), optionally preceded by a prefix.
+//
+// Objects that are larger than the configured part size are uploaded
+// using S3's multipart upload API, so that the amount of memory needed
+// to upload an object remains bounded.
+func NewS3BlobAccess(capabilitiesProvider capabilities.Provider, readBufferFactory ReadBufferFactory, digestKeyFormat digest.KeyFormat, s3Client cloud_aws.S3Client, bucket, keyPrefix string, multipartUploadPartSizeBytes int64, maximumConcurrentRequests int) BlobAccess {
+	return &s3BlobAccess{
+		Provider:                     capabilitiesProvider,
+		readBufferFactory:            readBufferFactory,
+		digestKeyFormat:              digestKeyFormat,
+		s3Client:                     s3Client,
+		bucket:                       aws.String(bucket),
+		keyPrefix:                    keyPrefix,
+		multipartUploadPartSizeBytes: multipartUploadPartSizeBytes,
+		maximumConcurrentRequests:    maximumConcurrentRequests,
+	}
+}
+
+func (ba *s3BlobAccess) getObjectKey(blobDigest digest.Digest) string {
+	return ba.keyPrefix + blobDigest.GetKey(ba.digestKeyFormat)
+}
+
+// newDataIntegrityCallback returns a DataIntegrityCallback that
+// removes an object from the bucket in case its contents are
+// corrupted. This allows clients to upload the object once again.
+func (ba *s3BlobAccess) newDataIntegrityCallback(key string) buffer.DataIntegrityCallback {
+	return func(dataIsValid bool) {
+		if !dataIsValid {
+			if _, err := ba.s3Client.DeleteObject(context.Background(), &s3.DeleteObjectInput{
+				Bucket: ba.bucket,
+				Key:    aws.String(key),
+			}); err != nil {
+				log.Printf("Failed to delete corrupted object %#v: %s", key, err)
+			}
+		}
+	}
+}
+
+func (ba *s3BlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	key := ba.getObjectKey(blobDigest)
+	getObjectOutput, err := ba.s3Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: ba.bucket,
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return buffer.NewBufferFromError(util.StatusWrapf(s3ErrToStatus(err), "Failed to get object %#v", key))
+	}
+	return ba.readBufferFactory.NewBufferFromReader(
+		blobDigest,
+		statusReturningReadCloser{r: getObjectOutput.Body},
+		ba.newDataIntegrityCallback(key))
+}
+
+func (ba *s3BlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	return getFromCompositeUsingSliceObjects(ctx, ba, ba.readBufferFactory, ba.maximumConcurrentRequests, parentDigest, childDigest, slicer)
+}
+
+func (ba *s3BlobAccess) getSliceObjectOffset(ctx context.Context, parentDigest, childDigest digest.Digest) (int64, error) {
+	headObjectOutput, err := ba.s3Client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: ba.bucket,
+		Key:    aws.String(getSliceObjectKey(ba.keyPrefix, ba.digestKeyFormat, parentDigest, childDigest)),
+	})
+	if err != nil {
+		return 0, s3ErrToStatus(err)
+	}
+	return parseSliceObjectOffset(headObjectOutput.Metadata)
+}
+
+func (ba *s3BlobAccess) getSlice(ctx context.Context, parentDigest, childDigest digest.Digest, offsetBytes int64) buffer.Buffer {
+	parentKey := ba.getObjectKey(parentDigest)
+	getObjectOutput, err := ba.s3Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: ba.bucket,
+		Key:    aws.String(parentKey),
+		Range:  aws.String(getHTTPRangeHeaderForSlice(offsetBytes, childDigest.GetSizeBytes())),
+	})
+	if err != nil {
+		return buffer.NewBufferFromError(util.StatusWrapf(s3ErrToStatus(err), "Failed to get object %#v", parentKey))
+	}
+	return ba.readBufferFactory.NewBufferFromReader(
+		childDigest,
+		statusReturningReadCloser{r: getObjectOutput.Body},
+		ba.newDataIntegrityCallback(parentKey))
+}
+
+func (ba *s3BlobAccess) putSliceObject(ctx context.Context, parentDigest, childDigest digest.Digest, offsetBytes int64) error {
+	sliceKey := getSliceObjectKey(ba.keyPrefix, ba.digestKeyFormat, parentDigest, childDigest)
+	if _, err := ba.s3Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        ba.bucket,
+		Key:           aws.String(sliceKey),
+		Body:          bytes.NewReader(nil),
+		ContentLength: aws.Int64(0),
+		Metadata:      newSliceObjectMetadata(offsetBytes),
+	}); err != nil {
+		return util.StatusWrapf(s3ErrToStatus(err), "Failed to create slice object %#v", sliceKey)
+	}
+	return nil
+}
+
+func (ba *s3BlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	sizeBytes, err := b.GetSizeBytes()
+	if err != nil {
+		b.Discard()
+		return err
+	}
+	key := ba.getObjectKey(blobDigest)
+	if sizeBytes <= ba.multipartUploadPartSizeBytes {
+		// Small object. Upload it using a single request. The
+		// object is loaded into memory, so that the AWS SDK is
+		// capable of computing checksums and retrying the
+		// request if needed.
+		data, err := b.ToByteSlice(int(sizeBytes))
+		if err != nil {
+			return err
+		}
+		if _, err := ba.s3Client.PutObject(ctx, &s3.PutObjectInput{
+			Bucket:        ba.bucket,
+			Key:           aws.String(key),
+			Body:          bytes.NewReader(data),
+			ContentLength: aws.Int64(sizeBytes),
+		}); err != nil {
+			return util.StatusWrapf(s3ErrToStatus(err), "Failed to put object %#v", key)
+		}
+		return nil
+	}
+	return ba.putMultipart(ctx, key, sizeBytes, b)
+}
+
+// putMultipart uploads a large object using S3's multipart upload API.
+// Parts are uploaded sequentially, meaning that only a single part
+// needs to be held in memory.
+func (ba *s3BlobAccess) putMultipart(ctx context.Context, key string, sizeBytes int64, b buffer.Buffer) error {
+	// Increase the part size if needed, as S3 places a limit on
+	// the number of parts of an object.
+	partSizeBytes := ba.multipartUploadPartSizeBytes
+	if minimumPartSizeBytes := (sizeBytes + s3MaximumMultipartUploadParts - 1) / s3MaximumMultipartUploadParts; partSizeBytes < minimumPartSizeBytes {
+		partSizeBytes = minimumPartSizeBytes
+	}
+
+	r := b.ToReader()
+	defer r.Close()
+
+	createMultipartUploadOutput, err := ba.s3Client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket: ba.bucket,
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return util.StatusWrapf(s3ErrToStatus(err), "Failed to create multipart upload for object %#v", key)
+	}
+	uploadID := createMultipartUploadOutput.UploadId
+
+	if err := ba.uploadParts(ctx, key, uploadID, r, sizeBytes, partSizeBytes); err != nil {
+		// Release storage associated with the parts that
+		// have been uploaded. Use a separate context, as the
+		// error may have been caused by the caller's context
+		// being canceled.
+		if _, abortErr := ba.s3Client.AbortMultipartUpload(context.Background(), &s3.AbortMultipartUploadInput{
+			Bucket:   ba.bucket,
+			Key:      aws.String(key),
+			UploadId: uploadID,
+		}); abortErr != nil {
+			log.Printf("Failed to abort multipart upload for object %#v: %s", key, abortErr)
+		}
+		return err
+	}
+	return nil
+}
+
+func (ba *s3BlobAccess) uploadParts(ctx context.Context, key string, uploadID *string, r io.Reader, sizeBytes, partSizeBytes int64) error {
+	partCount := (sizeBytes + partSizeBytes - 1) / partSizeBytes
+	completedParts := make([]types.CompletedPart, 0, partCount)
+	partData := make([]byte, partSizeBytes)
+	for partNumber := int32(1); int64(partNumber) <= partCount; partNumber++ {
+		partSizeBytes := sizeBytes - int64(partNumber-1)*int64(len(partData))
+		if partSizeBytes > int64(len(partData)) {
+			partSizeBytes = int64(len(partData))
+		}
+		if _, err := io.ReadFull(r, partData[:partSizeBytes]); err != nil {
+			return err
+		}
+		uploadPartOutput, err := ba.s3Client.UploadPart(ctx, &s3.UploadPartInput{
+			Bucket:        ba.bucket,
+			Key:           aws.String(key),
+			UploadId:      uploadID,
+			PartNumber:    aws.Int32(partNumber),
+			Body:          bytes.NewReader(partData[:partSizeBytes]),
+			ContentLength: aws.Int64(partSizeBytes),
+		})
+		if err != nil {
+			return util.StatusWrapf(s3ErrToStatus(err), "Failed to upload part %d of object %#v", partNumber, key)
+		}
+		completedParts = append(completedParts, types.CompletedPart{
+			ETag:       uploadPartOutput.ETag,
+			PartNumber: aws.Int32(partNumber),
+		})
+	}
+
+	if _, err := ba.s3Client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:   ba.bucket,
+		Key:      aws.String(key),
+		UploadId: uploadID,
+		MultipartUpload: &types.CompletedMultipartUpload{
+			Parts: completedParts,
+		},
+	}); err != nil {
+		return util.StatusWrapf(s3ErrToStatus(err), "Failed to complete multipart upload for object %#v", key)
+	}
+	return nil
+}
+
+func (ba *s3BlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
+	// S3 does not provide an API for checking the existence of
+	// multiple objects at once. Call HeadObject() for every object.
+	return findMissingUsingExistenceChecks(ctx, digests, ba.maximumConcurrentRequests, func(ctx context.Context, blobDigest digest.Digest) error {
+		key := ba.getObjectKey(blobDigest)
+		if _, err := ba.s3Client.HeadObject(ctx, &s3.HeadObjectInput{
+			Bucket: ba.bucket,
+			Key:    aws.String(key),
+		}); err != nil {
+			return util.StatusWrapf(s3ErrToStatus(err), "Failed to get attributes of object %#v", key)
+		}
+		return nil
+	})
+}
+
+func (ba *s3BlobAccess) FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+	return FindMissingStreamInBatches(ctx, digests, reportMissing, ba.FindMissing)
+}
+
+// s3ErrToStatus converts an error returned by the AWS SDK to a gRPC
+// status. Errors indicating that an object does not exist are
+// converted to NOT_FOUND.
+func s3ErrToStatus(err error) error {
+	var noSuchKey *types.NoSuchKey
+	var notFound *types.NotFound
+	var responseError *awshttp.ResponseError
+	if errors.As(err, &noSuchKey) || errors.As(err, &notFound) || (errors.As(err, &responseError) && responseError.HTTPStatusCode() == 404) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return errToStatus(err)
+}
+
+// getHTTPRangeHeaderForSlice creates a HTTP Range header for reading
+// a child object from its parent object.
+func getHTTPRangeHeaderForSlice(offsetBytes, sizeBytes int64) string {
+	return "bytes=" + strconv.FormatInt(offsetBytes, 10) + "-" + strconv.FormatInt(offsetBytes+sizeBytes-1, 10)
+}
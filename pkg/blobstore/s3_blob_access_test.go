@@ -0,0 +1,278 @@
+package blobstore_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestS3BlobAccessGet(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	s3Client := mock.NewMockS3Client(ctrl)
+	blobAccess := blobstore.NewS3BlobAccess(
+		nil,
+		blobstore.CASReadBufferFactory,
+		digest.KeyWithoutInstance,
+		s3Client,
+		"mybucket",
+		"cas/",
+		1024,
+		10)
+	helloDigest := digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	t.Run("NotFound", func(t *testing.T) {
+		s3Client.EXPECT().GetObject(ctx, &s3.GetObjectInput{
+			Bucket: aws.String("mybucket"),
+			Key:    aws.String("cas/3-8b1a9953c4611296a827abf8c47804d7-5"),
+		}).Return(nil, &types.NoSuchKey{Message: aws.String("The specified key does not exist.")})
+
+		_, err := blobAccess.Get(ctx, helloDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Failed to get object \"cas/3-8b1a9953c4611296a827abf8c47804d7-5\": NoSuchKey: The specified key does not exist."), err)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		s3Client.EXPECT().GetObject(ctx, &s3.GetObjectInput{
+			Bucket: aws.String("mybucket"),
+			Key:    aws.String("cas/3-8b1a9953c4611296a827abf8c47804d7-5"),
+		}).Return(&s3.GetObjectOutput{
+			Body: io.NopCloser(strings.NewReader("Hello")),
+		}, nil)
+
+		data, err := blobAccess.Get(ctx, helloDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("DataCorruption", func(t *testing.T) {
+		// Objects whose contents don't match the digest should
+		// be removed from the bucket.
+		s3Client.EXPECT().GetObject(ctx, &s3.GetObjectInput{
+			Bucket: aws.String("mybucket"),
+			Key:    aws.String("cas/3-8b1a9953c4611296a827abf8c47804d7-5"),
+		}).Return(&s3.GetObjectOutput{
+			Body: io.NopCloser(strings.NewReader("Hallo")),
+		}, nil)
+		s3Client.EXPECT().DeleteObject(gomock.Any(), &s3.DeleteObjectInput{
+			Bucket: aws.String("mybucket"),
+			Key:    aws.String("cas/3-8b1a9953c4611296a827abf8c47804d7-5"),
+		})
+
+		_, err := blobAccess.Get(ctx, helloDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Buffer has checksum d1bf93299de1b68e6d382c893bf1215f, while 8b1a9953c4611296a827abf8c47804d7 was expected"), err)
+	})
+}
+
+func TestS3BlobAccessGetFromComposite(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	s3Client := mock.NewMockS3Client(ctrl)
+	blobAccess := blobstore.NewS3BlobAccess(
+		nil,
+		blobstore.CASReadBufferFactory,
+		digest.KeyWithoutInstance,
+		s3Client,
+		"mybucket",
+		"",
+		1024,
+		10)
+	parentDigest := digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "f32a26e2a3a8aa338cd77b6e1263c535", 6)
+	childDigest := digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "ddc35f88fa71b6ef142ae61f35364653", 3)
+
+	t.Run("Sliced", func(t *testing.T) {
+		// If the parent object has been sliced before, the child
+		// object should be loaded using a range request.
+		s3Client.EXPECT().HeadObject(ctx, &s3.HeadObjectInput{
+			Bucket: aws.String("mybucket"),
+			Key:    aws.String("slices/3-f32a26e2a3a8aa338cd77b6e1263c535-6/3-ddc35f88fa71b6ef142ae61f35364653-3"),
+		}).Return(&s3.HeadObjectOutput{
+			Metadata: map[string]string{"offset-bytes": "3"},
+		}, nil)
+		s3Client.EXPECT().GetObject(ctx, &s3.GetObjectInput{
+			Bucket: aws.String("mybucket"),
+			Key:    aws.String("3-f32a26e2a3a8aa338cd77b6e1263c535-6"),
+			Range:  aws.String("bytes=3-5"),
+		}).Return(&s3.GetObjectOutput{
+			Body: io.NopCloser(strings.NewReader("Bar")),
+		}, nil)
+
+		data, err := blobAccess.GetFromComposite(ctx, parentDigest, childDigest, nil).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Bar"), data)
+	})
+
+	t.Run("SliceLookupFailure", func(t *testing.T) {
+		s3Client.EXPECT().HeadObject(ctx, gomock.Any()).
+			Return(nil, errors.New("dial tcp 1.2.3.4:443: connect: connection refused"))
+
+		_, err := blobAccess.GetFromComposite(ctx, parentDigest, childDigest, nil).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Failed to get slice object: dial tcp 1.2.3.4:443: connect: connection refused"), err)
+	})
+}
+
+func TestS3BlobAccessPut(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	s3Client := mock.NewMockS3Client(ctrl)
+	blobAccess := blobstore.NewS3BlobAccess(
+		nil,
+		blobstore.CASReadBufferFactory,
+		digest.KeyWithoutInstance,
+		s3Client,
+		"mybucket",
+		"",
+		3,
+		10)
+	helloDigest := digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	smallDigest := digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "acbd18db4cc2f85cedef654fccc4a4d8", 3)
+
+	t.Run("SinglePartSuccess", func(t *testing.T) {
+		s3Client.EXPECT().PutObject(ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+				require.Equal(t, "mybucket", *params.Bucket)
+				require.Equal(t, "3-acbd18db4cc2f85cedef654fccc4a4d8-3", *params.Key)
+				require.Equal(t, int64(3), *params.ContentLength)
+				data, err := io.ReadAll(params.Body)
+				require.NoError(t, err)
+				require.Equal(t, []byte("foo"), data)
+				return &s3.PutObjectOutput{}, nil
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, smallDigest, buffer.NewValidatedBufferFromByteSlice([]byte("foo"))))
+	})
+
+	t.Run("SinglePartFailure", func(t *testing.T) {
+		s3Client.EXPECT().PutObject(ctx, gomock.Any()).
+			Return(nil, errors.New("Server on fire"))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Internal, "Failed to put object \"3-acbd18db4cc2f85cedef654fccc4a4d8-3\": Server on fire"),
+			blobAccess.Put(ctx, smallDigest, buffer.NewValidatedBufferFromByteSlice([]byte("foo"))))
+	})
+
+	t.Run("MultipartSuccess", func(t *testing.T) {
+		// Objects exceeding the part size should be uploaded
+		// using a multipart upload.
+		s3Client.EXPECT().CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+			Bucket: aws.String("mybucket"),
+			Key:    aws.String("3-8b1a9953c4611296a827abf8c47804d7-5"),
+		}).Return(&s3.CreateMultipartUploadOutput{
+			UploadId: aws.String("upload1"),
+		}, nil)
+		for i, part := range []string{"Hel", "lo"} {
+			partNumber := int32(i + 1)
+			s3Client.EXPECT().UploadPart(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, params *s3.UploadPartInput, optFns ...func(*s3.Options)) (*s3.UploadPartOutput, error) {
+					require.Equal(t, "upload1", *params.UploadId)
+					require.Equal(t, partNumber, *params.PartNumber)
+					data, err := io.ReadAll(params.Body)
+					require.NoError(t, err)
+					require.Equal(t, []byte(part), data)
+					return &s3.UploadPartOutput{
+						ETag: aws.String(part),
+					}, nil
+				})
+		}
+		s3Client.EXPECT().CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+			Bucket:   aws.String("mybucket"),
+			Key:      aws.String("3-8b1a9953c4611296a827abf8c47804d7-5"),
+			UploadId: aws.String("upload1"),
+			MultipartUpload: &types.CompletedMultipartUpload{
+				Parts: []types.CompletedPart{
+					{ETag: aws.String("Hel"), PartNumber: aws.Int32(1)},
+					{ETag: aws.String("lo"), PartNumber: aws.Int32(2)},
+				},
+			},
+		}).Return(&s3.CompleteMultipartUploadOutput{}, nil)
+
+		require.NoError(t, blobAccess.Put(ctx, helloDigest, buffer.NewCASBufferFromReader(helloDigest, io.NopCloser(strings.NewReader("Hello")), buffer.UserProvided)))
+	})
+
+	t.Run("MultipartDataCorruption", func(t *testing.T) {
+		// If the data to be uploaded turns out to be corrupted,
+		// the multipart upload should be aborted.
+		s3Client.EXPECT().CreateMultipartUpload(ctx, gomock.Any()).Return(&s3.CreateMultipartUploadOutput{
+			UploadId: aws.String("upload2"),
+		}, nil)
+		s3Client.EXPECT().UploadPart(ctx, gomock.Any()).Return(&s3.UploadPartOutput{
+			ETag: aws.String("Hal"),
+		}, nil)
+		s3Client.EXPECT().AbortMultipartUpload(gomock.Any(), &s3.AbortMultipartUploadInput{
+			Bucket:   aws.String("mybucket"),
+			Key:      aws.String("3-8b1a9953c4611296a827abf8c47804d7-5"),
+			UploadId: aws.String("upload2"),
+		}).Return(&s3.AbortMultipartUploadOutput{}, nil)
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.InvalidArgument, "Buffer has checksum d1bf93299de1b68e6d382c893bf1215f, while 8b1a9953c4611296a827abf8c47804d7 was expected"),
+			blobAccess.Put(ctx, helloDigest, buffer.NewCASBufferFromReader(helloDigest, io.NopCloser(strings.NewReader("Hallo")), buffer.UserProvided)))
+	})
+}
+
+func TestS3BlobAccessFindMissing(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	s3Client := mock.NewMockS3Client(ctrl)
+	blobAccess := blobstore.NewS3BlobAccess(
+		nil,
+		blobstore.CASReadBufferFactory,
+		digest.KeyWithoutInstance,
+		s3Client,
+		"mybucket",
+		"",
+		1024,
+		2)
+	digestA := digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	digestB := digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "acbd18db4cc2f85cedef654fccc4a4d8", 3)
+	digestC := digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "37b51d194a7513e45b56f6524f2d51f2", 3)
+	allDigests := digest.NewSetBuilder().Add(digestA).Add(digestB).Add(digestC).Build()
+
+	t.Run("Success", func(t *testing.T) {
+		s3Client.EXPECT().HeadObject(gomock.Any(), &s3.HeadObjectInput{
+			Bucket: aws.String("mybucket"),
+			Key:    aws.String("3-8b1a9953c4611296a827abf8c47804d7-5"),
+		}).Return(&s3.HeadObjectOutput{}, nil)
+		s3Client.EXPECT().HeadObject(gomock.Any(), &s3.HeadObjectInput{
+			Bucket: aws.String("mybucket"),
+			Key:    aws.String("3-acbd18db4cc2f85cedef654fccc4a4d8-3"),
+		}).Return(nil, &types.NotFound{})
+		s3Client.EXPECT().HeadObject(gomock.Any(), &s3.HeadObjectInput{
+			Bucket: aws.String("mybucket"),
+			Key:    aws.String("3-37b51d194a7513e45b56f6524f2d51f2-3"),
+		}).Return(&s3.HeadObjectOutput{}, nil)
+
+		missing, err := blobAccess.FindMissing(ctx, allDigests)
+		require.NoError(t, err)
+		require.Equal(t, digestB.ToSingletonSet(), missing)
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		s3Client.EXPECT().HeadObject(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("Server on fire")).
+			MinTimes(1).
+			MaxTimes(3)
+
+		_, err := blobAccess.FindMissing(ctx, allDigests)
+		require.Equal(t, codes.Internal, status.Code(err))
+	})
+}
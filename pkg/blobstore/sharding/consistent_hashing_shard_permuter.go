@@ -0,0 +1,82 @@
+package sharding
+
+import (
+	"sort"
+)
+
+type consistentHashingVirtualNode struct {
+	hash  uint64
+	index int
+}
+
+type consistentHashingShardPermuter struct {
+	virtualNodes []consistentHashingVirtualNode
+	shardsCount  int
+}
+
+// NewConsistentHashingShardPermuter is a shard selection algorithm that
+// uses consistent hashing. Every shard places a number of virtual nodes
+// onto a hash ring that is proportional to its weight. The positions of
+// the virtual nodes are derived from the stable identifier of the
+// shard. For every hash, shards are returned in the order in which
+// their virtual nodes follow the hash on the ring.
+//
+// Unlike NewWeightedShardPermuter(), shards are identified by key as
+// opposed to their index. When shards are added or removed, keys only
+// move from or to the shards that are affected.
+func NewConsistentHashingShardPermuter(keys []string, weights []uint32, virtualNodesPerWeight uint32) ShardPermuter {
+	var virtualNodes []consistentHashingVirtualNode
+	for i, key := range keys {
+		// Place virtual nodes at positions obtained by running
+		// SplitMix64, seeded with the hash of the key.
+		state := hashShardKey(key)
+		count := uint64(weights[i]) * uint64(virtualNodesPerWeight)
+		for j := uint64(0); j < count; j++ {
+			state += 0x9e3779b97f4a7c15
+			virtualNodes = append(virtualNodes, consistentHashingVirtualNode{
+				hash:  mix64(state),
+				index: i,
+			})
+		}
+	}
+	sort.Slice(virtualNodes, func(i, j int) bool {
+		a, b := virtualNodes[i], virtualNodes[j]
+		return a.hash < b.hash || (a.hash == b.hash && a.index < b.index)
+	})
+	return &consistentHashingShardPermuter{
+		virtualNodes: virtualNodes,
+		shardsCount:  len(keys),
+	}
+}
+
+func (s *consistentHashingShardPermuter) GetShard(hash uint64, selector ShardSelector) {
+	// The hash is mixed once more, as the hashes provided by
+	// ShardingBlobAccess are not guaranteed to be uniformly
+	// distributed across the entire 64-bit space.
+	h := mix64(hash)
+	start := sort.Search(len(s.virtualNodes), func(i int) bool {
+		return s.virtualNodes[i].hash >= h
+	})
+
+	// Walk along the ring, returning every shard only once.
+	var seen []bool
+	remaining := s.shardsCount
+	for i := range s.virtualNodes {
+		index := s.virtualNodes[(start+i)%len(s.virtualNodes)].index
+		if seen == nil {
+			if !selector(index) {
+				return
+			}
+			seen = make([]bool, s.shardsCount)
+		} else if seen[index] {
+			continue
+		} else if !selector(index) {
+			return
+		}
+		seen[index] = true
+		remaining--
+		if remaining == 0 {
+			return
+		}
+	}
+}
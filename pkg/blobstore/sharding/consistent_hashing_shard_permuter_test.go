@@ -0,0 +1,65 @@
+package sharding_test
+
+import (
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/sharding"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsistentHashingShardPermuterDistribution(t *testing.T) {
+	// Distribution across five backends with a total weight of 15.
+	// Because the positions of virtual nodes are random, a larger
+	// error margin needs to be tolerated than for the other
+	// algorithms.
+	keys := []string{"a", "b", "c", "d", "e"}
+	weights := []uint32{1, 4, 2, 5, 3}
+	s := sharding.NewConsistentHashingShardPermuter(keys, weights, 1000)
+
+	occurrences := map[int]uint32{}
+	for hash := uint64(0); hash < 1000000; hash++ {
+		s.GetShard(hash, func(i int) bool {
+			occurrences[i]++
+			return false
+		})
+	}
+	for shard, weight := range weights {
+		require.InEpsilon(t, weight*1000000/15, occurrences[shard], 0.1)
+	}
+}
+
+func TestConsistentHashingShardPermuterPermutation(t *testing.T) {
+	// Every shard should be returned exactly once, even though
+	// each of them has many virtual nodes.
+	s := sharding.NewConsistentHashingShardPermuter([]string{"a", "b", "c", "d"}, []uint32{1, 2, 3, 4}, 10)
+	var indices []int
+	s.GetShard(12345, func(i int) bool {
+		indices = append(indices, i)
+		return true
+	})
+	require.ElementsMatch(t, []int{0, 1, 2, 3}, indices)
+}
+
+func TestConsistentHashingShardPermuterStability(t *testing.T) {
+	// Removing a shard should only cause keys stored on that shard
+	// to be moved.
+	keys1 := []string{"a", "b", "c", "d"}
+	keys2 := []string{"a", "c", "d"}
+	s1 := sharding.NewConsistentHashingShardPermuter(keys1, []uint32{1, 1, 1, 1}, 100)
+	s2 := sharding.NewConsistentHashingShardPermuter(keys2, []uint32{1, 1, 1}, 100)
+
+	for hash := uint64(0); hash < 100000; hash++ {
+		var key1, key2 string
+		s1.GetShard(hash, func(i int) bool {
+			key1 = keys1[i]
+			return false
+		})
+		s2.GetShard(hash, func(i int) bool {
+			key2 = keys2[i]
+			return false
+		})
+		if key1 != key2 {
+			require.Equal(t, "b", key1)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package sharding
+
+import (
+	"math"
+)
+
+type rendezvousShardPermuter struct {
+	keyHashes []uint64
+	weights   []float64
+}
+
+// NewRendezvousShardPermuter is a shard selection algorithm that uses
+// weighted rendezvous hashing (also known as highest random weight
+// hashing). For every hash, a score is computed for each shard based on
+// its stable identifier and weight. Shards are returned in order of
+// decreasing score.
+//
+// Unlike NewWeightedShardPermuter(), shards are identified by key as
+// opposed to their index. When shards are added or removed, keys only
+// move from or to the shards that are affected.
+func NewRendezvousShardPermuter(keys []string, weights []uint32) ShardPermuter {
+	s := &rendezvousShardPermuter{
+		keyHashes: make([]uint64, 0, len(keys)),
+		weights:   make([]float64, 0, len(weights)),
+	}
+	for i, key := range keys {
+		s.keyHashes = append(s.keyHashes, hashShardKey(key))
+		s.weights = append(s.weights, float64(weights[i]))
+	}
+	return s
+}
+
+func (s *rendezvousShardPermuter) GetShard(hash uint64, selector ShardSelector) {
+	// Compute the score of every shard. The combined hash is
+	// converted to a floating point number in range (0, 1), which
+	// is then transformed so that the probability of a shard
+	// having the highest score is proportional to its weight.
+	scores := make([]float64, 0, len(s.keyHashes))
+	for i, keyHash := range s.keyHashes {
+		u := (float64(mix64(hash^keyHash)>>11) + 0.5) / (1 << 53)
+		scores = append(scores, -s.weights[i]/math.Log(u))
+	}
+
+	// Return shards in order of decreasing score. In the common
+	// case only the first shard is needed, meaning that there is no
+	// need to sort all scores.
+	for {
+		bestIndex := -1
+		for i, score := range scores {
+			if score >= 0 && (bestIndex < 0 || score > scores[bestIndex]) {
+				bestIndex = i
+			}
+		}
+		if bestIndex < 0 || !selector(bestIndex) {
+			return
+		}
+		scores[bestIndex] = -1
+	}
+}
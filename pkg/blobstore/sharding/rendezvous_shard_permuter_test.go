@@ -0,0 +1,66 @@
+package sharding_test
+
+import (
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/sharding"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRendezvousShardPermuterDistribution(t *testing.T) {
+	// Distribution across five backends with a total weight of 15.
+	keys := []string{"a", "b", "c", "d", "e"}
+	weights := []uint32{1, 4, 2, 5, 3}
+	s := sharding.NewRendezvousShardPermuter(keys, weights)
+
+	// The first shard that is returned should be picked with a
+	// probability proportional to its weight.
+	occurrences := map[int]uint32{}
+	for hash := uint64(0); hash < 1000000; hash++ {
+		s.GetShard(hash*0x9e3779b97f4a7c15, func(i int) bool {
+			occurrences[i]++
+			return false
+		})
+	}
+	for shard, weight := range weights {
+		require.InEpsilon(t, weight*1000000/15, occurrences[shard], 0.01)
+	}
+}
+
+func TestRendezvousShardPermuterPermutation(t *testing.T) {
+	// Every shard should be returned exactly once.
+	s := sharding.NewRendezvousShardPermuter([]string{"a", "b", "c", "d"}, []uint32{1, 1, 1, 1})
+	var indices []int
+	s.GetShard(12345, func(i int) bool {
+		indices = append(indices, i)
+		return true
+	})
+	require.ElementsMatch(t, []int{0, 1, 2, 3}, indices)
+}
+
+func TestRendezvousShardPermuterStability(t *testing.T) {
+	// Adding a shard should only cause keys to be moved to the new
+	// shard. Reordering shards should have no effect.
+	s1 := sharding.NewRendezvousShardPermuter([]string{"a", "b", "c"}, []uint32{1, 1, 1})
+	s2 := sharding.NewRendezvousShardPermuter([]string{"d", "c", "b", "a"}, []uint32{1, 1, 1, 1})
+	keys1 := []string{"a", "b", "c"}
+	keys2 := []string{"d", "c", "b", "a"}
+
+	moved := 0
+	for hash := uint64(0); hash < 100000; hash++ {
+		var key1, key2 string
+		s1.GetShard(hash*0x9e3779b97f4a7c15, func(i int) bool {
+			key1 = keys1[i]
+			return false
+		})
+		s2.GetShard(hash*0x9e3779b97f4a7c15, func(i int) bool {
+			key2 = keys2[i]
+			return false
+		})
+		if key1 != key2 {
+			require.Equal(t, "d", key2)
+			moved++
+		}
+	}
+	require.InEpsilon(t, 25000, moved, 0.02)
+}
@@ -0,0 +1,225 @@
+package sharding
+
+import (
+	"context"
+	"iter"
+	"sync"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/replication"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	reshardingBlobAccessPrometheusMetrics sync.Once
+
+	reshardingBlobAccessBlobs = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "resharding_blob_access_blobs_total",
+			Help:      "Number of blobs accessed through ReshardingBlobAccess, partitioned by whether the shard of the blob changed.",
+		},
+		[]string{"storage", "operation", "shard"})
+	reshardingBlobAccessFallbacks = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "resharding_blob_access_fallbacks_total",
+			Help:      "Number of blobs whose shard changed that were absent in the current sharding configuration, causing them to be looked up in the previous sharding configuration.",
+		},
+		[]string{"storage", "operation"})
+	reshardingBlobAccessKeysMovedRatio = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "resharding_blob_access_keys_moved_ratio",
+			Help:      "Estimated fraction of keys whose shard changed between the previous and current sharding configuration.",
+		},
+		[]string{"storage"})
+)
+
+// keysMovedSamplesCount is the number of samples that is used to
+// estimate the fraction of keys whose shard changed.
+const keysMovedSamplesCount = 100000
+
+type reshardingOperationMetrics struct {
+	unchangedBlobs prometheus.Counter
+	changedBlobs   prometheus.Counter
+	fallbacks      prometheus.Counter
+}
+
+func newReshardingOperationMetrics(storageTypeName, operation string) reshardingOperationMetrics {
+	return reshardingOperationMetrics{
+		unchangedBlobs: reshardingBlobAccessBlobs.WithLabelValues(storageTypeName, operation, "Unchanged"),
+		changedBlobs:   reshardingBlobAccessBlobs.WithLabelValues(storageTypeName, operation, "Changed"),
+		fallbacks:      reshardingBlobAccessFallbacks.WithLabelValues(storageTypeName, operation),
+	}
+}
+
+type reshardingBlobAccess struct {
+	previous         blobstore.BlobAccess
+	current          blobstore.BlobAccess
+	previousTopology *Topology
+	currentTopology  *Topology
+	replicator       replication.BlobReplicator
+
+	getMetrics              reshardingOperationMetrics
+	getFromCompositeMetrics reshardingOperationMetrics
+	findMissingMetrics      reshardingOperationMetrics
+}
+
+// NewReshardingBlobAccess creates a BlobAccess that can be used to
+// migrate data between two sharding configurations, without causing
+// blobs to become unavailable.
+//
+// All writes are performed against the current sharding configuration.
+// Blobs that are stored on another shard in the current configuration
+// than in the previous configuration are looked up in the previous
+// configuration if they cannot be found, and are replicated to the
+// current configuration. Shards that are part of both configurations
+// are identified by key, and are assumed to refer to the same storage
+// backend.
+func NewReshardingBlobAccess(previous, current blobstore.BlobAccess, previousTopology, currentTopology *Topology, replicator replication.BlobReplicator, storageTypeName string) blobstore.BlobAccess {
+	reshardingBlobAccessPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(reshardingBlobAccessBlobs)
+		prometheus.MustRegister(reshardingBlobAccessFallbacks)
+		prometheus.MustRegister(reshardingBlobAccessKeysMovedRatio)
+	})
+	reshardingBlobAccessKeysMovedRatio.WithLabelValues(storageTypeName).Set(EstimateFractionOfKeysMoved(previousTopology, currentTopology, keysMovedSamplesCount))
+
+	return &reshardingBlobAccess{
+		previous:         previous,
+		current:          current,
+		previousTopology: previousTopology,
+		currentTopology:  currentTopology,
+		replicator:       replicator,
+
+		getMetrics:              newReshardingOperationMetrics(storageTypeName, "Get"),
+		getFromCompositeMetrics: newReshardingOperationMetrics(storageTypeName, "GetFromComposite"),
+		findMissingMetrics:      newReshardingOperationMetrics(storageTypeName, "FindMissing"),
+	}
+}
+
+// shardChanged returns whether a blob is stored on another shard in
+// the current sharding configuration than in the previous one.
+func (ba *reshardingBlobAccess) shardChanged(blobDigest digest.Digest, metrics *reshardingOperationMetrics) bool {
+	if ba.previousTopology.getShardKeyByDigest(blobDigest) == ba.currentTopology.getShardKeyByDigest(blobDigest) {
+		metrics.unchangedBlobs.Inc()
+		return false
+	}
+	metrics.changedBlobs.Inc()
+	return true
+}
+
+func (ba *reshardingBlobAccess) getBlobReplicatorSelector(metrics *reshardingOperationMetrics) replication.BlobReplicatorSelector {
+	replicator := ba.replicator
+	return func(observedErr error) (replication.BlobReplicator, error) {
+		if status.Code(observedErr) != codes.NotFound {
+			if replicator != nil {
+				return nil, util.StatusWrap(observedErr, "Current sharding configuration")
+			}
+			return nil, util.StatusWrap(observedErr, "Previous sharding configuration")
+		}
+		if replicator == nil {
+			// The blob is also absent in the previous
+			// sharding configuration.
+			return nil, observedErr
+		}
+
+		metrics.fallbacks.Inc()
+		replicatorToReturn := replicator
+		replicator = nil
+		return replicatorToReturn, nil
+	}
+}
+
+func (ba *reshardingBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	if !ba.shardChanged(blobDigest, &ba.getMetrics) {
+		return ba.current.Get(ctx, blobDigest)
+	}
+	return replication.GetWithBlobReplicator(
+		ctx,
+		blobDigest,
+		ba.current,
+		ba.getBlobReplicatorSelector(&ba.getMetrics))
+}
+
+func (ba *reshardingBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	if !ba.shardChanged(parentDigest, &ba.getFromCompositeMetrics) {
+		return ba.current.GetFromComposite(ctx, parentDigest, childDigest, slicer)
+	}
+	return replication.GetFromCompositeWithBlobReplicator(
+		ctx,
+		parentDigest,
+		childDigest,
+		slicer,
+		ba.current,
+		ba.getBlobReplicatorSelector(&ba.getFromCompositeMetrics))
+}
+
+func (ba *reshardingBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	return ba.current.Put(ctx, blobDigest, b)
+}
+
+func (ba *reshardingBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
+	missingInCurrent, err := ba.current.FindMissing(ctx, digests)
+	if err != nil {
+		return digest.EmptySet, util.StatusWrap(err, "Current sharding configuration")
+	}
+
+	// Blobs whose shard did not change don't need to be looked up
+	// in the previous sharding configuration.
+	changed := map[digest.Digest]struct{}{}
+	for _, blobDigest := range digests.Items() {
+		if ba.shardChanged(blobDigest, &ba.findMissingMetrics) {
+			changed[blobDigest] = struct{}{}
+		}
+	}
+	missingUnchanged := digest.NewSetBuilder()
+	missingChanged := digest.NewSetBuilder()
+	for _, blobDigest := range missingInCurrent.Items() {
+		if _, ok := changed[blobDigest]; ok {
+			missingChanged.Add(blobDigest)
+		} else {
+			missingUnchanged.Add(blobDigest)
+		}
+	}
+	if missingChanged.Length() == 0 {
+		return missingInCurrent, nil
+	}
+	ba.findMissingMetrics.fallbacks.Add(float64(missingChanged.Length()))
+
+	// Replicate blobs that are only present in the previous sharding
+	// configuration, so that they remain available once the
+	// previous sharding configuration is removed.
+	lookups := missingChanged.Build()
+	missingInPrevious, err := ba.previous.FindMissing(ctx, lookups)
+	if err != nil {
+		return digest.EmptySet, util.StatusWrap(err, "Previous sharding configuration")
+	}
+	presentInPrevious, _, _ := digest.GetDifferenceAndIntersection(lookups, missingInPrevious)
+	if err := ba.replicator.ReplicateMultiple(ctx, presentInPrevious); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return digest.EmptySet, util.StatusWrapWithCode(err, codes.Internal, "Previous sharding configuration returned inconsistent results while replicating")
+		}
+		return digest.EmptySet, util.StatusWrap(err, "Failed to replicate from previous sharding configuration")
+	}
+	return digest.GetUnion([]digest.Set{missingUnchanged.Build(), missingInPrevious}), nil
+}
+
+func (ba *reshardingBlobAccess) FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+	return blobstore.FindMissingStreamInBatches(ctx, digests, reportMissing, ba.FindMissing)
+}
+
+func (ba *reshardingBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
+	return ba.current.GetCapabilities(ctx, instanceName)
+}
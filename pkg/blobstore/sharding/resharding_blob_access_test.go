@@ -0,0 +1,127 @@
+package sharding_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/sharding"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestReshardingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	// A third shard is added to the sharding configuration.
+	previous := mock.NewMockBlobAccess(ctrl)
+	current := mock.NewMockBlobAccess(ctrl)
+	replicator := mock.NewMockBlobReplicator(ctrl)
+	blobAccess := sharding.NewReshardingBlobAccess(
+		previous,
+		current,
+		&sharding.Topology{
+			HashInitialization: 0x62994904405896a1,
+			ShardPermuter:      sharding.NewRendezvousShardPermuter([]string{"a", "b"}, []uint32{1, 1}),
+			ShardKeys:          []string{"a", "b"},
+		},
+		&sharding.Topology{
+			HashInitialization: 0x62994904405896a1,
+			ShardPermuter:      sharding.NewRendezvousShardPermuter([]string{"a", "b", "c"}, []uint32{1, 1, 1}),
+			ShardKeys:          []string{"a", "b", "c"},
+		},
+		replicator,
+		"cas")
+
+	// The first digest is moved from shard "b" to shard "c", while
+	// the second digest remains stored on shard "b".
+	movedDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	unmovedDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "942a5b4164c26ae5d57a4f9526dcfca4", 5)
+
+	t.Run("GetUnmoved", func(t *testing.T) {
+		// Blobs whose shard didn't change should not be looked
+		// up in the previous sharding configuration.
+		current.EXPECT().Get(ctx, unmovedDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+
+		_, err := blobAccess.Get(ctx, unmovedDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object not found"), err)
+	})
+
+	t.Run("GetMovedPresentInCurrent", func(t *testing.T) {
+		current.EXPECT().Get(ctx, movedDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := blobAccess.Get(ctx, movedDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("GetMovedPresentInPrevious", func(t *testing.T) {
+		// Blobs that haven't been migrated yet should be
+		// replicated from the previous sharding configuration.
+		current.EXPECT().Get(ctx, movedDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+		replicator.EXPECT().ReplicateSingle(ctx, movedDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := blobAccess.Get(ctx, movedDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("GetMovedCurrentFailure", func(t *testing.T) {
+		current.EXPECT().Get(ctx, movedDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.Unavailable, "Server offline")))
+
+		_, err := blobAccess.Get(ctx, movedDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Current sharding configuration: Server offline"), err)
+	})
+
+	t.Run("Put", func(t *testing.T) {
+		// Writes should only go to the current sharding
+		// configuration.
+		current.EXPECT().Put(ctx, movedDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(100)
+				require.NoError(t, err)
+				require.Equal(t, []byte("Hello"), data)
+				return nil
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, movedDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("FindMissingPreviousFailure", func(t *testing.T) {
+		current.EXPECT().FindMissing(ctx, movedDigest.ToSingletonSet()).
+			Return(movedDigest.ToSingletonSet(), nil)
+		previous.EXPECT().FindMissing(ctx, movedDigest.ToSingletonSet()).
+			Return(digest.EmptySet, status.Error(codes.Unavailable, "Server offline"))
+
+		_, err := blobAccess.FindMissing(ctx, movedDigest.ToSingletonSet())
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Previous sharding configuration: Server offline"), err)
+	})
+
+	t.Run("FindMissingSuccess", func(t *testing.T) {
+		// Both blobs are absent in the current sharding
+		// configuration. Only the blob that was moved should be
+		// looked up in the previous sharding configuration. As
+		// it is present, it should be replicated.
+		bothDigests := digest.NewSetBuilder().Add(movedDigest).Add(unmovedDigest).Build()
+		current.EXPECT().FindMissing(ctx, bothDigests).Return(bothDigests, nil)
+		previous.EXPECT().FindMissing(ctx, movedDigest.ToSingletonSet()).Return(digest.EmptySet, nil)
+		replicator.EXPECT().ReplicateMultiple(ctx, movedDigest.ToSingletonSet())
+
+		missing, err := blobAccess.FindMissing(ctx, bothDigests)
+		require.NoError(t, err)
+		require.Equal(t, unmovedDigest.ToSingletonSet(), missing)
+	})
+}
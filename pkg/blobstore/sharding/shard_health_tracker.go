@@ -0,0 +1,262 @@
+package sharding
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	shardHealthTrackerPrometheusMetrics sync.Once
+
+	shardHealthTrackerShardHealthy = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "sharding_blob_access_shard_healthy",
+			Help:      "Whether a shard of ShardingBlobAccess is healthy (1) or temporarily taken out of rotation (0).",
+		},
+		[]string{"storage", "topology", "shard"})
+	shardHealthTrackerHealthTransitions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "sharding_blob_access_shard_health_transitions_total",
+			Help:      "Number of times a shard of ShardingBlobAccess changed its health state.",
+		},
+		[]string{"storage", "topology", "shard", "state"})
+	shardHealthTrackerProbes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "sharding_blob_access_shard_probes_total",
+			Help:      "Number of probes sent to unhealthy shards of ShardingBlobAccess.",
+		},
+		[]string{"storage", "topology", "shard", "result"})
+)
+
+// ShardHealthTracker keeps track of whether a shard of
+// ShardingBlobAccess is healthy. Unhealthy shards are skipped when
+// selecting a shard for a blob, as if they were drained.
+type ShardHealthTracker interface {
+	// IsHealthy returns whether requests may be routed to the shard.
+	IsHealthy() bool
+	// ReportResult is called by ShardingBlobAccess after every
+	// request sent to the shard, providing the outcome of the
+	// request.
+	ReportResult(err error)
+}
+
+// HealthyShardsCounter keeps track of the number of healthy shards of
+// a ShardingBlobAccess. It is updated by health trackers whenever a
+// shard changes state, so that ShardingBlobAccess can determine whether
+// any shard is healthy without inspecting every health tracker. The
+// zero value corresponds to a counter without any healthy shards.
+type HealthyShardsCounter struct {
+	count atomic.Int64
+}
+
+// Increment the number of healthy shards. This should be called when a
+// shard is added in the healthy state, or when it becomes healthy.
+func (c *HealthyShardsCounter) Increment() {
+	c.count.Add(1)
+}
+
+// Decrement the number of healthy shards. This should be called when a
+// shard becomes unhealthy.
+func (c *HealthyShardsCounter) Decrement() {
+	c.count.Add(-1)
+}
+
+// HasHealthyShards returns whether at least one shard is healthy.
+func (c *HealthyShardsCounter) HasHealthyShards() bool {
+	return c.count.Load() > 0
+}
+
+// ShardProbe is called by ConsecutiveFailuresShardHealthTracker to
+// determine whether an unhealthy shard has recovered.
+type ShardProbe func(ctx context.Context) error
+
+// probeDigest is the digest that is used by NewFindMissingShardProbe()
+// to check whether a shard is capable of processing requests.
+var probeDigest = digest.MustNewDigest(
+	"",
+	remoteexecution.DigestFunction_SHA256,
+	"0000000000000000000000000000000000000000000000000000000000000000",
+	1)
+
+// NewFindMissingShardProbe creates a ShardProbe that calls
+// FindMissing() against a shard's backend for a single digest that is
+// not expected to exist.
+func NewFindMissingShardProbe(backend blobstore.BlobAccess) ShardProbe {
+	return func(ctx context.Context) error {
+		_, err := backend.FindMissing(ctx, probeDigest.ToSingletonSet())
+		return err
+	}
+}
+
+// isShardFailure returns whether an error returned by a shard
+// indicates that the shard itself is malfunctioning, as opposed to the
+// request being invalid or the blob being absent.
+func isShardFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// ConsecutiveFailuresShardHealthTracker is an implementation of
+// ShardHealthTracker that marks a shard unhealthy after a number of
+// consecutive requests fail with an infrastructure error. While
+// unhealthy, the shard is probed periodically. The shard is marked
+// healthy once a probe succeeds.
+type ConsecutiveFailuresShardHealthTracker struct {
+	clock                        clock.Clock
+	probe                        ShardProbe
+	consecutiveFailuresThreshold uint32
+	probeInterval                time.Duration
+	probeTimeout                 time.Duration
+	healthyShards                *HealthyShardsCounter
+
+	// Writes to healthy are performed while holding the lock, so
+	// that they remain consistent with the other fields. Reads are
+	// lock-free, as they are performed for every request.
+	lock                sync.Mutex
+	healthy             atomic.Bool
+	consecutiveFailures uint32
+	unhealthyWakeup     chan struct{}
+
+	healthyGauge         prometheus.Gauge
+	healthyTransitions   prometheus.Counter
+	unhealthyTransitions prometheus.Counter
+	probesSuccess        prometheus.Counter
+	probesFailure        prometheus.Counter
+}
+
+var _ ShardHealthTracker = (*ConsecutiveFailuresShardHealthTracker)(nil)
+
+// NewConsecutiveFailuresShardHealthTracker creates a
+// ConsecutiveFailuresShardHealthTracker for a single shard. The shard
+// is initially assumed to be healthy. The storage type and the name of
+// the topology containing the shard (e.g., "current" or "previous" when
+// resharding) are used to label metrics, so that trackers of shards
+// with the same key don't share metrics.
+//
+// Transitions between the healthy and unhealthy states are reported to
+// a HealthyShardsCounter, which should be shared by the trackers of all
+// shards of the same ShardingBlobAccess.
+//
+// Probes are only sent while ProcessProbe() is called in a loop.
+func NewConsecutiveFailuresShardHealthTracker(clock clock.Clock, storageTypeName, topologyName, shardKey string, probe ShardProbe, consecutiveFailuresThreshold uint32, probeInterval, probeTimeout time.Duration, healthyShards *HealthyShardsCounter) *ConsecutiveFailuresShardHealthTracker {
+	shardHealthTrackerPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(shardHealthTrackerShardHealthy)
+		prometheus.MustRegister(shardHealthTrackerHealthTransitions)
+		prometheus.MustRegister(shardHealthTrackerProbes)
+	})
+
+	ht := &ConsecutiveFailuresShardHealthTracker{
+		clock:                        clock,
+		probe:                        probe,
+		consecutiveFailuresThreshold: consecutiveFailuresThreshold,
+		probeInterval:                probeInterval,
+		probeTimeout:                 probeTimeout,
+		healthyShards:                healthyShards,
+
+		unhealthyWakeup: make(chan struct{}),
+
+		healthyGauge:         shardHealthTrackerShardHealthy.WithLabelValues(storageTypeName, topologyName, shardKey),
+		healthyTransitions:   shardHealthTrackerHealthTransitions.WithLabelValues(storageTypeName, topologyName, shardKey, "Healthy"),
+		unhealthyTransitions: shardHealthTrackerHealthTransitions.WithLabelValues(storageTypeName, topologyName, shardKey, "Unhealthy"),
+		probesSuccess:        shardHealthTrackerProbes.WithLabelValues(storageTypeName, topologyName, shardKey, "Success"),
+		probesFailure:        shardHealthTrackerProbes.WithLabelValues(storageTypeName, topologyName, shardKey, "Failure"),
+	}
+	ht.healthy.Store(true)
+	ht.healthyShards.Increment()
+	ht.healthyGauge.Set(1)
+	return ht
+}
+
+// IsHealthy returns whether requests may be routed to the shard.
+func (ht *ConsecutiveFailuresShardHealthTracker) IsHealthy() bool {
+	return ht.healthy.Load()
+}
+
+// ReportResult updates the number of consecutive failures of the
+// shard, marking it unhealthy if the threshold is reached.
+func (ht *ConsecutiveFailuresShardHealthTracker) ReportResult(err error) {
+	ht.lock.Lock()
+	defer ht.lock.Unlock()
+	if !isShardFailure(err) {
+		ht.consecutiveFailures = 0
+		return
+	}
+	ht.consecutiveFailures++
+	if ht.healthy.Load() && ht.consecutiveFailures >= ht.consecutiveFailuresThreshold {
+		ht.healthy.Store(false)
+		ht.healthyShards.Decrement()
+		close(ht.unhealthyWakeup)
+		ht.healthyGauge.Set(0)
+		ht.unhealthyTransitions.Inc()
+	}
+}
+
+// ProcessProbe waits for the shard to become unhealthy. It then waits
+// for the probe interval to elapse, and probes the shard once. If the
+// probe succeeds, the shard is marked healthy.
+//
+// This function must generally be called in a loop in a separate
+// goroutine. It returns false if the provided context is canceled.
+func (ht *ConsecutiveFailuresShardHealthTracker) ProcessProbe(ctx context.Context) bool {
+	ht.lock.Lock()
+	unhealthyWakeup := ht.unhealthyWakeup
+	ht.lock.Unlock()
+	select {
+	case <-unhealthyWakeup:
+	case <-ctx.Done():
+		return false
+	}
+
+	timer, t := ht.clock.NewTimer(ht.probeInterval)
+	select {
+	case <-t:
+	case <-ctx.Done():
+		timer.Stop()
+		return false
+	}
+
+	probeCtx, cancel := ht.clock.NewContextWithTimeout(ctx, ht.probeTimeout)
+	err := ht.probe(probeCtx)
+	cancel()
+	if ctx.Err() != nil {
+		return false
+	}
+	if isShardFailure(err) {
+		ht.probesFailure.Inc()
+		return true
+	}
+	ht.probesSuccess.Inc()
+
+	ht.lock.Lock()
+	defer ht.lock.Unlock()
+	if !ht.healthy.Load() {
+		ht.healthy.Store(true)
+		ht.healthyShards.Increment()
+		ht.consecutiveFailures = 0
+		ht.unhealthyWakeup = make(chan struct{})
+		ht.healthyGauge.Set(1)
+		ht.healthyTransitions.Inc()
+	}
+	return true
+}
@@ -0,0 +1,92 @@
+package sharding_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/sharding"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestConsecutiveFailuresShardHealthTracker(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	clock := mock.NewMockClock(ctrl)
+	var probeErr error
+	probesCount := 0
+	var healthyShards sharding.HealthyShardsCounter
+	healthTracker := sharding.NewConsecutiveFailuresShardHealthTracker(
+		clock,
+		"cas",
+		"current",
+		"shard-a",
+		func(ctx context.Context) error {
+			probesCount++
+			return probeErr
+		},
+		/* consecutiveFailuresThreshold = */ 3,
+		/* probeInterval = */ 10*time.Second,
+		/* probeTimeout = */ 5*time.Second,
+		&healthyShards)
+
+	expectProbe := func() {
+		timer := mock.NewMockTimer(ctrl)
+		timerChan := make(chan time.Time, 1)
+		timerChan <- time.Unix(1010, 0)
+		clock.EXPECT().NewTimer(10*time.Second).Return(timer, timerChan)
+		clock.EXPECT().NewContextWithTimeout(gomock.Any(), 5*time.Second).
+			DoAndReturn(func(parent context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+				return context.WithCancel(parent)
+			})
+	}
+
+	// Shards should initially be healthy.
+	require.True(t, healthTracker.IsHealthy())
+	require.True(t, healthyShards.HasHealthyShards())
+
+	// Errors that are caused by the request should not count as
+	// failures. Successful requests should reset the number of
+	// consecutive failures.
+	healthTracker.ReportResult(status.Error(codes.Unavailable, "Server offline"))
+	healthTracker.ReportResult(status.Error(codes.Unavailable, "Server offline"))
+	healthTracker.ReportResult(status.Error(codes.NotFound, "Object not found"))
+	healthTracker.ReportResult(status.Error(codes.Unavailable, "Server offline"))
+	healthTracker.ReportResult(nil)
+	healthTracker.ReportResult(status.Error(codes.DeadlineExceeded, "Request timed out"))
+	healthTracker.ReportResult(status.Error(codes.Unavailable, "Server offline"))
+	require.True(t, healthTracker.IsHealthy())
+
+	// The third consecutive failure should mark the shard unhealthy.
+	healthTracker.ReportResult(status.Error(codes.Unavailable, "Server offline"))
+	require.False(t, healthTracker.IsHealthy())
+	require.False(t, healthyShards.HasHealthyShards())
+
+	// Probes that fail should leave the shard unhealthy.
+	expectProbe()
+	probeErr = status.Error(codes.Unavailable, "Server offline")
+	require.True(t, healthTracker.ProcessProbe(ctx))
+	require.Equal(t, 1, probesCount)
+	require.False(t, healthTracker.IsHealthy())
+
+	// Probes that succeed should mark the shard healthy again.
+	expectProbe()
+	probeErr = nil
+	require.True(t, healthTracker.ProcessProbe(ctx))
+	require.Equal(t, 2, probesCount)
+	require.True(t, healthTracker.IsHealthy())
+	require.True(t, healthyShards.HasHealthyShards())
+
+	// While healthy, no probes should be sent. ProcessProbe() should
+	// block until the context is canceled.
+	ctxWithCancel, cancel := context.WithCancel(ctx)
+	cancel()
+	require.False(t, healthTracker.ProcessProbe(ctxWithCancel))
+	require.Equal(t, 2, probesCount)
+}
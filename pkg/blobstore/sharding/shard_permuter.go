@@ -17,3 +17,23 @@ type ShardSelector func(int) bool
 type ShardPermuter interface {
 	GetShard(hash uint64, selector ShardSelector)
 }
+
+// hashShardKey computes a 64-bit hash of the stable identifier of a
+// shard, using FNV-1a.
+func hashShardKey(key string) uint64 {
+	h := uint64(14695981039346656037)
+	for _, c := range []byte(key) {
+		h ^= uint64(c)
+		h *= 1099511628211
+	}
+	return h
+}
+
+// mix64 is the finalizer of the SplitMix64 pseudo-random number
+// generator. It is used to obtain well distributed hashes from inputs
+// that may only differ in a small number of bits.
+func mix64(h uint64) uint64 {
+	h = (h ^ (h >> 30)) * 0xbf58476d1ce4e5b9
+	h = (h ^ (h >> 27)) * 0x94d049bb133111eb
+	return h ^ (h >> 31)
+}
@@ -2,6 +2,7 @@ package sharding
 
 import (
 	"context"
+	"iter"
 	"sync/atomic"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
@@ -16,6 +17,8 @@ import (
 
 type shardingBlobAccess struct {
 	backends             []blobstore.BlobAccess
+	healthTrackers       []ShardHealthTracker
+	healthyShards        *HealthyShardsCounter
 	shardPermuter        ShardPermuter
 	hashInitialization   uint64
 	getCapabilitiesRound atomic.Uint64
@@ -24,29 +27,36 @@ type shardingBlobAccess struct {
 // NewShardingBlobAccess is an adapter for BlobAccess that partitions
 // requests across backends by hashing the digest. A ShardPermuter is
 // used to map hashes to backends.
-func NewShardingBlobAccess(backends []blobstore.BlobAccess, shardPermuter ShardPermuter, hashInitialization uint64) blobstore.BlobAccess {
+//
+// If health trackers are provided, shards that are reported to be
+// unhealthy are skipped, causing requests to be routed to the next
+// shard returned by the ShardPermuter. The outcome of every request is
+// reported to the health tracker of the shard that processed it.
+// Drained shards have no health tracker. The health trackers must
+// report state transitions to the provided HealthyShardsCounter.
+func NewShardingBlobAccess(backends []blobstore.BlobAccess, healthTrackers []ShardHealthTracker, healthyShards *HealthyShardsCounter, shardPermuter ShardPermuter, hashInitialization uint64) blobstore.BlobAccess {
 	return &shardingBlobAccess{
 		backends:           backends,
+		healthTrackers:     healthTrackers,
+		healthyShards:      healthyShards,
 		shardPermuter:      shardPermuter,
 		hashInitialization: hashInitialization,
 	}
 }
 
 func (ba *shardingBlobAccess) getBackendIndexByDigest(blobDigest digest.Digest) int {
-	// Hash the key using FNV-1a.
-	h := ba.hashInitialization
-	for _, c := range blobDigest.GetKey(digest.KeyWithoutInstance) {
-		h ^= uint64(c)
-		h *= 1099511628211
-	}
-	return ba.getBackendIndexByHash(h)
+	return ba.getBackendIndexByHash(hashDigest(ba.hashInitialization, blobDigest))
 }
 
 func (ba *shardingBlobAccess) getBackendIndexByHash(h uint64) int {
-	// Keep requesting shards until matching one that is undrained.
+	// Keep requesting shards until matching one that is undrained
+	// and healthy. If all shards are unhealthy, there is no point in
+	// skipping any of them, as that would prevent requests from
+	// succeeding once shards recover.
+	skipUnhealthy := ba.healthyShards != nil && ba.healthyShards.HasHealthyShards()
 	var selectedIndex int
 	ba.shardPermuter.GetShard(h, func(index int) bool {
-		if ba.backends[index] == nil {
+		if ba.backends[index] == nil || (skipUnhealthy && !ba.healthTrackers[index].IsHealthy()) {
 			return true
 		}
 		selectedIndex = index
@@ -55,23 +65,41 @@ func (ba *shardingBlobAccess) getBackendIndexByHash(h uint64) int {
 	return selectedIndex
 }
 
+// reportResult forwards the outcome of a request to the health tracker
+// of the shard, if health tracking is enabled.
+func (ba *shardingBlobAccess) reportResult(index int, err error) {
+	if ba.healthTrackers != nil {
+		ba.healthTrackers[index].ReportResult(err)
+	}
+}
+
+func (ba *shardingBlobAccess) newErrorHandler(index int) buffer.ErrorHandler {
+	eh := &shardIndexAddingErrorHandler{index: index}
+	if ba.healthTrackers != nil {
+		eh.healthTracker = ba.healthTrackers[index]
+	}
+	return eh
+}
+
 func (ba *shardingBlobAccess) Get(ctx context.Context, digest digest.Digest) buffer.Buffer {
 	index := ba.getBackendIndexByDigest(digest)
 	return buffer.WithErrorHandler(
 		ba.backends[index].Get(ctx, digest),
-		shardIndexAddingErrorHandler{index: index})
+		ba.newErrorHandler(index))
 }
 
 func (ba *shardingBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
 	index := ba.getBackendIndexByDigest(parentDigest)
 	return buffer.WithErrorHandler(
 		ba.backends[index].GetFromComposite(ctx, parentDigest, childDigest, slicer),
-		shardIndexAddingErrorHandler{index: index})
+		ba.newErrorHandler(index))
 }
 
 func (ba *shardingBlobAccess) Put(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
 	index := ba.getBackendIndexByDigest(digest)
-	if err := ba.backends[index].Put(ctx, digest, b); err != nil {
+	err := ba.backends[index].Put(ctx, digest, b)
+	ba.reportResult(index, err)
+	if err != nil {
 		return util.StatusWrapf(err, "Shard %d", index)
 	}
 	return nil
@@ -97,6 +125,7 @@ func (ba *shardingBlobAccess) FindMissing(ctx context.Context, digests digest.Se
 			missingOut := &missingPerBackend[len(missingPerBackend)-1]
 			group.Go(func() error {
 				missing, err := ba.backends[index].FindMissing(ctxWithCancel, digests.Build())
+				ba.reportResult(index, err)
 				if err != nil {
 					return util.StatusWrapf(err, "Shard %d", index)
 				}
@@ -113,10 +142,36 @@ func (ba *shardingBlobAccess) FindMissing(ctx context.Context, digests digest.Se
 	return digest.GetUnion(missingPerBackend), nil
 }
 
+func (ba *shardingBlobAccess) FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+	// Partition the stream into one stream per shard. Streams are
+	// only created for shards that receive at least one digest.
+	partitioner := blobstore.NewFindMissingStreamPartitioner(ctx, reportMissing)
+	partitions := make([]*blobstore.FindMissingStreamPartition, len(ba.backends))
+	for blobDigest := range digests {
+		index := ba.getBackendIndexByDigest(blobDigest)
+		if partitions[index] == nil {
+			backend := ba.backends[index]
+			partitions[index] = partitioner.AddPartition(func(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+				err := backend.FindMissingStream(ctx, digests, reportMissing)
+				ba.reportResult(index, err)
+				if err != nil {
+					return util.StatusWrapf(err, "Shard %d", index)
+				}
+				return nil
+			})
+		}
+		if !partitions[index].Add(blobDigest) {
+			break
+		}
+	}
+	return partitioner.Wait()
+}
+
 func (ba *shardingBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
 	// Spread requests across shards.
 	index := ba.getBackendIndexByHash(ba.getCapabilitiesRound.Add(1))
 	capabilities, err := ba.backends[index].GetCapabilities(ctx, instanceName)
+	ba.reportResult(index, err)
 	if err != nil {
 		return nil, util.StatusWrapf(err, "Shard %d", index)
 	}
@@ -124,11 +179,21 @@ func (ba *shardingBlobAccess) GetCapabilities(ctx context.Context, instanceName
 }
 
 type shardIndexAddingErrorHandler struct {
-	index int
+	index         int
+	healthTracker ShardHealthTracker
+	failed        bool
 }
 
-func (eh shardIndexAddingErrorHandler) OnError(err error) (buffer.Buffer, error) {
+func (eh *shardIndexAddingErrorHandler) OnError(err error) (buffer.Buffer, error) {
+	if eh.healthTracker != nil {
+		eh.healthTracker.ReportResult(err)
+		eh.failed = true
+	}
 	return nil, util.StatusWrapf(err, "Shard %d", eh.index)
 }
 
-func (eh shardIndexAddingErrorHandler) Done() {}
+func (eh *shardIndexAddingErrorHandler) Done() {
+	if eh.healthTracker != nil && !eh.failed {
+		eh.healthTracker.ReportResult(nil)
+	}
+}
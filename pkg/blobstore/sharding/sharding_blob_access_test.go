@@ -2,6 +2,8 @@ package sharding_test
 
 import (
 	"context"
+	"iter"
+	"slices"
 	"testing"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
@@ -31,6 +33,8 @@ func TestShardingBlobAccess(t *testing.T) {
 			shard1,
 			nil, // Shard that is explicitly drained.
 		},
+		/* healthTrackers = */ nil,
+		/* healthyShards = */ nil,
 		shardPermuter,
 		/* hashInitialization = */ 0x62994904405896a1)
 
@@ -191,4 +195,135 @@ func TestShardingBlobAccess(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, digest.NewSetBuilder().Add(digest1).Add(digest3).Build(), missing)
 	})
+
+	t.Run("FindMissingStreamFailure", func(t *testing.T) {
+		// Errors returned by backends should be prefixed with
+		// the shard number.
+		shardPermuter.EXPECT().GetShard(uint64(0xe4780eee2c3e5c4d), gomock.Any()).Do(
+			func(hash uint64, selector sharding.ShardSelector) {
+				require.False(t, selector(0))
+			})
+		shard0.EXPECT().FindMissingStream(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+				require.Equal(t, []digest.Digest{digest1}, slices.Collect(digests))
+				return status.Error(codes.Unavailable, "Server offline")
+			})
+
+		err := blobAccess.FindMissingStream(
+			ctx,
+			slices.Values([]digest.Digest{digest1}),
+			func(blobDigest digest.Digest) error {
+				t.Fatal("No digests should be reported as missing")
+				return nil
+			})
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Shard 0: Server offline"), err)
+	})
+
+	t.Run("FindMissingStreamSuccess", func(t *testing.T) {
+		// Digests should be forwarded to the stream of the
+		// shard to which they belong. Shards that don't receive
+		// any digests should not be called into.
+		shardPermuter.EXPECT().GetShard(uint64(0xe4780eee2c3e5c4d), gomock.Any()).Do(
+			func(hash uint64, selector sharding.ShardSelector) {
+				require.False(t, selector(1))
+			})
+		shardPermuter.EXPECT().GetShard(uint64(0xb1e63d21c14e3f12), gomock.Any()).Do(
+			func(hash uint64, selector sharding.ShardSelector) {
+				require.False(t, selector(1))
+			})
+		shardPermuter.EXPECT().GetShard(uint64(0x71fb8268edc4f6e9), gomock.Any()).Do(
+			func(hash uint64, selector sharding.ShardSelector) {
+				require.False(t, selector(1))
+			})
+		shard1.EXPECT().FindMissingStream(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+				for blobDigest := range digests {
+					if blobDigest != digest2 {
+						if err := reportMissing(blobDigest); err != nil {
+							return err
+						}
+					}
+				}
+				return nil
+			})
+
+		missing := digest.NewSetBuilder()
+		require.NoError(t, blobAccess.FindMissingStream(
+			ctx,
+			slices.Values([]digest.Digest{digest1, digest2, digest3}),
+			func(blobDigest digest.Digest) error {
+				missing.Add(blobDigest)
+				return nil
+			}))
+		require.Equal(t, digest.NewSetBuilder().Add(digest1).Add(digest3).Build(), missing.Build())
+	})
+}
+
+func TestShardingBlobAccessHealthTracking(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	shard0 := mock.NewMockBlobAccess(ctrl)
+	shard1 := mock.NewMockBlobAccess(ctrl)
+	healthTracker0 := mock.NewMockShardHealthTracker(ctrl)
+	healthTracker1 := mock.NewMockShardHealthTracker(ctrl)
+	shardPermuter := mock.NewMockShardPermuter(ctrl)
+	var healthyShards sharding.HealthyShardsCounter
+	blobAccess := sharding.NewShardingBlobAccess(
+		[]blobstore.BlobAccess{
+			shard0,
+			shard1,
+			nil, // Shard that is explicitly drained.
+		},
+		[]sharding.ShardHealthTracker{
+			healthTracker0,
+			healthTracker1,
+			nil,
+		},
+		&healthyShards,
+		shardPermuter,
+		/* hashInitialization = */ 0x62994904405896a1)
+
+	helloDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	t.Run("GetSkipUnhealthy", func(t *testing.T) {
+		// Shard 0 is unhealthy, meaning the request should be
+		// routed to the next shard in the permutation. The
+		// outcome should be reported to shard 1's health
+		// tracker.
+		healthyShards.Increment()
+		healthTracker0.EXPECT().IsHealthy().Return(false)
+		healthTracker1.EXPECT().IsHealthy().Return(true)
+		shardPermuter.EXPECT().GetShard(uint64(0x7118d6877ee9ee3d), gomock.Any()).Do(
+			func(hash uint64, selector sharding.ShardSelector) {
+				require.True(t, selector(0))
+				require.True(t, selector(2))
+				require.False(t, selector(1))
+			})
+		shard1.EXPECT().Get(ctx, helloDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.Unavailable, "Server offline")))
+		healthTracker1.EXPECT().ReportResult(testutil.EqStatus(t, status.Error(codes.Unavailable, "Server offline")))
+
+		_, err := blobAccess.Get(ctx, helloDigest).ToByteSlice(1000)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Shard 1: Server offline"), err)
+	})
+
+	t.Run("PutAllUnhealthy", func(t *testing.T) {
+		// If all shards are unhealthy, they should not be
+		// skipped, as that would prevent all requests from
+		// being processed. The health trackers should not be
+		// consulted individually.
+		healthyShards.Decrement()
+		shardPermuter.EXPECT().GetShard(uint64(0x7118d6877ee9ee3d), gomock.Any()).Do(
+			func(hash uint64, selector sharding.ShardSelector) {
+				require.False(t, selector(0))
+			})
+		shard0.EXPECT().Put(ctx, helloDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			})
+		healthTracker0.EXPECT().ReportResult(nil)
+
+		require.NoError(t, blobAccess.Put(ctx, helloDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
 }
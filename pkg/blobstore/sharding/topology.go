@@ -0,0 +1,69 @@
+package sharding
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/lazybeaver/xorshift"
+)
+
+// Topology describes how blobs are assigned to shards, without
+// providing access to the shards themselves. It can be used to compare
+// sharding configurations.
+type Topology struct {
+	HashInitialization uint64
+	ShardPermuter      ShardPermuter
+
+	// Stable identifiers of the shards, indexed by the values
+	// returned by ShardPermuter. Drained shards are denoted by
+	// empty strings.
+	ShardKeys []string
+}
+
+func (t *Topology) getShardKeyByDigest(blobDigest digest.Digest) string {
+	var key string
+	t.ShardPermuter.GetShard(hashDigest(t.HashInitialization, blobDigest), func(index int) bool {
+		key = t.ShardKeys[index]
+		return key == ""
+	})
+	return key
+}
+
+// hashDigest computes the hash of a digest that is provided to
+// ShardPermuter to determine which shard stores the blob.
+func hashDigest(hashInitialization uint64, blobDigest digest.Digest) uint64 {
+	// Hash the key using FNV-1a.
+	h := hashInitialization
+	for _, c := range blobDigest.GetKey(digest.KeyWithoutInstance) {
+		h ^= uint64(c)
+		h *= 1099511628211
+	}
+	return h
+}
+
+// EstimateFractionOfKeysMoved estimates the fraction of blobs that are
+// stored on another shard after changing the sharding configuration
+// from one topology to another. It does this by routing a number of
+// randomly generated digests through both topologies, and comparing
+// the keys of the shards that are selected.
+func EstimateFractionOfKeysMoved(oldTopology, newTopology *Topology, samplesCount int) float64 {
+	generator := xorshift.NewXorShift64Star(1)
+	var hash [32]byte
+	moved := 0
+	for i := 0; i < samplesCount; i++ {
+		for j := 0; j < len(hash); j += 8 {
+			binary.LittleEndian.PutUint64(hash[j:], generator.Next())
+		}
+		blobDigest := digest.MustNewDigest(
+			"",
+			remoteexecution.DigestFunction_SHA256,
+			hex.EncodeToString(hash[:]),
+			int64(generator.Next()>>40))
+		if oldTopology.getShardKeyByDigest(blobDigest) != newTopology.getShardKeyByDigest(blobDigest) {
+			moved++
+		}
+	}
+	return float64(moved) / float64(samplesCount)
+}
@@ -0,0 +1,50 @@
+package sharding_test
+
+import (
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/sharding"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateFractionOfKeysMoved(t *testing.T) {
+	oldTopology := sharding.Topology{
+		HashInitialization: 0x62994904405896a1,
+		ShardPermuter:      sharding.NewRendezvousShardPermuter([]string{"a", "b", "c"}, []uint32{1, 1, 1}),
+		ShardKeys:          []string{"a", "b", "c"},
+	}
+
+	t.Run("Identical", func(t *testing.T) {
+		require.Equal(t, 0.0, sharding.EstimateFractionOfKeysMoved(&oldTopology, &oldTopology, 10000))
+	})
+
+	t.Run("AddShard", func(t *testing.T) {
+		// Adding a fourth shard should only move a quarter of
+		// all keys.
+		newTopology := sharding.Topology{
+			HashInitialization: 0x62994904405896a1,
+			ShardPermuter:      sharding.NewRendezvousShardPermuter([]string{"a", "b", "c", "d"}, []uint32{1, 1, 1, 1}),
+			ShardKeys:          []string{"a", "b", "c", "d"},
+		}
+		require.InDelta(t, 0.25, sharding.EstimateFractionOfKeysMoved(&oldTopology, &newTopology, 100000), 0.01)
+	})
+
+	t.Run("DrainShard", func(t *testing.T) {
+		// Draining a shard should move all keys stored on it.
+		newTopology := sharding.Topology{
+			HashInitialization: 0x62994904405896a1,
+			ShardPermuter:      oldTopology.ShardPermuter,
+			ShardKeys:          []string{"a", "", "c"},
+		}
+		require.InDelta(t, 1.0/3, sharding.EstimateFractionOfKeysMoved(&oldTopology, &newTopology, 100000), 0.01)
+	})
+
+	t.Run("ChangeHashInitialization", func(t *testing.T) {
+		// Changing the hash initialization causes a full
+		// repartitioning. Only keys that end up on the same shard
+		// by accident are not moved.
+		newTopology := oldTopology
+		newTopology.HashInitialization = 0x5f9d8f1ec7c3a0b2
+		require.InDelta(t, 2.0/3, sharding.EstimateFractionOfKeysMoved(&oldTopology, &newTopology, 100000), 0.01)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"archive/zip"
 	"context"
 	"io"
+	"iter"
 
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
@@ -19,6 +20,7 @@ type zipReadingBlobAccess struct {
 	capabilities.Provider
 	readBufferFactory ReadBufferFactory
 	digestKeyFormat   digest.KeyFormat
+	filesList         []*zip.File
 	files             map[string]*zip.File
 }
 
@@ -26,6 +28,10 @@ type zipReadingBlobAccess struct {
 // reading objects from a ZIP archive. Depending on whether the
 // containing files are compressed, files may either be randomly or
 // sequentially accessible.
+//
+// As the names of the files contained in the ZIP archive correspond to
+// the keys of the objects, the returned BlobAccess also implements
+// DigestEnumerator.
 func NewZIPReadingBlobAccess(capabilitiesProvider capabilities.Provider, readBufferFactory ReadBufferFactory, digestKeyFormat digest.KeyFormat, filesList []*zip.File) BlobAccess {
 	files := make(map[string]*zip.File, len(filesList))
 	for _, file := range filesList {
@@ -35,6 +41,7 @@ func NewZIPReadingBlobAccess(capabilitiesProvider capabilities.Provider, readBuf
 		Provider:          capabilitiesProvider,
 		readBufferFactory: readBufferFactory,
 		digestKeyFormat:   digestKeyFormat,
+		filesList:         filesList,
 		files:             files,
 	}
 }
@@ -93,6 +100,40 @@ func (ba *zipReadingBlobAccess) FindMissing(ctx context.Context, digests digest.
 	return missing.Build(), nil
 }
 
+func (ba *zipReadingBlobAccess) FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+	for fileDigest := range digests {
+		if _, ok := ba.files[fileDigest.GetKey(ba.digestKeyFormat)]; !ok {
+			if err := reportMissing(fileDigest); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (ba *zipReadingBlobAccess) EnumerateDigests(ctx context.Context, instanceNamePrefix digest.InstanceName, reportDigest func(digest.Digest) error) error {
+	// If the keys of objects don't contain an instance name, the
+	// objects are not associated with any instance name. Report
+	// them as if they are stored under the prefix.
+	instanceNamePrefixTrie := digest.NewInstanceNameTrie()
+	instanceNamePrefixTrie.Set(instanceNamePrefix, 0)
+	for _, file := range ba.filesList {
+		if err := util.StatusFromContext(ctx); err != nil {
+			return err
+		}
+		fileDigest, err := instanceNamePrefix.NewDigestFromKey(file.Name, ba.digestKeyFormat)
+		if err != nil {
+			return util.StatusWrapf(err, "Invalid key %#v in ZIP archive", file.Name)
+		}
+		if instanceNamePrefixTrie.ContainsPrefix(fileDigest.GetInstanceName()) {
+			if err := reportDigest(fileDigest); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 type nopAtCloser struct {
 	io.ReaderAt
 }
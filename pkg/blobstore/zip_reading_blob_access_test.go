@@ -161,4 +161,75 @@ func TestZIPReadingBlobAccess(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA256, "522b44d647b6989f60302ef755c277e508d5bcc38f05e139906ebdb03a5b19f2", 9).ToSingletonSet(), missing)
 	})
+	t.Run("EnumerateDigests", func(t *testing.T) {
+		// As the ZIP archive uses keys without instance names,
+		// all objects should be reported under the prefix.
+		var digests []digest.Digest
+		require.NoError(t, blobAccess.(blobstore.DigestEnumerator).EnumerateDigests(
+			ctx,
+			digest.MustNewInstanceName("example"),
+			func(blobDigest digest.Digest) error {
+				digests = append(digests, blobDigest)
+				return nil
+			}))
+		require.Equal(t, []digest.Digest{
+			digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA1, "897256b6709e1a4da9daba92b6bde39ccfccd8c1", 16384),
+			digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5),
+		}, digests)
+	})
+}
+
+func TestZIPReadingBlobAccessEnumerateDigestsWithInstance(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	// Create a ZIP archive whose keys contain instance names, as
+	// is the case for the Action Cache.
+	digests := []digest.Digest{
+		digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5),
+		digest.MustNewDigest("a", remoteexecution.DigestFunction_MD5, "5d41402abc4b2a76b9719d911017c592", 5),
+		digest.MustNewDigest("a/b", remoteexecution.DigestFunction_MD5, "7d793037a0760186574b0282f2f435e7", 5),
+		digest.MustNewDigest("ab", remoteexecution.DigestFunction_MD5, "0cc175b9c0f1b6a831c399e269772661", 1),
+	}
+	var zipData bytes.Buffer
+	zipWriter := zip.NewWriter(&zipData)
+	for _, blobDigest := range digests {
+		_, err := zipWriter.Create(blobDigest.GetKey(digest.KeyWithInstance))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zipWriter.Close())
+	zipReader, err := zip.NewReader(bytes.NewReader(zipData.Bytes()), int64(zipData.Len()))
+	require.NoError(t, err)
+
+	blobAccess := blobstore.NewZIPReadingBlobAccess(
+		mock.NewMockCapabilitiesProvider(ctrl),
+		mock.NewMockReadBufferFactory(ctrl),
+		digest.KeyWithInstance,
+		zipReader.File)
+
+	t.Run("Prefix", func(t *testing.T) {
+		// Only objects whose instance name is equal to or
+		// below the prefix should be reported.
+		var enumeratedDigests []digest.Digest
+		require.NoError(t, blobAccess.(blobstore.DigestEnumerator).EnumerateDigests(
+			ctx,
+			digest.MustNewInstanceName("a"),
+			func(blobDigest digest.Digest) error {
+				enumeratedDigests = append(enumeratedDigests, blobDigest)
+				return nil
+			}))
+		require.Equal(t, digests[1:3], enumeratedDigests)
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		// Errors returned by the callback should be propagated.
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Internal, "Callback failed"),
+			blobAccess.(blobstore.DigestEnumerator).EnumerateDigests(
+				ctx,
+				digest.EmptyInstanceName,
+				func(blobDigest digest.Digest) error {
+					return status.Error(codes.Internal, "Callback failed")
+				}))
+	})
 }
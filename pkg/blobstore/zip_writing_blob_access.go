@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 	"hash/crc32"
 	"io"
+	"iter"
 	"sync"
 
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
@@ -216,6 +217,10 @@ func (ba *ZIPWritingBlobAccess) FindMissing(ctx context.Context, digests digest.
 	return missing.Build(), nil
 }
 
+func (ba *ZIPWritingBlobAccess) FindMissingStream(ctx context.Context, digests iter.Seq[digest.Digest], reportMissing func(digest.Digest) error) error {
+	return FindMissingStreamInBatches(ctx, digests, reportMissing, ba.FindMissing)
+}
+
 // Finalize the ZIP archive by appending a central directory to the
 // underlying file. Once called, it is no longer possible to call Put().
 func (ba *ZIPWritingBlobAccess) Finalize() error {
@@ -31,3 +31,9 @@ type BlockDevice interface {
 }
 
 var _ BlockDevice = (*os.File)(nil)
+
+// blockDeviceFactory is invoked by the functions in this package that
+// open block devices to create a BlockDevice for a file descriptor,
+// once its size and sector size have been determined. When nil, the
+// block device is accessed through a memory map.
+type blockDeviceFactory func(fd, sizeBytes, sectorSizeBytes int) (BlockDevice, error)
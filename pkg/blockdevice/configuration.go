@@ -2,11 +2,66 @@ package blockdevice
 
 import (
 	pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/blockdevice"
+	"github.com/buildbarn/bb-storage/pkg/util"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// getBlockDeviceFactory returns the function that should be used to
+// create a BlockDevice once its file descriptor has been opened, based
+// on the I/O method selected in the configuration.
+func getBlockDeviceFactory(configuration *pb.Configuration) (blockDeviceFactory, error) {
+	if directIO := configuration.DirectIo; directIO != nil {
+		return newDirectIOBlockDeviceFactory(int(directIO.IoUringEntries))
+	}
+	return nil, nil
+}
+
+// newStripedBlockDeviceFromConfiguration creates a BlockDevice that
+// stripes data across multiple BlockDevices, each of which is created
+// by calling into a provided function.
+func newStripedBlockDeviceFromConfiguration(configuration *pb.Configuration, stripedConfiguration *pb.StripedConfiguration, newDevice func(*pb.Configuration) (BlockDevice, int, int64, error)) (BlockDevice, int, int64, error) {
+	if configuration.DirectIo != nil {
+		return nil, 0, 0, status.Error(codes.InvalidArgument, "Direct I/O needs to be configured on the individual block devices that are striped")
+	}
+	if len(stripedConfiguration.Devices) == 0 {
+		return nil, 0, 0, status.Error(codes.InvalidArgument, "No block devices to stripe provided")
+	}
+
+	devices := make([]BlockDevice, 0, len(stripedConfiguration.Devices))
+	var sectorSizeBytes int
+	var minimumSectorCount int64
+	for i, deviceConfiguration := range stripedConfiguration.Devices {
+		device, deviceSectorSizeBytes, deviceSectorCount, err := newDevice(deviceConfiguration)
+		if err != nil {
+			return nil, 0, 0, util.StatusWrapf(err, "Device %d", i)
+		}
+		if i == 0 {
+			sectorSizeBytes = deviceSectorSizeBytes
+			minimumSectorCount = deviceSectorCount
+		} else {
+			if deviceSectorSizeBytes != sectorSizeBytes {
+				return nil, 0, 0, status.Errorf(codes.InvalidArgument, "Device %d has a sector size of %d bytes, while device 0 has a sector size of %d bytes", i, deviceSectorSizeBytes, sectorSizeBytes)
+			}
+			minimumSectorCount = min(minimumSectorCount, deviceSectorCount)
+		}
+		devices = append(devices, device)
+	}
+
+	stripeSizeBytes := stripedConfiguration.StripeSizeBytes
+	if stripeSizeBytes <= 0 || stripeSizeBytes%int64(sectorSizeBytes) != 0 {
+		return nil, 0, 0, status.Errorf(codes.InvalidArgument, "Stripe size must be a positive multiple of the sector size of %d bytes", sectorSizeBytes)
+	}
+	stripeSectorCount := stripeSizeBytes / int64(sectorSizeBytes)
+	stripesPerDevice := minimumSectorCount / stripeSectorCount
+	if stripesPerDevice == 0 {
+		return nil, 0, 0, status.Errorf(codes.InvalidArgument, "Stripe size of %d bytes exceeds the size of the smallest device, which is %d bytes", stripeSizeBytes, minimumSectorCount*int64(sectorSizeBytes))
+	}
+	sectorCount := stripesPerDevice * stripeSectorCount * int64(len(devices))
+	return NewStripedBlockDevice(devices, stripeSizeBytes, sectorCount*int64(sectorSizeBytes)), sectorSizeBytes, sectorCount, nil
+}
+
 // NewBlockDeviceFromConfiguration creates a BlockDevice based on
 // parameters provided in a configuration file.
 func NewBlockDeviceFromConfiguration(configuration *pb.Configuration, mayZeroInitialize bool) (BlockDevice, int, int64, error) {
@@ -14,11 +69,47 @@ func NewBlockDeviceFromConfiguration(configuration *pb.Configuration, mayZeroIni
 		return nil, 0, 0, status.Error(codes.InvalidArgument, "Block device configuration not specified")
 	}
 
+	newBlockDevice, err := getBlockDeviceFactory(configuration)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	switch source := configuration.Source.(type) {
+	case *pb.Configuration_DevicePath:
+		return newBlockDeviceFromDevice(source.DevicePath, false, newBlockDevice)
+	case *pb.Configuration_File:
+		return newBlockDeviceFromFile(source.File.Path, int(source.File.SizeBytes), mayZeroInitialize, false, newBlockDevice)
+	case *pb.Configuration_Striped:
+		return newStripedBlockDeviceFromConfiguration(configuration, source.Striped, func(configuration *pb.Configuration) (BlockDevice, int, int64, error) {
+			return NewBlockDeviceFromConfiguration(configuration, mayZeroInitialize)
+		})
+	default:
+		return nil, 0, 0, status.Error(codes.InvalidArgument, "Configuration did not contain a supported block device source")
+	}
+}
+
+// NewReadOnlyBlockDeviceFromConfiguration is identical to
+// NewBlockDeviceFromConfiguration, except that the block device is
+// opened for reading only. Files are neither created nor resized.
+// Attempts to write to the resulting block device will fail.
+//
+// This may be used by tools that need to inspect the contents of a
+// block device without modifying it.
+func NewReadOnlyBlockDeviceFromConfiguration(configuration *pb.Configuration) (BlockDevice, int, int64, error) {
+	if configuration == nil {
+		return nil, 0, 0, status.Error(codes.InvalidArgument, "Block device configuration not specified")
+	}
+
+	newBlockDevice, err := getBlockDeviceFactory(configuration)
+	if err != nil {
+		return nil, 0, 0, err
+	}
 	switch source := configuration.Source.(type) {
 	case *pb.Configuration_DevicePath:
-		return NewBlockDeviceFromDevice(source.DevicePath)
+		return newBlockDeviceFromDevice(source.DevicePath, true, newBlockDevice)
 	case *pb.Configuration_File:
-		return NewBlockDeviceFromFile(source.File.Path, int(source.File.SizeBytes), mayZeroInitialize)
+		return newBlockDeviceFromFile(source.File.Path, int(source.File.SizeBytes), false, true, newBlockDevice)
+	case *pb.Configuration_Striped:
+		return newStripedBlockDeviceFromConfiguration(configuration, source.Striped, NewReadOnlyBlockDeviceFromConfiguration)
 	default:
 		return nil, 0, 0, status.Error(codes.InvalidArgument, "Configuration did not contain a supported block device source")
 	}
@@ -0,0 +1,17 @@
+//go:build !linux
+// +build !linux
+
+package blockdevice
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newDirectIOBlockDeviceFactory returns a function for creating
+// BlockDevices that are accessed using direct I/O. This implementation
+// is a stub for operating systems for which direct I/O is not
+// supported.
+func newDirectIOBlockDeviceFactory(ioURingEntries int) (blockDeviceFactory, error) {
+	return nil, status.Error(codes.Unimplemented, "Direct I/O is only supported on Linux")
+}
@@ -0,0 +1,258 @@
+//go:build linux
+// +build linux
+
+package blockdevice
+
+import (
+	"io"
+	"runtime"
+	"sync"
+	"syscall"
+	"unsafe"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"golang.org/x/sys/unix"
+)
+
+// maximumPooledBufferSizeBytes is the maximum size of intermediate
+// buffers that are retained for reuse after performing reads and
+// writes that are not aligned to sector boundaries. Larger buffers are
+// released after use, so that infrequent large operations don't cause
+// the pool to retain a lot of memory.
+const maximumPooledBufferSizeBytes = 1 << 20
+
+type directIOBlockDevice struct {
+	fd              int
+	sizeBytes       int64
+	sectorSizeBytes int
+	pread           func(fd int, p []byte, offset int64) (int, error)
+	pwrite          func(fd int, p []byte, offset int64) (int, error)
+	ring            *ioURing
+
+	// Intermediate buffers used by reads and writes that don't
+	// meet the alignment requirements of direct I/O. Pooling them
+	// prevents small unaligned operations from allocating and
+	// zeroing buffers each time.
+	alignedBufferPool sync.Pool
+
+	// Writes that don't start or end at sector boundaries require
+	// the surrounding sectors to be read, modified and written
+	// back. These writes are serialized, so that concurrent writes
+	// to adjacent parts of the same sector don't overwrite each
+	// other.
+	unalignedWriteLock sync.Mutex
+}
+
+// newDirectIOBlockDeviceFactory returns a function for creating
+// BlockDevices that are accessed using direct I/O (O_DIRECT). As
+// opposed to memory mapped BlockDevices, reads and writes bypass the
+// page cache entirely. This prevents the page cache from thrashing,
+// and makes the latency of reads more predictable.
+//
+// Direct I/O requires that the offsets, sizes and addresses of buffers
+// are aligned to sector boundaries. Reads and writes that don't meet
+// these requirements are performed through intermediate buffers.
+//
+// If the number of io_uring entries is non-zero, reads and writes are
+// submitted through an io_uring instead of calling pread() and
+// pwrite(). The io_uring and the file descriptor are released when the
+// BlockDevice is closed or garbage collected.
+func newDirectIOBlockDeviceFactory(ioURingEntries int) (blockDeviceFactory, error) {
+	return func(fd, sizeBytes, sectorSizeBytes int) (BlockDevice, error) {
+		flags, err := unix.FcntlInt(uintptr(fd), unix.F_GETFL, 0)
+		if err != nil {
+			return nil, util.StatusWrap(err, "Failed to obtain file descriptor flags")
+		}
+		if _, err := unix.FcntlInt(uintptr(fd), unix.F_SETFL, flags|unix.O_DIRECT); err != nil {
+			return nil, util.StatusWrap(err, "Failed to enable direct I/O")
+		}
+
+		bd := &directIOBlockDevice{
+			fd:              fd,
+			sizeBytes:       int64(sizeBytes),
+			sectorSizeBytes: sectorSizeBytes,
+			pread:           unix.Pread,
+			pwrite:          unix.Pwrite,
+		}
+		if ioURingEntries > 0 {
+			ring, err := newIOURing(ioURingEntries)
+			if err != nil {
+				return nil, util.StatusWrap(err, "Failed to create io_uring")
+			}
+			bd.pread = ring.pread
+			bd.pwrite = ring.pwrite
+			bd.ring = ring
+		}
+		runtime.SetFinalizer(bd, (*directIOBlockDevice).Close)
+		return bd, nil
+	}, nil
+}
+
+// newAlignedBuffer allocates a buffer whose address is aligned to the
+// sector size, making it suitable for direct I/O.
+func (bd *directIOBlockDevice) newAlignedBuffer(sizeBytes int) []byte {
+	b := make([]byte, sizeBytes+bd.sectorSizeBytes)
+	misalignment := int(uintptr(unsafe.Pointer(&b[0])) % uintptr(bd.sectorSizeBytes))
+	return b[(bd.sectorSizeBytes-misalignment)%bd.sectorSizeBytes:][:sizeBytes]
+}
+
+// getAlignedBuffer returns a buffer of a given size whose address is
+// aligned to the sector size. The buffer is obtained from the pool if
+// possible. It must be returned by calling putAlignedBuffer().
+func (bd *directIOBlockDevice) getAlignedBuffer(sizeBytes int) *[]byte {
+	if sizeBytes <= maximumPooledBufferSizeBytes {
+		if b, ok := bd.alignedBufferPool.Get().(*[]byte); ok && cap(*b) >= sizeBytes {
+			*b = (*b)[:sizeBytes]
+			return b
+		}
+	}
+	b := bd.newAlignedBuffer(sizeBytes)
+	return &b
+}
+
+// putAlignedBuffer returns a buffer obtained through getAlignedBuffer()
+// to the pool.
+func (bd *directIOBlockDevice) putAlignedBuffer(b *[]byte) {
+	if cap(*b) <= maximumPooledBufferSizeBytes {
+		bd.alignedBufferPool.Put(b)
+	}
+}
+
+// isAligned returns true if a buffer can be used for direct I/O at a
+// given offset without making use of an intermediate buffer.
+func (bd *directIOBlockDevice) isAligned(p []byte, off int64) bool {
+	sectorSizeBytes := bd.sectorSizeBytes
+	return off%int64(sectorSizeBytes) == 0 &&
+		len(p)%sectorSizeBytes == 0 &&
+		(len(p) == 0 || uintptr(unsafe.Pointer(&p[0]))%uintptr(sectorSizeBytes) == 0)
+}
+
+// getAlignedRange returns the smallest range of sectors that contains
+// a given range of data.
+func (bd *directIOBlockDevice) getAlignedRange(off int64, sizeBytes int) (int64, int64) {
+	sectorSizeBytes := int64(bd.sectorSizeBytes)
+	start := off - off%sectorSizeBytes
+	end := off + int64(sizeBytes)
+	if remainder := end % sectorSizeBytes; remainder != 0 {
+		end += sectorSizeBytes - remainder
+	}
+	return start, end
+}
+
+func (bd *directIOBlockDevice) readFull(p []byte, off int64) error {
+	for len(p) > 0 {
+		n, err := bd.pread(bd.fd, p, off)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return io.ErrUnexpectedEOF
+		}
+		p = p[n:]
+		off += int64(n)
+	}
+	return nil
+}
+
+func (bd *directIOBlockDevice) writeFull(p []byte, off int64) (int, error) {
+	// Similar to memoryMappedBlockDevice, pwrite() may need to be
+	// invoked repeatedly to handle short writes.
+	nTotal := 0
+	for len(p) > 0 {
+		n, err := bd.pwrite(bd.fd, p, off)
+		nTotal += n
+		if err != nil {
+			return nTotal, err
+		}
+		p = p[n:]
+		off += int64(n)
+	}
+	return nTotal, nil
+}
+
+func (bd *directIOBlockDevice) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, syscall.EINVAL
+	}
+	if off > bd.sizeBytes {
+		return 0, io.EOF
+	}
+	var errEOF error
+	if remaining := bd.sizeBytes - off; int64(len(p)) > remaining {
+		p = p[:remaining]
+		errEOF = io.EOF
+	}
+
+	if bd.isAligned(p, off) {
+		if err := bd.readFull(p, off); err != nil {
+			return 0, err
+		}
+		return len(p), errEOF
+	}
+
+	// Read all sectors containing the requested data into an
+	// intermediate buffer.
+	start, end := bd.getAlignedRange(off, len(p))
+	bp := bd.getAlignedBuffer(int(end - start))
+	defer bd.putAlignedBuffer(bp)
+	b := *bp
+	if err := bd.readFull(b, start); err != nil {
+		return 0, err
+	}
+	return copy(p, b[off-start:]), errEOF
+}
+
+func (bd *directIOBlockDevice) WriteAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, syscall.EINVAL
+	}
+	if len(p) == 0 || bd.isAligned(p, off) {
+		return bd.writeFull(p, off)
+	}
+
+	start, end := bd.getAlignedRange(off, len(p))
+	bp := bd.getAlignedBuffer(int(end - start))
+	defer bd.putAlignedBuffer(bp)
+	b := *bp
+	sectorSizeBytes := int64(bd.sectorSizeBytes)
+	headIsPartial := off != start
+	tailIsPartial := off+int64(len(p)) != end
+	if headIsPartial || tailIsPartial {
+		// Load the existing contents of sectors that are only
+		// overwritten partially.
+		bd.unalignedWriteLock.Lock()
+		defer bd.unalignedWriteLock.Unlock()
+
+		if headIsPartial {
+			if err := bd.readFull(b[:sectorSizeBytes], start); err != nil {
+				return 0, err
+			}
+		}
+		if tailIsPartial && (!headIsPartial || end-start > sectorSizeBytes) {
+			if err := bd.readFull(b[end-start-sectorSizeBytes:], end-sectorSizeBytes); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	copy(b[off-start:], p)
+	n, err := bd.writeFull(b, start)
+	// Only report the number of bytes written that were provided
+	// by the caller.
+	return min(max(n-int(off-start), 0), len(p)), err
+}
+
+func (bd *directIOBlockDevice) Sync() error {
+	return unix.Fsync(bd.fd)
+}
+
+func (bd *directIOBlockDevice) Close() error {
+	runtime.SetFinalizer(bd, nil)
+	if bd.ring != nil {
+		if err := bd.ring.close(); err != nil {
+			return util.StatusWrap(err, "Failed to close io_uring")
+		}
+	}
+	return unix.Close(bd.fd)
+}
@@ -0,0 +1,98 @@
+package blockdevice_test
+
+import (
+	"bytes"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/blockdevice"
+	pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/blockdevice"
+	"github.com/stretchr/testify/require"
+)
+
+func testDirectIOBlockDevice(t *testing.T, directIO *pb.DirectIOConfiguration) {
+	blockDevicePath := filepath.Join(t.TempDir(), "blockdevice")
+	blockDevice, sectorSizeBytes, sectorCount, err := blockdevice.NewBlockDeviceFromConfiguration(&pb.Configuration{
+		Source: &pb.Configuration_File{
+			File: &pb.FileConfiguration{
+				Path:      blockDevicePath,
+				SizeBytes: 123456,
+			},
+		},
+		DirectIo: directIO,
+	}, true)
+	require.NoError(t, err)
+	sizeBytes := int64(sectorSizeBytes) * sectorCount
+
+	// Writes that are not aligned to sector boundaries should not
+	// affect any surrounding data, including data stored in the
+	// same sectors.
+	n, err := blockDevice.WriteAt([]byte("Hello"), int64(sectorSizeBytes)-2)
+	require.Equal(t, 5, n)
+	require.NoError(t, err)
+	n, err = blockDevice.WriteAt([]byte("World"), int64(sectorSizeBytes)+3)
+	require.Equal(t, 5, n)
+	require.NoError(t, err)
+
+	var b [16]byte
+	n, err = blockDevice.ReadAt(b[:], int64(sectorSizeBytes)-4)
+	require.Equal(t, 16, n)
+	require.NoError(t, err)
+	require.Equal(t, []byte("\x00\x00HelloWorld\x00\x00\x00\x00"), b[:])
+
+	// Intermediate buffers are reused. Data from previous
+	// operations should not leak into subsequent reads.
+	n, err = blockDevice.ReadAt(b[:3], int64(sectorSizeBytes)+3)
+	require.Equal(t, 3, n)
+	require.NoError(t, err)
+	require.Equal(t, []byte("Wor"), b[:3])
+
+	// Write and read entire sectors, using buffers that are not
+	// aligned in memory.
+	sectors := bytes.Repeat([]byte("Sector!!"), sectorSizeBytes/4)
+	unalignedBuffer := make([]byte, len(sectors)+1)
+	copy(unalignedBuffer[1:], sectors)
+	n, err = blockDevice.WriteAt(unalignedBuffer[1:], 2*int64(sectorSizeBytes))
+	require.Equal(t, len(sectors), n)
+	require.NoError(t, err)
+
+	n, err = blockDevice.ReadAt(unalignedBuffer[1:], 2*int64(sectorSizeBytes))
+	require.Equal(t, len(sectors), n)
+	require.NoError(t, err)
+	require.Equal(t, sectors, unalignedBuffer[1:])
+
+	// Reads at the end of the block device should be truncated.
+	n, err = blockDevice.ReadAt(b[:], sizeBytes-4)
+	require.Equal(t, 4, n)
+	require.Equal(t, io.EOF, err)
+
+	require.NoError(t, blockDevice.Sync())
+
+	// Closing the block device should release its resources.
+	// Subsequent operations should fail.
+	require.NoError(t, blockDevice.(io.Closer).Close())
+	_, err = blockDevice.ReadAt(b[:], 0)
+	require.Error(t, err)
+
+	// Data should be persisted, so that it can be read back when
+	// opening the file through a memory map.
+	blockDevice, _, _, err = blockdevice.NewBlockDeviceFromFile(blockDevicePath, 123456, false)
+	require.NoError(t, err)
+	n, err = blockDevice.ReadAt(b[:], int64(sectorSizeBytes)-4)
+	require.Equal(t, 16, n)
+	require.NoError(t, err)
+	require.Equal(t, []byte("\x00\x00HelloWorld\x00\x00\x00\x00"), b[:])
+}
+
+func TestDirectIOBlockDevice(t *testing.T) {
+	t.Run("PreadPwrite", func(t *testing.T) {
+		testDirectIOBlockDevice(t, &pb.DirectIOConfiguration{})
+	})
+
+	t.Run("IOURing", func(t *testing.T) {
+		testDirectIOBlockDevice(t, &pb.DirectIOConfiguration{
+			IoUringEntries: 8,
+		})
+	})
+}
@@ -0,0 +1,392 @@
+//go:build linux
+// +build linux
+
+package blockdevice
+
+import (
+	"math"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"unsafe"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Constants and data structures declared in <linux/io_uring.h>.
+const (
+	ioURingOffSQRing = 0
+	ioURingOffCQRing = 0x8000000
+	ioURingOffSQEs   = 0x10000000
+
+	ioURingEnterGetEvents = 1
+
+	ioURingOpNop   = 0
+	ioURingOpRead  = 22
+	ioURingOpWrite = 23
+)
+
+// ioURingShutdownUserData is the user data of the no-op operation that
+// is submitted to instruct the goroutine reaping completions to
+// terminate.
+const ioURingShutdownUserData = math.MaxUint64
+
+type ioURingSQRingOffsets struct {
+	head        uint32
+	tail        uint32
+	ringMask    uint32
+	ringEntries uint32
+	flags       uint32
+	dropped     uint32
+	array       uint32
+	resv1       uint32
+	userAddr    uint64
+}
+
+type ioURingCQRingOffsets struct {
+	head        uint32
+	tail        uint32
+	ringMask    uint32
+	ringEntries uint32
+	overflow    uint32
+	cqes        uint32
+	flags       uint32
+	resv1       uint32
+	userAddr    uint64
+}
+
+type ioURingParams struct {
+	sqEntries    uint32
+	cqEntries    uint32
+	flags        uint32
+	sqThreadCPU  uint32
+	sqThreadIdle uint32
+	features     uint32
+	wqFD         uint32
+	resv         [3]uint32
+	sqOff        ioURingSQRingOffsets
+	cqOff        ioURingCQRingOffsets
+}
+
+type ioURingSQE struct {
+	opcode      uint8
+	flags       uint8
+	ioprio      uint16
+	fd          int32
+	off         uint64
+	addr        uint64
+	len         uint32
+	rwFlags     uint32
+	userData    uint64
+	bufIndex    uint16
+	personality uint16
+	spliceFDIn  int32
+	addr3       uint64
+	pad2        uint64
+}
+
+type ioURingCQE struct {
+	userData uint64
+	res      int32
+	flags    uint32
+}
+
+// ioURingCompletion is the outcome of an operation submitted to an
+// io_uring, as passed from the goroutine reaping completions to the
+// goroutine that submitted the operation.
+type ioURingCompletion struct {
+	res int32
+	err error
+}
+
+// ioURing is a minimal implementation of a client for Linux's io_uring
+// interface. It provides replacements for pread() and pwrite() that
+// may be called concurrently. Operations are placed in the submission
+// queue of a single ring, and completions are reaped by a dedicated
+// goroutine, allowing the kernel to process many operations in
+// parallel.
+//
+// If interacting with the ring fails, all future operations fail with
+// the same error. Operations that are already pending continue to wait
+// for their completions, as the kernel may still access their buffers.
+// They only fail once the ring is closed.
+type ioURing struct {
+	fd       int
+	mappings [][]byte
+	reaped   chan struct{}
+
+	sqHead  *uint32
+	sqTail  *uint32
+	sqMask  uint32
+	sqArray []uint32
+	sqes    []ioURingSQE
+
+	cqHead *uint32
+	cqTail *uint32
+	cqMask uint32
+	cqes   []ioURingCQE
+
+	lock          sync.Mutex
+	slotAvailable sync.Cond
+	inFlight      int
+	maxInFlight   int
+	nextUserData  uint64
+	completions   map[uint64]chan<- ioURingCompletion
+	err           error
+}
+
+func newIOURing(entries int) (*ioURing, error) {
+	var params ioURingParams
+	fd, _, errno := unix.Syscall(unix.SYS_IO_URING_SETUP, uintptr(entries), uintptr(unsafe.Pointer(&params)), 0)
+	if errno != 0 {
+		return nil, util.StatusWrap(errno, "Failed to set up ring")
+	}
+
+	// Map the submission queue, completion queue and submission
+	// queue entries into memory. These mappings are retained until
+	// the ring is closed.
+	sqRing, err := unix.Mmap(int(fd), ioURingOffSQRing, int(params.sqOff.array+params.sqEntries*4), unix.PROT_READ|unix.PROT_WRITE, unix.MAP_SHARED|unix.MAP_POPULATE)
+	if err != nil {
+		unix.Close(int(fd))
+		return nil, util.StatusWrap(err, "Failed to memory map submission queue")
+	}
+	cqRing, err := unix.Mmap(int(fd), ioURingOffCQRing, int(params.cqOff.cqes+params.cqEntries*uint32(unsafe.Sizeof(ioURingCQE{}))), unix.PROT_READ|unix.PROT_WRITE, unix.MAP_SHARED|unix.MAP_POPULATE)
+	if err != nil {
+		unix.Munmap(sqRing)
+		unix.Close(int(fd))
+		return nil, util.StatusWrap(err, "Failed to memory map completion queue")
+	}
+	sqes, err := unix.Mmap(int(fd), ioURingOffSQEs, int(params.sqEntries*uint32(unsafe.Sizeof(ioURingSQE{}))), unix.PROT_READ|unix.PROT_WRITE, unix.MAP_SHARED|unix.MAP_POPULATE)
+	if err != nil {
+		unix.Munmap(cqRing)
+		unix.Munmap(sqRing)
+		unix.Close(int(fd))
+		return nil, util.StatusWrap(err, "Failed to memory map submission queue entries")
+	}
+
+	r := &ioURing{
+		fd:       int(fd),
+		mappings: [][]byte{sqRing, cqRing, sqes},
+		reaped:   make(chan struct{}),
+
+		sqHead:  (*uint32)(unsafe.Pointer(&sqRing[params.sqOff.head])),
+		sqTail:  (*uint32)(unsafe.Pointer(&sqRing[params.sqOff.tail])),
+		sqMask:  *(*uint32)(unsafe.Pointer(&sqRing[params.sqOff.ringMask])),
+		sqArray: unsafe.Slice((*uint32)(unsafe.Pointer(&sqRing[params.sqOff.array])), params.sqEntries),
+		sqes:    unsafe.Slice((*ioURingSQE)(unsafe.Pointer(&sqes[0])), params.sqEntries),
+
+		cqHead: (*uint32)(unsafe.Pointer(&cqRing[params.cqOff.head])),
+		cqTail: (*uint32)(unsafe.Pointer(&cqRing[params.cqOff.tail])),
+		cqMask: *(*uint32)(unsafe.Pointer(&cqRing[params.cqOff.ringMask])),
+		cqes:   unsafe.Slice((*ioURingCQE)(unsafe.Pointer(&cqRing[params.cqOff.cqes])), params.cqEntries),
+
+		// Limit the number of operations in flight, so that
+		// neither the submission queue nor the completion
+		// queue can overflow.
+		maxInFlight: int(min(params.sqEntries, params.cqEntries)),
+		completions: map[uint64]chan<- ioURingCompletion{},
+	}
+	r.slotAvailable.L = &r.lock
+	go r.reapCompletions()
+	return r, nil
+}
+
+// enqueue an operation in the submission queue, and submit it to the
+// kernel. This function must be called while holding the lock.
+func (r *ioURing) enqueue(sqe ioURingSQE) error {
+	tail := atomic.LoadUint32(r.sqTail)
+	index := tail & r.sqMask
+	r.sqes[index] = sqe
+	r.sqArray[index] = index
+	atomic.StoreUint32(r.sqTail, tail+1)
+
+	// Submit all entries that have not been consumed by the
+	// kernel yet. As every call to enqueue() submits its own
+	// entry, this is at most the entry that was just enqueued.
+	for {
+		toSubmit := atomic.LoadUint32(r.sqTail) - atomic.LoadUint32(r.sqHead)
+		if toSubmit == 0 {
+			return nil
+		}
+		if _, _, errno := unix.Syscall6(unix.SYS_IO_URING_ENTER, uintptr(r.fd), uintptr(toSubmit), 0, 0, 0, 0); errno != 0 && errno != syscall.EINTR && errno != syscall.EAGAIN && errno != syscall.EBUSY {
+			if atomic.LoadUint32(r.sqHead) == tail {
+				// The entry was not consumed by the
+				// kernel. Retract it.
+				atomic.StoreUint32(r.sqTail, tail)
+				return util.StatusWrapWithCode(errno, codes.Internal, "Failed to submit io_uring entry")
+			}
+			return nil
+		}
+	}
+}
+
+// submit an operation to the kernel, and wait for it to complete.
+func (r *ioURing) submit(opcode uint8, fd int, p []byte, offset int64) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	completion := make(chan ioURingCompletion, 1)
+
+	// The kernel accesses the buffer until the operation completes,
+	// meaning it may not be moved or garbage collected.
+	var pinner runtime.Pinner
+	pinner.Pin(&p[0])
+	defer pinner.Unpin()
+
+	r.lock.Lock()
+	for r.err == nil && r.inFlight >= r.maxInFlight {
+		r.slotAvailable.Wait()
+	}
+	if r.err != nil {
+		err := r.err
+		r.lock.Unlock()
+		return 0, err
+	}
+	userData := r.nextUserData
+	if err := r.enqueue(ioURingSQE{
+		opcode:   opcode,
+		fd:       int32(fd),
+		off:      uint64(offset),
+		addr:     uint64(uintptr(unsafe.Pointer(&p[0]))),
+		len:      uint32(len(p)),
+		userData: userData,
+	}); err != nil {
+		r.lock.Unlock()
+		return 0, err
+	}
+	r.inFlight++
+	r.nextUserData++
+	r.completions[userData] = completion
+	r.lock.Unlock()
+
+	c := <-completion
+	if c.err != nil {
+		return 0, c.err
+	}
+	if c.res < 0 {
+		return 0, syscall.Errno(-c.res)
+	}
+	return int(c.res), nil
+}
+
+// fail all future operations with a given error. Operations that are
+// pending are left untouched, as their buffers may not be released
+// until the kernel has posted their completions. This function must
+// be called while holding the lock.
+func (r *ioURing) fail(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+	r.slotAvailable.Broadcast()
+}
+
+// processCompletions consumes all entries that are currently present
+// in the completion queue, and wakes up the goroutines that submitted
+// the corresponding operations. It returns true if the completion of
+// the operation submitted by close() was observed. This function must
+// be called while holding the lock.
+func (r *ioURing) processCompletions() bool {
+	head := atomic.LoadUint32(r.cqHead)
+	tail := atomic.LoadUint32(r.cqTail)
+	shutdown := false
+	for ; head != tail; head++ {
+		cqe := r.cqes[head&r.cqMask]
+		if cqe.userData == ioURingShutdownUserData {
+			shutdown = true
+		} else if completion, ok := r.completions[cqe.userData]; ok {
+			completion <- ioURingCompletion{res: cqe.res}
+			delete(r.completions, cqe.userData)
+			r.inFlight--
+		}
+	}
+	atomic.StoreUint32(r.cqHead, head)
+	r.slotAvailable.Broadcast()
+	return shutdown
+}
+
+// reapCompletions is run in a separate goroutine to wait for
+// operations to complete, and to wake up the goroutines that submitted
+// them. It terminates when the ring is closed, or when waiting for
+// completions fails.
+func (r *ioURing) reapCompletions() {
+	defer close(r.reaped)
+	for {
+		if _, _, errno := unix.Syscall6(unix.SYS_IO_URING_ENTER, uintptr(r.fd), 0, 1, ioURingEnterGetEvents, 0, 0); errno != 0 && errno != syscall.EINTR {
+			r.lock.Lock()
+			r.fail(util.StatusWrapWithCode(errno, codes.Internal, "Failed to wait for io_uring completions"))
+			r.lock.Unlock()
+			return
+		}
+
+		if atomic.LoadUint32(r.cqHead) == atomic.LoadUint32(r.cqTail) {
+			continue
+		}
+		r.lock.Lock()
+		shutdown := r.processCompletions()
+		r.lock.Unlock()
+		if shutdown {
+			return
+		}
+	}
+}
+
+// close the ring, releasing its memory mappings and file descriptor.
+// Operations that are still in flight are waited for. Operations
+// submitted afterwards fail.
+//
+// If the goroutine reaping completions terminated due to a failure,
+// operations may still be pending. Completions that have been posted
+// by the kernel are processed, after which the ring's file descriptor
+// is closed, causing the kernel to cancel any remaining operations.
+// Only then are the goroutines that submitted them woken up.
+func (r *ioURing) close() error {
+	r.lock.Lock()
+	for r.err == nil && r.inFlight > 0 {
+		r.slotAvailable.Wait()
+	}
+	if r.err == nil {
+		// Instruct the goroutine reaping completions to
+		// terminate. If this fails, the memory mappings cannot
+		// be released safely, as the goroutine may still
+		// access them.
+		if err := r.enqueue(ioURingSQE{
+			opcode:   ioURingOpNop,
+			userData: ioURingShutdownUserData,
+		}); err != nil {
+			r.fail(err)
+			r.lock.Unlock()
+			return err
+		}
+		r.fail(status.Error(codes.Unavailable, "io_uring has been closed"))
+	}
+	r.lock.Unlock()
+	<-r.reaped
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.processCompletions()
+	err := unix.Close(r.fd)
+	for userData, completion := range r.completions {
+		completion <- ioURingCompletion{err: r.err}
+		delete(r.completions, userData)
+	}
+	r.inFlight = 0
+
+	for _, mapping := range r.mappings {
+		unix.Munmap(mapping)
+	}
+	return err
+}
+
+func (r *ioURing) pread(fd int, p []byte, offset int64) (int, error) {
+	return r.submit(ioURingOpRead, fd, p, offset)
+}
+
+func (r *ioURing) pwrite(fd int, p []byte, offset int64) (int, error) {
+	return r.submit(ioURingOpWrite, fd, p, offset)
+}
@@ -12,5 +12,9 @@ import (
 // into the address space of the current process. This implementation is
 // a stub for operating systems that don't support block device access.
 func NewBlockDeviceFromDevice(path string) (BlockDevice, int, int64, error) {
+	return newBlockDeviceFromDevice(path, false, nil)
+}
+
+func newBlockDeviceFromDevice(path string, readOnly bool, newBlockDevice blockDeviceFactory) (BlockDevice, int, int64, error) {
 	return nil, 0, 0, status.Error(codes.Unimplemented, "Memory mapping block devices is not supported on this platform")
 }
@@ -22,7 +22,15 @@ import (
 // Writes may only occur at sector boundaries, as unaligned writes would
 // cause unnecessary read operations against underlying storage.
 func NewBlockDeviceFromDevice(path string) (BlockDevice, int, int64, error) {
-	fd, err := unix.Open(path, unix.O_RDWR, 0)
+	return newBlockDeviceFromDevice(path, false, nil)
+}
+
+func newBlockDeviceFromDevice(path string, readOnly bool, newBlockDevice blockDeviceFactory) (BlockDevice, int, int64, error) {
+	flags := unix.O_RDWR
+	if readOnly {
+		flags = unix.O_RDONLY
+	}
+	fd, err := unix.Open(path, flags, 0)
 	if err != nil {
 		return nil, 0, 0, util.StatusWrapf(err, "Failed to open device node %#v", path)
 	}
@@ -39,7 +47,10 @@ func NewBlockDeviceFromDevice(path string) (BlockDevice, int, int64, error) {
 		return nil, 0, 0, util.StatusWrapf(err, "Failed to obtain size of device node %#v", path)
 	}
 
-	bd, err := newMemoryMappedBlockDevice(fd, int(deviceSizeBytes))
+	if newBlockDevice == nil {
+		newBlockDevice = newMemoryMappedBlockDevice
+	}
+	bd, err := newBlockDevice(fd, int(deviceSizeBytes), int(sectorSizeBytes))
 	if err != nil {
 		unix.Close(fd)
 		return nil, 0, 0, err
@@ -12,5 +12,9 @@ import (
 // regular file stored in a file system. This implementation is a stub
 // for operating systems that don't support block device access.
 func NewBlockDeviceFromFile(path string, minimumSizeBytes int, zeroInitialize bool) (BlockDevice, int, int64, error) {
+	return newBlockDeviceFromFile(path, minimumSizeBytes, zeroInitialize, false, nil)
+}
+
+func newBlockDeviceFromFile(path string, minimumSizeBytes int, zeroInitialize, readOnly bool, newBlockDevice blockDeviceFactory) (BlockDevice, int, int64, error) {
 	return nil, 0, 0, status.Error(codes.Unimplemented, "Memory mapping block devices is not supported on this platform")
 }
@@ -7,6 +7,8 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/util"
 
 	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // NewBlockDeviceFromFile creates a BlockDevice that is backed by a
@@ -17,8 +19,14 @@ import (
 // environments where spare disks (or the privileges needed to access
 // those) aren't readily available.
 func NewBlockDeviceFromFile(path string, minimumSizeBytes int, zeroInitialize bool) (BlockDevice, int, int64, error) {
+	return newBlockDeviceFromFile(path, minimumSizeBytes, zeroInitialize, false, nil)
+}
+
+func newBlockDeviceFromFile(path string, minimumSizeBytes int, zeroInitialize, readOnly bool, newBlockDevice blockDeviceFactory) (BlockDevice, int, int64, error) {
 	flags := unix.O_CREAT | unix.O_RDWR
-	if zeroInitialize {
+	if readOnly {
+		flags = unix.O_RDONLY
+	} else if zeroInitialize {
 		flags |= unix.O_TRUNC
 	}
 	fd, err := unix.Open(path, flags, 0o666)
@@ -37,11 +45,19 @@ func NewBlockDeviceFromFile(path string, minimumSizeBytes int, zeroInitialize bo
 	sectorCount := int64((uint64(minimumSizeBytes) + uint64(stat.Blksize) - 1) / uint64(stat.Blksize))
 	sizeBytes := int64(sectorSizeBytes) * sectorCount
 
-	if err := unix.Ftruncate(fd, sizeBytes); err != nil {
+	if readOnly {
+		if stat.Size < sizeBytes {
+			unix.Close(fd)
+			return nil, 0, 0, status.Errorf(codes.InvalidArgument, "File %#v is %d bytes in size, while at least %d bytes were expected", path, stat.Size, sizeBytes)
+		}
+	} else if err := unix.Ftruncate(fd, sizeBytes); err != nil {
 		return nil, 0, 0, util.StatusWrapf(err, "Failed to truncate file %#v to %d bytes", path, sizeBytes)
 	}
 
-	bd, err := newMemoryMappedBlockDevice(fd, int(sizeBytes))
+	if newBlockDevice == nil {
+		newBlockDevice = newMemoryMappedBlockDevice
+	}
+	bd, err := newBlockDevice(fd, int(sizeBytes), sectorSizeBytes)
 	if err != nil {
 		unix.Close(fd)
 		return nil, 0, 0, err
@@ -0,0 +1,107 @@
+package blockdevice
+
+import (
+	"io"
+	"syscall"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+)
+
+type stripedBlockDevice struct {
+	devices         []BlockDevice
+	stripeSizeBytes int64
+	sizeBytes       int64
+}
+
+// NewStripedBlockDevice creates a BlockDevice that combines multiple
+// BlockDevices into a single logical BlockDevice. Data is split up into
+// stripes of a fixed size, which are stored on the underlying
+// BlockDevices in a round-robin fashion. This makes it possible to
+// spread load across multiple storage media.
+//
+// Every underlying BlockDevice is expected to be at least sizeBytes /
+// len(devices) bytes in size. sizeBytes must be a multiple of
+// stripeSizeBytes * len(devices).
+func NewStripedBlockDevice(devices []BlockDevice, stripeSizeBytes, sizeBytes int64) BlockDevice {
+	return &stripedBlockDevice{
+		devices:         devices,
+		stripeSizeBytes: stripeSizeBytes,
+		sizeBytes:       sizeBytes,
+	}
+}
+
+// forEachStripe splits up a read or write operation into a sequence of
+// operations against individual stripes, and calls into the underlying
+// BlockDevices to perform them.
+func (bd *stripedBlockDevice) forEachStripe(p []byte, off int64, operation func(device BlockDevice, p []byte, off int64) (int, error)) (int, error) {
+	nTotal := 0
+	devicesCount := int64(len(bd.devices))
+	for len(p) > 0 {
+		stripe := off / bd.stripeSizeBytes
+		offsetWithinStripe := off % bd.stripeSizeBytes
+		chunk := p[:min(int64(len(p)), bd.stripeSizeBytes-offsetWithinStripe)]
+		n, err := operation(
+			bd.devices[stripe%devicesCount],
+			chunk,
+			stripe/devicesCount*bd.stripeSizeBytes+offsetWithinStripe)
+		nTotal += n
+		if err != nil && (err != io.EOF || n < len(chunk)) {
+			return nTotal, err
+		}
+		p = p[len(chunk):]
+		off += int64(len(chunk))
+	}
+	return nTotal, nil
+}
+
+func (bd *stripedBlockDevice) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, syscall.EINVAL
+	}
+	if off > bd.sizeBytes {
+		return 0, io.EOF
+	}
+	var errEOF error
+	if remaining := bd.sizeBytes - off; int64(len(p)) > remaining {
+		p = p[:remaining]
+		errEOF = io.EOF
+	}
+	n, err := bd.forEachStripe(p, off, func(device BlockDevice, p []byte, off int64) (int, error) {
+		return device.ReadAt(p, off)
+	})
+	if err != nil {
+		return n, err
+	}
+	return n, errEOF
+}
+
+func (bd *stripedBlockDevice) WriteAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, syscall.EINVAL
+	}
+	if off+int64(len(p)) > bd.sizeBytes {
+		// Don't permit writes to extend beyond the end of the
+		// logical block device, as they would end up in the
+		// wrong place.
+		if off >= bd.sizeBytes {
+			return 0, io.ErrShortWrite
+		}
+		n, err := bd.WriteAt(p[:bd.sizeBytes-off], off)
+		if err != nil {
+			return n, err
+		}
+		return n, io.ErrShortWrite
+	}
+	return bd.forEachStripe(p, off, func(device BlockDevice, p []byte, off int64) (int, error) {
+		return device.WriteAt(p, off)
+	})
+}
+
+func (bd *stripedBlockDevice) Sync() error {
+	for i, device := range bd.devices {
+		if err := device.Sync(); err != nil {
+			return util.StatusWrapf(err, "Device %d", i)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package blockdevice_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blockdevice"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestStripedBlockDevice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	device0 := mock.NewMockBlockDevice(ctrl)
+	device1 := mock.NewMockBlockDevice(ctrl)
+	blockDevice := blockdevice.NewStripedBlockDevice([]blockdevice.BlockDevice{device0, device1}, 4, 16)
+
+	t.Run("ReadWithinStripe", func(t *testing.T) {
+		device1.EXPECT().ReadAt(gomock.Len(2), int64(5)).DoAndReturn(func(p []byte, off int64) (int, error) {
+			return copy(p, "He"), nil
+		})
+
+		var b [2]byte
+		n, err := blockDevice.ReadAt(b[:], 13)
+		require.Equal(t, 2, n)
+		require.NoError(t, err)
+		require.Equal(t, []byte("He"), b[:])
+	})
+
+	t.Run("ReadAcrossStripes", func(t *testing.T) {
+		// Reads spanning multiple stripes should be split up.
+		// Stripes are assigned to devices in a round-robin
+		// fashion.
+		gomock.InOrder(
+			device0.EXPECT().ReadAt(gomock.Len(2), int64(2)).DoAndReturn(func(p []byte, off int64) (int, error) {
+				return copy(p, "He"), nil
+			}),
+			device1.EXPECT().ReadAt(gomock.Len(4), int64(0)).DoAndReturn(func(p []byte, off int64) (int, error) {
+				return copy(p, "llo "), nil
+			}),
+			device0.EXPECT().ReadAt(gomock.Len(4), int64(4)).DoAndReturn(func(p []byte, off int64) (int, error) {
+				// Reaching the end of the underlying
+				// device should not cause reads to fail.
+				return copy(p, "Worl"), io.EOF
+			}),
+			device1.EXPECT().ReadAt(gomock.Len(1), int64(4)).DoAndReturn(func(p []byte, off int64) (int, error) {
+				return copy(p, "d"), nil
+			}))
+
+		var b [11]byte
+		n, err := blockDevice.ReadAt(b[:], 2)
+		require.Equal(t, 11, n)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello World"), b[:])
+	})
+
+	t.Run("ReadBeyondEnd", func(t *testing.T) {
+		device1.EXPECT().ReadAt(gomock.Len(2), int64(6)).DoAndReturn(func(p []byte, off int64) (int, error) {
+			return copy(p, "!!"), nil
+		})
+
+		var b [4]byte
+		n, err := blockDevice.ReadAt(b[:], 14)
+		require.Equal(t, 2, n)
+		require.Equal(t, io.EOF, err)
+		require.Equal(t, []byte("!!"), b[:2])
+	})
+
+	t.Run("WriteFailure", func(t *testing.T) {
+		gomock.InOrder(
+			device0.EXPECT().WriteAt([]byte("Hel"), int64(1)).Return(3, nil),
+			device1.EXPECT().WriteAt([]byte("lo"), int64(0)).Return(1, status.Error(codes.Internal, "Disk on fire")))
+
+		n, err := blockDevice.WriteAt([]byte("Hello"), 1)
+		require.Equal(t, 4, n)
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Disk on fire"), err)
+	})
+
+	t.Run("WriteBeyondEnd", func(t *testing.T) {
+		device1.EXPECT().WriteAt([]byte("He"), int64(6)).Return(2, nil)
+
+		n, err := blockDevice.WriteAt([]byte("Hello"), 14)
+		require.Equal(t, 2, n)
+		require.Equal(t, io.ErrShortWrite, err)
+	})
+
+	t.Run("Sync", func(t *testing.T) {
+		device0.EXPECT().Sync()
+		device1.EXPECT().Sync().Return(status.Error(codes.Internal, "Disk on fire"))
+
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Device 1: Disk on fire"), blockDevice.Sync())
+	})
+}
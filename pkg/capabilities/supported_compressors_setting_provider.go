@@ -0,0 +1,42 @@
+package capabilities
+
+import (
+	"context"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type supportedCompressorsSettingProvider struct {
+	base        Provider
+	compressors []remoteexecution.Compressor_Value
+}
+
+// NewSupportedCompressorsSettingProvider creates a decorator for a
+// capabilities provider that sets the
+// CacheCapabilities.supported_compressors field. This can be used to
+// announce to clients that the ByteStream service is capable of
+// transferring Content Addressable Storage objects in compressed form.
+func NewSupportedCompressorsSettingProvider(base Provider, compressors []remoteexecution.Compressor_Value) Provider {
+	return &supportedCompressorsSettingProvider{
+		base:        base,
+		compressors: compressors,
+	}
+}
+
+func (p *supportedCompressorsSettingProvider) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
+	serverCapabilities, err := p.base.GetCapabilities(ctx, instanceName)
+	if err != nil {
+		return nil, err
+	}
+	if serverCapabilities.CacheCapabilities == nil {
+		return serverCapabilities, nil
+	}
+
+	var copiedCapabilities remoteexecution.ServerCapabilities
+	proto.Merge(&copiedCapabilities, serverCapabilities)
+	copiedCapabilities.CacheCapabilities.SupportedCompressors = p.compressors
+	return &copiedCapabilities, nil
+}
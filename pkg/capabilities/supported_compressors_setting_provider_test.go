@@ -0,0 +1,74 @@
+package capabilities_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/capabilities"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestSupportedCompressorsSettingProvider(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseProvider := mock.NewMockCapabilitiesProvider(ctrl)
+	provider := capabilities.NewSupportedCompressorsSettingProvider(baseProvider, []remoteexecution.Compressor_Value{
+		remoteexecution.Compressor_ZSTD,
+		remoteexecution.Compressor_DEFLATE,
+	})
+	instanceName := digest.MustNewInstanceName("hello")
+
+	t.Run("BackendFailure", func(t *testing.T) {
+		baseProvider.EXPECT().GetCapabilities(ctx, instanceName).
+			Return(nil, status.Error(codes.Unavailable, "Server not reachable"))
+
+		_, err := provider.GetCapabilities(ctx, instanceName)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server not reachable"), err)
+	})
+
+	t.Run("NoCacheCapabilities", func(t *testing.T) {
+		// If the backend provides no cache capabilities, there
+		// is no Content Addressable Storage for which
+		// compression can be announced.
+		baseProvider.EXPECT().GetCapabilities(ctx, instanceName).
+			Return(&remoteexecution.ServerCapabilities{}, nil)
+
+		response, err := provider.GetCapabilities(ctx, instanceName)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &remoteexecution.ServerCapabilities{}, response)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		baseCapabilities := &remoteexecution.ServerCapabilities{
+			CacheCapabilities: &remoteexecution.CacheCapabilities{
+				DigestFunctions: digest.SupportedDigestFunctions,
+			},
+		}
+		baseProvider.EXPECT().GetCapabilities(ctx, instanceName).
+			Return(baseCapabilities, nil)
+
+		response, err := provider.GetCapabilities(ctx, instanceName)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &remoteexecution.ServerCapabilities{
+			CacheCapabilities: &remoteexecution.CacheCapabilities{
+				DigestFunctions: digest.SupportedDigestFunctions,
+				SupportedCompressors: []remoteexecution.Compressor_Value{
+					remoteexecution.Compressor_ZSTD,
+					remoteexecution.Compressor_DEFLATE,
+				},
+			},
+		}, response)
+
+		// The response of the backend should not be modified.
+		require.Empty(t, baseCapabilities.CacheCapabilities.SupportedCompressors)
+	})
+}
@@ -54,7 +54,10 @@ func (w wrappedStorageBucketHandle) Object(name string) StorageObjectHandle {
 // storage.ObjectHandle type that are used by this code base. This
 // interface has been added to permit unit testing.
 type StorageObjectHandle interface {
+	Attrs(ctx context.Context) (*storage.ObjectAttrs, error)
+	Delete(ctx context.Context) error
 	NewRangeReader(ctx context.Context, offset, length int64) (io.ReadCloser, error)
+	NewWriter(ctx context.Context, metadata map[string]string) io.WriteCloser
 }
 
 type wrappedStorageObjectHandle struct {
@@ -65,6 +68,23 @@ type wrappedStorageObjectHandle struct {
 // argument to request reading the object until the end.
 const ReadUntilEOF int64 = -1
 
+func (w wrappedStorageObjectHandle) Attrs(ctx context.Context) (*storage.ObjectAttrs, error) {
+	return w.impl.Attrs(ctx)
+}
+
+func (w wrappedStorageObjectHandle) Delete(ctx context.Context) error {
+	return w.impl.Delete(ctx)
+}
+
 func (w wrappedStorageObjectHandle) NewRangeReader(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
 	return w.impl.NewRangeReader(ctx, offset, length)
 }
+
+// NewWriter returns a writer for storing data in the object. Custom
+// metadata may be attached to the object. The upload is aborted if the
+// provided context is canceled before the writer is closed.
+func (w wrappedStorageObjectHandle) NewWriter(ctx context.Context, metadata map[string]string) io.WriteCloser {
+	writer := w.impl.NewWriter(ctx)
+	writer.Metadata = metadata
+	return writer
+}
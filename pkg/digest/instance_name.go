@@ -4,9 +4,11 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"io"
+	"strconv"
 	"strings"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/util"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -119,6 +121,43 @@ func (in InstanceName) NewDigestFromCompactBinary(r io.ByteReader) (Digest, erro
 	return digestFunction.NewDigest(hex.EncodeToString(hash), sizeBytes)
 }
 
+// NewDigestFromKey constructs a Digest object by parsing a key that was
+// generated using Digest.GetKey(). As keys generated using
+// KeyWithoutInstance do not contain an instance name, the instance name
+// on which this method is called is used instead.
+func (in InstanceName) NewDigestFromKey(key string, format KeyFormat) (Digest, error) {
+	fieldsCount := 3
+	if format == KeyWithInstance {
+		fieldsCount = 4
+	}
+	fields := strings.SplitN(key, "-", fieldsCount)
+	if len(fields) != fieldsCount {
+		return BadDigest, status.Error(codes.InvalidArgument, "Key has an invalid number of fields")
+	}
+
+	instanceName := in
+	if format == KeyWithInstance {
+		var err error
+		instanceName, err = NewInstanceName(fields[3])
+		if err != nil {
+			return BadDigest, util.StatusWrapf(err, "Invalid instance name %#v", fields[3])
+		}
+	}
+	digestFunctionEnum, err := strconv.ParseUint(fields[0], 10, 8)
+	if err != nil {
+		return BadDigest, status.Errorf(codes.InvalidArgument, "Invalid digest function %#v", fields[0])
+	}
+	digestFunction, err := instanceName.GetDigestFunction(remoteexecution.DigestFunction_Value(digestFunctionEnum), 0)
+	if err != nil {
+		return BadDigest, err
+	}
+	sizeBytes, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil {
+		return BadDigest, status.Errorf(codes.InvalidArgument, "Invalid size %#v", fields[2])
+	}
+	return digestFunction.NewDigest(fields[1], sizeBytes)
+}
+
 func (in InstanceName) String() string {
 	return in.value
 }
@@ -137,3 +137,29 @@ func TestInstanceNameNewDigestFromCompactBinary(t *testing.T) {
 		require.Equal(t, digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256TREE, "59d3f9914a2e320b1b36e1180fb681fee9c434a213c0afbd0393bac3f937d0c8", 124982395), blobDigest)
 	})
 }
+
+func TestInstanceNameNewDigestFromKey(t *testing.T) {
+	instanceName := digest.MustNewInstanceName("hello")
+	blobDigest := digest.MustNewDigest("world", remoteexecution.DigestFunction_SHA256, "18c17f53df2fcd1f8271bc1c0e55df71b1a796eaa74ff45a68900f04e3f4c7a2", 124982395)
+
+	t.Run("WithoutInstance", func(t *testing.T) {
+		// The instance name should be taken from the receiver.
+		parsedDigest, err := instanceName.NewDigestFromKey(blobDigest.GetKey(digest.KeyWithoutInstance), digest.KeyWithoutInstance)
+		require.NoError(t, err)
+		require.Equal(t, digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "18c17f53df2fcd1f8271bc1c0e55df71b1a796eaa74ff45a68900f04e3f4c7a2", 124982395), parsedDigest)
+	})
+
+	t.Run("WithInstance", func(t *testing.T) {
+		parsedDigest, err := instanceName.NewDigestFromKey(blobDigest.GetKey(digest.KeyWithInstance), digest.KeyWithInstance)
+		require.NoError(t, err)
+		require.Equal(t, blobDigest, parsedDigest)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := instanceName.NewDigestFromKey("1-18c17f53df2fcd1f8271bc1c0e55df71b1a796eaa74ff45a68900f04e3f4c7a2", digest.KeyWithoutInstance)
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Key has an invalid number of fields"), err)
+
+		_, err = instanceName.NewDigestFromKey("1-18c17f53df2fcd1f8271bc1c0e55df71b1a796eaa74ff45a68900f04e3f4c7a2-hello", digest.KeyWithoutInstance)
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Invalid size \"hello\""), err)
+	})
+}
@@ -0,0 +1,45 @@
+package filesystem
+
+import (
+	"io"
+	"os"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+)
+
+// WriteFileAtomically writes a file into a directory atomically, by
+// first writing its contents into a temporary file, followed by
+// renaming it. This ensures that the previous version of the file
+// remains intact if a crash occurs while writing.
+func WriteFileAtomically(directory Directory, name, temporaryName path.Component, writeContents func(w io.Writer) error) error {
+	if err := directory.Remove(temporaryName); err != nil && !os.IsNotExist(err) {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to remove previous temporary file")
+	}
+	f, err := directory.OpenAppend(temporaryName, CreateExcl(0o666))
+	if err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to create temporary file")
+	}
+	if err := writeContents(f); err != nil {
+		f.Close()
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to write to temporary file")
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to synchronize temporary file")
+	}
+	if err := f.Close(); err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to close temporary file")
+	}
+
+	// Move the new file over the old copy.
+	if err := directory.Rename(temporaryName, directory, name); err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to rename temporary file")
+	}
+	if err := directory.Sync(); err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to synchronize directory")
+	}
+	return nil
+}
@@ -142,6 +142,7 @@ type PersistentState struct {
 	OldestEpochId                    uint32        `protobuf:"varint,1,opt,name=oldest_epoch_id,json=oldestEpochId,proto3" json:"oldest_epoch_id,omitempty"`
 	Blocks                           []*BlockState `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`
 	KeyLocationMapHashInitialization uint64        `protobuf:"varint,3,opt,name=key_location_map_hash_initialization,json=keyLocationMapHashInitialization,proto3" json:"key_location_map_hash_initialization,omitempty"`
+	KeyLocationMapRecordsCount       int64         `protobuf:"varint,4,opt,name=key_location_map_records_count,json=keyLocationMapRecordsCount,proto3" json:"key_location_map_records_count,omitempty"`
 }
 
 func (x *PersistentState) Reset() {
@@ -195,6 +196,13 @@ func (x *PersistentState) GetKeyLocationMapHashInitialization() uint64 {
 	return 0
 }
 
+func (x *PersistentState) GetKeyLocationMapRecordsCount() int64 {
+	if x != nil {
+		return x.KeyLocationMapRecordsCount
+	}
+	return 0
+}
+
 var File_pkg_proto_blobstore_local_local_proto protoreflect.FileDescriptor
 
 var file_pkg_proto_blobstore_local_local_proto_rawDesc = []byte{
@@ -219,7 +227,7 @@ var file_pkg_proto_blobstore_local_local_proto_rawDesc = []byte{
 	0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
 	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d,
 	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08,
-	0x01, 0x10, 0x02, 0x22, 0xc8, 0x01, 0x0a, 0x0f, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x01, 0x10, 0x02, 0x22, 0x8c, 0x02, 0x0a, 0x0f, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65,
 	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x6c, 0x64, 0x65, 0x73,
 	0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
 	0x52, 0x0d, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12,
@@ -231,12 +239,16 @@ var file_pkg_proto_blobstore_local_local_proto_rawDesc = []byte{
 	0x61, 0x70, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69,
 	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x20, 0x6b, 0x65,
 	0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x48, 0x61, 0x73, 0x68,
-	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x3b,
-	0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69,
-	0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2f, 0x62, 0x62, 0x2d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6c, 0x6f, 0x62,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42,
+	0x0a, 0x1e, 0x6b, 0x65, 0x79, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x61, 0x70, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x6b, 0x65, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2f, 0x62, 0x62, 0x2d, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -0,0 +1,395 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v5.28.3
+// source: pkg/proto/cdc/cdc.proto
+
+package cdc
+
+import (
+	v2 "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SplitBlobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceName   string                  `protobuf:"bytes,1,opt,name=instance_name,json=instanceName,proto3" json:"instance_name,omitempty"`
+	BlobDigest     *v2.Digest              `protobuf:"bytes,2,opt,name=blob_digest,json=blobDigest,proto3" json:"blob_digest,omitempty"`
+	DigestFunction v2.DigestFunction_Value `protobuf:"varint,3,opt,name=digest_function,json=digestFunction,proto3,enum=build.bazel.remote.execution.v2.DigestFunction_Value" json:"digest_function,omitempty"`
+}
+
+func (x *SplitBlobRequest) Reset() {
+	*x = SplitBlobRequest{}
+	mi := &file_pkg_proto_cdc_cdc_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitBlobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitBlobRequest) ProtoMessage() {}
+
+func (x *SplitBlobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_cdc_cdc_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitBlobRequest.ProtoReflect.Descriptor instead.
+func (*SplitBlobRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_cdc_cdc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SplitBlobRequest) GetInstanceName() string {
+	if x != nil {
+		return x.InstanceName
+	}
+	return ""
+}
+
+func (x *SplitBlobRequest) GetBlobDigest() *v2.Digest {
+	if x != nil {
+		return x.BlobDigest
+	}
+	return nil
+}
+
+func (x *SplitBlobRequest) GetDigestFunction() v2.DigestFunction_Value {
+	if x != nil {
+		return x.DigestFunction
+	}
+	return v2.DigestFunction_Value(0)
+}
+
+type SplitBlobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChunkDigests   []*v2.Digest            `protobuf:"bytes,1,rep,name=chunk_digests,json=chunkDigests,proto3" json:"chunk_digests,omitempty"`
+	DigestFunction v2.DigestFunction_Value `protobuf:"varint,2,opt,name=digest_function,json=digestFunction,proto3,enum=build.bazel.remote.execution.v2.DigestFunction_Value" json:"digest_function,omitempty"`
+}
+
+func (x *SplitBlobResponse) Reset() {
+	*x = SplitBlobResponse{}
+	mi := &file_pkg_proto_cdc_cdc_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitBlobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitBlobResponse) ProtoMessage() {}
+
+func (x *SplitBlobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_cdc_cdc_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitBlobResponse.ProtoReflect.Descriptor instead.
+func (*SplitBlobResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_cdc_cdc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SplitBlobResponse) GetChunkDigests() []*v2.Digest {
+	if x != nil {
+		return x.ChunkDigests
+	}
+	return nil
+}
+
+func (x *SplitBlobResponse) GetDigestFunction() v2.DigestFunction_Value {
+	if x != nil {
+		return x.DigestFunction
+	}
+	return v2.DigestFunction_Value(0)
+}
+
+type SpliceBlobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceName   string                  `protobuf:"bytes,1,opt,name=instance_name,json=instanceName,proto3" json:"instance_name,omitempty"`
+	BlobDigest     *v2.Digest              `protobuf:"bytes,2,opt,name=blob_digest,json=blobDigest,proto3" json:"blob_digest,omitempty"`
+	ChunkDigests   []*v2.Digest            `protobuf:"bytes,3,rep,name=chunk_digests,json=chunkDigests,proto3" json:"chunk_digests,omitempty"`
+	DigestFunction v2.DigestFunction_Value `protobuf:"varint,4,opt,name=digest_function,json=digestFunction,proto3,enum=build.bazel.remote.execution.v2.DigestFunction_Value" json:"digest_function,omitempty"`
+}
+
+func (x *SpliceBlobRequest) Reset() {
+	*x = SpliceBlobRequest{}
+	mi := &file_pkg_proto_cdc_cdc_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpliceBlobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpliceBlobRequest) ProtoMessage() {}
+
+func (x *SpliceBlobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_cdc_cdc_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpliceBlobRequest.ProtoReflect.Descriptor instead.
+func (*SpliceBlobRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_cdc_cdc_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SpliceBlobRequest) GetInstanceName() string {
+	if x != nil {
+		return x.InstanceName
+	}
+	return ""
+}
+
+func (x *SpliceBlobRequest) GetBlobDigest() *v2.Digest {
+	if x != nil {
+		return x.BlobDigest
+	}
+	return nil
+}
+
+func (x *SpliceBlobRequest) GetChunkDigests() []*v2.Digest {
+	if x != nil {
+		return x.ChunkDigests
+	}
+	return nil
+}
+
+func (x *SpliceBlobRequest) GetDigestFunction() v2.DigestFunction_Value {
+	if x != nil {
+		return x.DigestFunction
+	}
+	return v2.DigestFunction_Value(0)
+}
+
+type SpliceBlobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlobDigest *v2.Digest `protobuf:"bytes,1,opt,name=blob_digest,json=blobDigest,proto3" json:"blob_digest,omitempty"`
+}
+
+func (x *SpliceBlobResponse) Reset() {
+	*x = SpliceBlobResponse{}
+	mi := &file_pkg_proto_cdc_cdc_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpliceBlobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpliceBlobResponse) ProtoMessage() {}
+
+func (x *SpliceBlobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_cdc_cdc_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpliceBlobResponse.ProtoReflect.Descriptor instead.
+func (*SpliceBlobResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_cdc_cdc_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SpliceBlobResponse) GetBlobDigest() *v2.Digest {
+	if x != nil {
+		return x.BlobDigest
+	}
+	return nil
+}
+
+var File_pkg_proto_cdc_cdc_proto protoreflect.FileDescriptor
+
+var file_pkg_proto_cdc_cdc_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x64, 0x63, 0x2f,
+	0x63, 0x64, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x64, 0x63, 0x1a, 0x36, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2f,
+	0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2f, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x32, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xe1, 0x01, 0x0a, 0x10, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x62, 0x6c,
+	0x6f, 0x62, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x32, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x62, 0x44, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x12, 0x5e, 0x0a, 0x0f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x66,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e,
+	0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc1, 0x01, 0x0a, 0x11, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x42, 0x6c,
+	0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x0c, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x12, 0x5e, 0x0a, 0x0f, 0x64, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x35, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb0, 0x02, 0x0a, 0x11, 0x53, 0x70, 0x6c,
+	0x69, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x64, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x62, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a,
+	0x0d, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x62, 0x61, 0x7a,
+	0x65, 0x6c, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x0c, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x12, 0x5e, 0x0a, 0x0f, 0x64,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x62, 0x61, 0x7a,
+	0x65, 0x6c, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x64, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5e, 0x0a, 0x12, 0x53,
+	0x70, 0x6c, 0x69, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x48, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x62,
+	0x61, 0x7a, 0x65, 0x6c, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52,
+	0x0a, 0x62, 0x6c, 0x6f, 0x62, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x32, 0xbb, 0x01, 0x0a, 0x16,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x4e, 0x0a, 0x09, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x42,
+	0x6c, 0x6f, 0x62, 0x12, 0x1f, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e,
+	0x63, 0x64, 0x63, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e,
+	0x2e, 0x63, 0x64, 0x63, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0a, 0x53, 0x70, 0x6c, 0x69, 0x63, 0x65,
+	0x42, 0x6c, 0x6f, 0x62, 0x12, 0x20, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e,
+	0x2e, 0x63, 0x64, 0x63, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61,
+	0x72, 0x6e, 0x2e, 0x63, 0x64, 0x63, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x63, 0x65, 0x42, 0x6c, 0x6f,
+	0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72,
+	0x6e, 0x2f, 0x62, 0x62, 0x2d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x64, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_pkg_proto_cdc_cdc_proto_rawDescOnce sync.Once
+	file_pkg_proto_cdc_cdc_proto_rawDescData = file_pkg_proto_cdc_cdc_proto_rawDesc
+)
+
+func file_pkg_proto_cdc_cdc_proto_rawDescGZIP() []byte {
+	file_pkg_proto_cdc_cdc_proto_rawDescOnce.Do(func() {
+		file_pkg_proto_cdc_cdc_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_proto_cdc_cdc_proto_rawDescData)
+	})
+	return file_pkg_proto_cdc_cdc_proto_rawDescData
+}
+
+var file_pkg_proto_cdc_cdc_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_pkg_proto_cdc_cdc_proto_goTypes = []any{
+	(*SplitBlobRequest)(nil),     // 0: buildbarn.cdc.SplitBlobRequest
+	(*SplitBlobResponse)(nil),    // 1: buildbarn.cdc.SplitBlobResponse
+	(*SpliceBlobRequest)(nil),    // 2: buildbarn.cdc.SpliceBlobRequest
+	(*SpliceBlobResponse)(nil),   // 3: buildbarn.cdc.SpliceBlobResponse
+	(*v2.Digest)(nil),            // 4: build.bazel.remote.execution.v2.Digest
+	(v2.DigestFunction_Value)(0), // 5: build.bazel.remote.execution.v2.DigestFunction.Value
+}
+var file_pkg_proto_cdc_cdc_proto_depIdxs = []int32{
+	4,  // 0: buildbarn.cdc.SplitBlobRequest.blob_digest:type_name -> build.bazel.remote.execution.v2.Digest
+	5,  // 1: buildbarn.cdc.SplitBlobRequest.digest_function:type_name -> build.bazel.remote.execution.v2.DigestFunction.Value
+	4,  // 2: buildbarn.cdc.SplitBlobResponse.chunk_digests:type_name -> build.bazel.remote.execution.v2.Digest
+	5,  // 3: buildbarn.cdc.SplitBlobResponse.digest_function:type_name -> build.bazel.remote.execution.v2.DigestFunction.Value
+	4,  // 4: buildbarn.cdc.SpliceBlobRequest.blob_digest:type_name -> build.bazel.remote.execution.v2.Digest
+	4,  // 5: buildbarn.cdc.SpliceBlobRequest.chunk_digests:type_name -> build.bazel.remote.execution.v2.Digest
+	5,  // 6: buildbarn.cdc.SpliceBlobRequest.digest_function:type_name -> build.bazel.remote.execution.v2.DigestFunction.Value
+	4,  // 7: buildbarn.cdc.SpliceBlobResponse.blob_digest:type_name -> build.bazel.remote.execution.v2.Digest
+	0,  // 8: buildbarn.cdc.ContentDefinedChunking.SplitBlob:input_type -> buildbarn.cdc.SplitBlobRequest
+	2,  // 9: buildbarn.cdc.ContentDefinedChunking.SpliceBlob:input_type -> buildbarn.cdc.SpliceBlobRequest
+	1,  // 10: buildbarn.cdc.ContentDefinedChunking.SplitBlob:output_type -> buildbarn.cdc.SplitBlobResponse
+	3,  // 11: buildbarn.cdc.ContentDefinedChunking.SpliceBlob:output_type -> buildbarn.cdc.SpliceBlobResponse
+	10, // [10:12] is the sub-list for method output_type
+	8,  // [8:10] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_pkg_proto_cdc_cdc_proto_init() }
+func file_pkg_proto_cdc_cdc_proto_init() {
+	if File_pkg_proto_cdc_cdc_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_proto_cdc_cdc_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_proto_cdc_cdc_proto_goTypes,
+		DependencyIndexes: file_pkg_proto_cdc_cdc_proto_depIdxs,
+		MessageInfos:      file_pkg_proto_cdc_cdc_proto_msgTypes,
+	}.Build()
+	File_pkg_proto_cdc_cdc_proto = out.File
+	file_pkg_proto_cdc_cdc_proto_rawDesc = nil
+	file_pkg_proto_cdc_cdc_proto_goTypes = nil
+	file_pkg_proto_cdc_cdc_proto_depIdxs = nil
+}
@@ -0,0 +1,144 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.28.3
+// source: pkg/proto/cdc/cdc.proto
+
+package cdc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ContentDefinedChunking_SplitBlob_FullMethodName  = "/buildbarn.cdc.ContentDefinedChunking/SplitBlob"
+	ContentDefinedChunking_SpliceBlob_FullMethodName = "/buildbarn.cdc.ContentDefinedChunking/SpliceBlob"
+)
+
+// ContentDefinedChunkingClient is the client API for ContentDefinedChunking service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ContentDefinedChunkingClient interface {
+	SplitBlob(ctx context.Context, in *SplitBlobRequest, opts ...grpc.CallOption) (*SplitBlobResponse, error)
+	SpliceBlob(ctx context.Context, in *SpliceBlobRequest, opts ...grpc.CallOption) (*SpliceBlobResponse, error)
+}
+
+type contentDefinedChunkingClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewContentDefinedChunkingClient(cc grpc.ClientConnInterface) ContentDefinedChunkingClient {
+	return &contentDefinedChunkingClient{cc}
+}
+
+func (c *contentDefinedChunkingClient) SplitBlob(ctx context.Context, in *SplitBlobRequest, opts ...grpc.CallOption) (*SplitBlobResponse, error) {
+	out := new(SplitBlobResponse)
+	err := c.cc.Invoke(ctx, ContentDefinedChunking_SplitBlob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentDefinedChunkingClient) SpliceBlob(ctx context.Context, in *SpliceBlobRequest, opts ...grpc.CallOption) (*SpliceBlobResponse, error) {
+	out := new(SpliceBlobResponse)
+	err := c.cc.Invoke(ctx, ContentDefinedChunking_SpliceBlob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ContentDefinedChunkingServer is the server API for ContentDefinedChunking service.
+// All implementations should embed UnimplementedContentDefinedChunkingServer
+// for forward compatibility
+type ContentDefinedChunkingServer interface {
+	SplitBlob(context.Context, *SplitBlobRequest) (*SplitBlobResponse, error)
+	SpliceBlob(context.Context, *SpliceBlobRequest) (*SpliceBlobResponse, error)
+}
+
+// UnimplementedContentDefinedChunkingServer should be embedded to have forward compatible implementations.
+type UnimplementedContentDefinedChunkingServer struct {
+}
+
+func (UnimplementedContentDefinedChunkingServer) SplitBlob(context.Context, *SplitBlobRequest) (*SplitBlobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitBlob not implemented")
+}
+func (UnimplementedContentDefinedChunkingServer) SpliceBlob(context.Context, *SpliceBlobRequest) (*SpliceBlobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpliceBlob not implemented")
+}
+
+// UnsafeContentDefinedChunkingServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ContentDefinedChunkingServer will
+// result in compilation errors.
+type UnsafeContentDefinedChunkingServer interface {
+	mustEmbedUnimplementedContentDefinedChunkingServer()
+}
+
+func RegisterContentDefinedChunkingServer(s grpc.ServiceRegistrar, srv ContentDefinedChunkingServer) {
+	s.RegisterService(&ContentDefinedChunking_ServiceDesc, srv)
+}
+
+func _ContentDefinedChunking_SplitBlob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitBlobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentDefinedChunkingServer).SplitBlob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentDefinedChunking_SplitBlob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentDefinedChunkingServer).SplitBlob(ctx, req.(*SplitBlobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentDefinedChunking_SpliceBlob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpliceBlobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentDefinedChunkingServer).SpliceBlob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentDefinedChunking_SpliceBlob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentDefinedChunkingServer).SpliceBlob(ctx, req.(*SpliceBlobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ContentDefinedChunking_ServiceDesc is the grpc.ServiceDesc for ContentDefinedChunking service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ContentDefinedChunking_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "buildbarn.cdc.ContentDefinedChunking",
+	HandlerType: (*ContentDefinedChunkingServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SplitBlob",
+			Handler:    _ContentDefinedChunking_SplitBlob_Handler,
+		},
+		{
+			MethodName: "SpliceBlob",
+			Handler:    _ContentDefinedChunking_SpliceBlob_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/proto/cdc/cdc.proto",
+}
@@ -0,0 +1,350 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v5.28.3
+// source: pkg/proto/configuration/bb_anti_entropy/bb_anti_entropy.proto
+
+package bb_anti_entropy
+
+import (
+	blobstore "github.com/buildbarn/bb-storage/pkg/proto/configuration/blobstore"
+	global "github.com/buildbarn/bb-storage/pkg/proto/configuration/global"
+	grpc "github.com/buildbarn/bb-storage/pkg/proto/configuration/grpc"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ApplicationConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mirrored                *blobstore.MirroredBlobAccessConfiguration `protobuf:"bytes,1,opt,name=mirrored,proto3" json:"mirrored,omitempty"`
+	DigestListPaths         []string                                   `protobuf:"bytes,2,rep,name=digest_list_paths,json=digestListPaths,proto3" json:"digest_list_paths,omitempty"`
+	BatchSize               uint32                                     `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	MaximumBlobsPerSecond   float64                                    `protobuf:"fixed64,4,opt,name=maximum_blobs_per_second,json=maximumBlobsPerSecond,proto3" json:"maximum_blobs_per_second,omitempty"`
+	PassInterval            *durationpb.Duration                       `protobuf:"bytes,5,opt,name=pass_interval,json=passInterval,proto3" json:"pass_interval,omitempty"`
+	MaximumMessageSizeBytes int64                                      `protobuf:"varint,6,opt,name=maximum_message_size_bytes,json=maximumMessageSizeBytes,proto3" json:"maximum_message_size_bytes,omitempty"`
+	Global                  *global.Configuration                      `protobuf:"bytes,7,opt,name=global,proto3" json:"global,omitempty"`
+	Scan                    *ScanConfiguration                         `protobuf:"bytes,8,opt,name=scan,proto3" json:"scan,omitempty"`
+}
+
+func (x *ApplicationConfiguration) Reset() {
+	*x = ApplicationConfiguration{}
+	mi := &file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationConfiguration) ProtoMessage() {}
+
+func (x *ApplicationConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationConfiguration.ProtoReflect.Descriptor instead.
+func (*ApplicationConfiguration) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ApplicationConfiguration) GetMirrored() *blobstore.MirroredBlobAccessConfiguration {
+	if x != nil {
+		return x.Mirrored
+	}
+	return nil
+}
+
+func (x *ApplicationConfiguration) GetDigestListPaths() []string {
+	if x != nil {
+		return x.DigestListPaths
+	}
+	return nil
+}
+
+func (x *ApplicationConfiguration) GetBatchSize() uint32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *ApplicationConfiguration) GetMaximumBlobsPerSecond() float64 {
+	if x != nil {
+		return x.MaximumBlobsPerSecond
+	}
+	return 0
+}
+
+func (x *ApplicationConfiguration) GetPassInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PassInterval
+	}
+	return nil
+}
+
+func (x *ApplicationConfiguration) GetMaximumMessageSizeBytes() int64 {
+	if x != nil {
+		return x.MaximumMessageSizeBytes
+	}
+	return 0
+}
+
+func (x *ApplicationConfiguration) GetGlobal() *global.Configuration {
+	if x != nil {
+		return x.Global
+	}
+	return nil
+}
+
+func (x *ApplicationConfiguration) GetScan() *ScanConfiguration {
+	if x != nil {
+		return x.Scan
+	}
+	return nil
+}
+
+type ScanConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BackendADigestEnumerationClient *grpc.ClientConfiguration `protobuf:"bytes,1,opt,name=backend_a_digest_enumeration_client,json=backendADigestEnumerationClient,proto3" json:"backend_a_digest_enumeration_client,omitempty"`
+	BackendBDigestEnumerationClient *grpc.ClientConfiguration `protobuf:"bytes,2,opt,name=backend_b_digest_enumeration_client,json=backendBDigestEnumerationClient,proto3" json:"backend_b_digest_enumeration_client,omitempty"`
+	InstanceNamePrefix              string                    `protobuf:"bytes,3,opt,name=instance_name_prefix,json=instanceNamePrefix,proto3" json:"instance_name_prefix,omitempty"`
+	RangeBits                       uint32                    `protobuf:"varint,4,opt,name=range_bits,json=rangeBits,proto3" json:"range_bits,omitempty"`
+	MaximumBlobsPerListing          uint64                    `protobuf:"varint,5,opt,name=maximum_blobs_per_listing,json=maximumBlobsPerListing,proto3" json:"maximum_blobs_per_listing,omitempty"`
+}
+
+func (x *ScanConfiguration) Reset() {
+	*x = ScanConfiguration{}
+	mi := &file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanConfiguration) ProtoMessage() {}
+
+func (x *ScanConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanConfiguration.ProtoReflect.Descriptor instead.
+func (*ScanConfiguration) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScanConfiguration) GetBackendADigestEnumerationClient() *grpc.ClientConfiguration {
+	if x != nil {
+		return x.BackendADigestEnumerationClient
+	}
+	return nil
+}
+
+func (x *ScanConfiguration) GetBackendBDigestEnumerationClient() *grpc.ClientConfiguration {
+	if x != nil {
+		return x.BackendBDigestEnumerationClient
+	}
+	return nil
+}
+
+func (x *ScanConfiguration) GetInstanceNamePrefix() string {
+	if x != nil {
+		return x.InstanceNamePrefix
+	}
+	return ""
+}
+
+func (x *ScanConfiguration) GetRangeBits() uint32 {
+	if x != nil {
+		return x.RangeBits
+	}
+	return 0
+}
+
+func (x *ScanConfiguration) GetMaximumBlobsPerListing() uint64 {
+	if x != nil {
+		return x.MaximumBlobsPerListing
+	}
+	return 0
+}
+
+var File_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto protoreflect.FileDescriptor
+
+var file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_rawDesc = []byte{
+	0x0a, 0x3d, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x62, 0x62, 0x5f, 0x61, 0x6e, 0x74,
+	0x69, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x6f, 0x70, 0x79, 0x2f, 0x62, 0x62, 0x5f, 0x61, 0x6e, 0x74,
+	0x69, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x6f, 0x70, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x27, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x62, 0x62, 0x5f, 0x61, 0x6e, 0x74, 0x69,
+	0x5f, 0x65, 0x6e, 0x74, 0x72, 0x6f, 0x70, 0x79, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x31, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2b, 0x70, 0x6b, 0x67,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x2f, 0x67, 0x6c, 0x6f, 0x62,
+	0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x92, 0x04, 0x0a, 0x18, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5e,
+	0x0a, 0x08, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x42, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x65, 0x64, 0x42, 0x6c, 0x6f,
+	0x62, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x2a,
+	0x0a, 0x11, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x6d, 0x61, 0x78,
+	0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x6d, 0x61, 0x78,
+	0x69, 0x6d, 0x75, 0x6d, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x12, 0x3e, 0x0a, 0x0d, 0x70, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x12, 0x3b, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x45, 0x0a, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x4e, 0x0a, 0x04, 0x73, 0x63, 0x61, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x62,
+	0x62, 0x5f, 0x61, 0x6e, 0x74, 0x69, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x6f, 0x70, 0x79, 0x2e, 0x53,
+	0x63, 0x61, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x04, 0x73, 0x63, 0x61, 0x6e, 0x22, 0xa1, 0x03, 0x0a, 0x11, 0x53, 0x63, 0x61, 0x6e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x7f, 0x0a, 0x23,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1f, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x41, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x75, 0x6d,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x7f, 0x0a,
+	0x23, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1f, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x42, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x75,
+	0x6d, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x30,
+	0x0a, 0x14, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x62, 0x69, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x69, 0x74, 0x73, 0x12,
+	0x39, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x6c, 0x6f, 0x62, 0x73,
+	0x50, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x49, 0x5a, 0x47, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61,
+	0x72, 0x6e, 0x2f, 0x62, 0x62, 0x2d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x62, 0x62, 0x5f, 0x61, 0x6e, 0x74, 0x69, 0x5f, 0x65, 0x6e,
+	0x74, 0x72, 0x6f, 0x70, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_rawDescOnce sync.Once
+	file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_rawDescData = file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_rawDesc
+)
+
+func file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_rawDescGZIP() []byte {
+	file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_rawDescOnce.Do(func() {
+		file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_rawDescData)
+	})
+	return file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_rawDescData
+}
+
+var file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_goTypes = []any{
+	(*ApplicationConfiguration)(nil),                  // 0: buildbarn.configuration.bb_anti_entropy.ApplicationConfiguration
+	(*ScanConfiguration)(nil),                         // 1: buildbarn.configuration.bb_anti_entropy.ScanConfiguration
+	(*blobstore.MirroredBlobAccessConfiguration)(nil), // 2: buildbarn.configuration.blobstore.MirroredBlobAccessConfiguration
+	(*durationpb.Duration)(nil),                       // 3: google.protobuf.Duration
+	(*global.Configuration)(nil),                      // 4: buildbarn.configuration.global.Configuration
+	(*grpc.ClientConfiguration)(nil),                  // 5: buildbarn.configuration.grpc.ClientConfiguration
+}
+var file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_depIdxs = []int32{
+	2, // 0: buildbarn.configuration.bb_anti_entropy.ApplicationConfiguration.mirrored:type_name -> buildbarn.configuration.blobstore.MirroredBlobAccessConfiguration
+	3, // 1: buildbarn.configuration.bb_anti_entropy.ApplicationConfiguration.pass_interval:type_name -> google.protobuf.Duration
+	4, // 2: buildbarn.configuration.bb_anti_entropy.ApplicationConfiguration.global:type_name -> buildbarn.configuration.global.Configuration
+	1, // 3: buildbarn.configuration.bb_anti_entropy.ApplicationConfiguration.scan:type_name -> buildbarn.configuration.bb_anti_entropy.ScanConfiguration
+	5, // 4: buildbarn.configuration.bb_anti_entropy.ScanConfiguration.backend_a_digest_enumeration_client:type_name -> buildbarn.configuration.grpc.ClientConfiguration
+	5, // 5: buildbarn.configuration.bb_anti_entropy.ScanConfiguration.backend_b_digest_enumeration_client:type_name -> buildbarn.configuration.grpc.ClientConfiguration
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_init() }
+func file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_init() {
+	if File_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_goTypes,
+		DependencyIndexes: file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_depIdxs,
+		MessageInfos:      file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_msgTypes,
+	}.Build()
+	File_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto = out.File
+	file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_rawDesc = nil
+	file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_goTypes = nil
+	file_pkg_proto_configuration_bb_anti_entropy_bb_anti_entropy_proto_depIdxs = nil
+}
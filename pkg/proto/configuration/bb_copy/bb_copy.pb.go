@@ -22,6 +22,110 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DigestSourceConfiguration_Format int32
+
+const (
+	DigestSourceConfiguration_NEWLINE_DELIMITED          DigestSourceConfiguration_Format = 0
+	DigestSourceConfiguration_BAZEL_EXECUTION_LOG_BINARY DigestSourceConfiguration_Format = 1
+	DigestSourceConfiguration_BUILD_EVENT_JSON           DigestSourceConfiguration_Format = 2
+)
+
+// Enum value maps for DigestSourceConfiguration_Format.
+var (
+	DigestSourceConfiguration_Format_name = map[int32]string{
+		0: "NEWLINE_DELIMITED",
+		1: "BAZEL_EXECUTION_LOG_BINARY",
+		2: "BUILD_EVENT_JSON",
+	}
+	DigestSourceConfiguration_Format_value = map[string]int32{
+		"NEWLINE_DELIMITED":          0,
+		"BAZEL_EXECUTION_LOG_BINARY": 1,
+		"BUILD_EVENT_JSON":           2,
+	}
+)
+
+func (x DigestSourceConfiguration_Format) Enum() *DigestSourceConfiguration_Format {
+	p := new(DigestSourceConfiguration_Format)
+	*p = x
+	return p
+}
+
+func (x DigestSourceConfiguration_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DigestSourceConfiguration_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_proto_configuration_bb_copy_bb_copy_proto_enumTypes[0].Descriptor()
+}
+
+func (DigestSourceConfiguration_Format) Type() protoreflect.EnumType {
+	return &file_pkg_proto_configuration_bb_copy_bb_copy_proto_enumTypes[0]
+}
+
+func (x DigestSourceConfiguration_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DigestSourceConfiguration_Format.Descriptor instead.
+func (DigestSourceConfiguration_Format) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_proto_configuration_bb_copy_bb_copy_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type DigestSourceConfiguration_ObjectType int32
+
+const (
+	DigestSourceConfiguration_BLOB          DigestSourceConfiguration_ObjectType = 0
+	DigestSourceConfiguration_ACTION        DigestSourceConfiguration_ObjectType = 1
+	DigestSourceConfiguration_DIRECTORY     DigestSourceConfiguration_ObjectType = 2
+	DigestSourceConfiguration_TREE          DigestSourceConfiguration_ObjectType = 3
+	DigestSourceConfiguration_ACTION_RESULT DigestSourceConfiguration_ObjectType = 4
+)
+
+// Enum value maps for DigestSourceConfiguration_ObjectType.
+var (
+	DigestSourceConfiguration_ObjectType_name = map[int32]string{
+		0: "BLOB",
+		1: "ACTION",
+		2: "DIRECTORY",
+		3: "TREE",
+		4: "ACTION_RESULT",
+	}
+	DigestSourceConfiguration_ObjectType_value = map[string]int32{
+		"BLOB":          0,
+		"ACTION":        1,
+		"DIRECTORY":     2,
+		"TREE":          3,
+		"ACTION_RESULT": 4,
+	}
+)
+
+func (x DigestSourceConfiguration_ObjectType) Enum() *DigestSourceConfiguration_ObjectType {
+	p := new(DigestSourceConfiguration_ObjectType)
+	*p = x
+	return p
+}
+
+func (x DigestSourceConfiguration_ObjectType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DigestSourceConfiguration_ObjectType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_proto_configuration_bb_copy_bb_copy_proto_enumTypes[1].Descriptor()
+}
+
+func (DigestSourceConfiguration_ObjectType) Type() protoreflect.EnumType {
+	return &file_pkg_proto_configuration_bb_copy_bb_copy_proto_enumTypes[1]
+}
+
+func (x DigestSourceConfiguration_ObjectType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DigestSourceConfiguration_ObjectType.Descriptor instead.
+func (DigestSourceConfiguration_ObjectType) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_proto_configuration_bb_copy_bb_copy_proto_rawDescGZIP(), []int{2, 1}
+}
+
 type ApplicationConfiguration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -38,6 +142,10 @@ type ApplicationConfiguration struct {
 	MaximumMessageSizeBytes int64                                  `protobuf:"varint,9,opt,name=maximum_message_size_bytes,json=maximumMessageSizeBytes,proto3" json:"maximum_message_size_bytes,omitempty"`
 	TraversalConcurrency    int32                                  `protobuf:"varint,10,opt,name=traversal_concurrency,json=traversalConcurrency,proto3" json:"traversal_concurrency,omitempty"`
 	DigestFunction          v2.DigestFunction_Value                `protobuf:"varint,11,opt,name=digest_function,json=digestFunction,proto3,enum=build.bazel.remote.execution.v2.DigestFunction_Value" json:"digest_function,omitempty"`
+	DigestSources           []*DigestSourceConfiguration           `protobuf:"bytes,12,rep,name=digest_sources,json=digestSources,proto3" json:"digest_sources,omitempty"`
+	CheckpointPath          string                                 `protobuf:"bytes,13,opt,name=checkpoint_path,json=checkpointPath,proto3" json:"checkpoint_path,omitempty"`
+	BlobBatchSize           uint32                                 `protobuf:"varint,14,opt,name=blob_batch_size,json=blobBatchSize,proto3" json:"blob_batch_size,omitempty"`
+	ActionCache             *ActionCacheConfiguration              `protobuf:"bytes,15,opt,name=action_cache,json=actionCache,proto3" json:"action_cache,omitempty"`
 }
 
 func (x *ApplicationConfiguration) Reset() {
@@ -147,6 +255,156 @@ func (x *ApplicationConfiguration) GetDigestFunction() v2.DigestFunction_Value {
 	return v2.DigestFunction_Value(0)
 }
 
+func (x *ApplicationConfiguration) GetDigestSources() []*DigestSourceConfiguration {
+	if x != nil {
+		return x.DigestSources
+	}
+	return nil
+}
+
+func (x *ApplicationConfiguration) GetCheckpointPath() string {
+	if x != nil {
+		return x.CheckpointPath
+	}
+	return ""
+}
+
+func (x *ApplicationConfiguration) GetBlobBatchSize() uint32 {
+	if x != nil {
+		return x.BlobBatchSize
+	}
+	return 0
+}
+
+func (x *ApplicationConfiguration) GetActionCache() *ActionCacheConfiguration {
+	if x != nil {
+		return x.ActionCache
+	}
+	return nil
+}
+
+type ActionCacheConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source                        *blobstore.BlobAccessConfiguration `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Sink                          *blobstore.BlobAccessConfiguration `protobuf:"bytes,2,opt,name=sink,proto3" json:"sink,omitempty"`
+	EnumerateInstanceNamePrefixes []string                           `protobuf:"bytes,3,rep,name=enumerate_instance_name_prefixes,json=enumerateInstanceNamePrefixes,proto3" json:"enumerate_instance_name_prefixes,omitempty"`
+}
+
+func (x *ActionCacheConfiguration) Reset() {
+	*x = ActionCacheConfiguration{}
+	mi := &file_pkg_proto_configuration_bb_copy_bb_copy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActionCacheConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionCacheConfiguration) ProtoMessage() {}
+
+func (x *ActionCacheConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_configuration_bb_copy_bb_copy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionCacheConfiguration.ProtoReflect.Descriptor instead.
+func (*ActionCacheConfiguration) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_configuration_bb_copy_bb_copy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ActionCacheConfiguration) GetSource() *blobstore.BlobAccessConfiguration {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *ActionCacheConfiguration) GetSink() *blobstore.BlobAccessConfiguration {
+	if x != nil {
+		return x.Sink
+	}
+	return nil
+}
+
+func (x *ActionCacheConfiguration) GetEnumerateInstanceNamePrefixes() []string {
+	if x != nil {
+		return x.EnumerateInstanceNamePrefixes
+	}
+	return nil
+}
+
+type DigestSourceConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path       string                               `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Format     DigestSourceConfiguration_Format     `protobuf:"varint,2,opt,name=format,proto3,enum=buildbarn.configuration.bb_copy.DigestSourceConfiguration_Format" json:"format,omitempty"`
+	ObjectType DigestSourceConfiguration_ObjectType `protobuf:"varint,3,opt,name=object_type,json=objectType,proto3,enum=buildbarn.configuration.bb_copy.DigestSourceConfiguration_ObjectType" json:"object_type,omitempty"`
+}
+
+func (x *DigestSourceConfiguration) Reset() {
+	*x = DigestSourceConfiguration{}
+	mi := &file_pkg_proto_configuration_bb_copy_bb_copy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DigestSourceConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DigestSourceConfiguration) ProtoMessage() {}
+
+func (x *DigestSourceConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_configuration_bb_copy_bb_copy_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DigestSourceConfiguration.ProtoReflect.Descriptor instead.
+func (*DigestSourceConfiguration) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_configuration_bb_copy_bb_copy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DigestSourceConfiguration) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DigestSourceConfiguration) GetFormat() DigestSourceConfiguration_Format {
+	if x != nil {
+		return x.Format
+	}
+	return DigestSourceConfiguration_NEWLINE_DELIMITED
+}
+
+func (x *DigestSourceConfiguration) GetObjectType() DigestSourceConfiguration_ObjectType {
+	if x != nil {
+		return x.ObjectType
+	}
+	return DigestSourceConfiguration_BLOB
+}
+
 var File_pkg_proto_configuration_bb_copy_bb_copy_proto protoreflect.FileDescriptor
 
 var file_pkg_proto_configuration_bb_copy_bb_copy_proto_rawDesc = []byte{
@@ -161,7 +419,7 @@ var file_pkg_proto_configuration_bb_copy_bb_copy_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x31, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa1, 0x06, 0x0a, 0x18,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb3, 0x08, 0x0a, 0x18,
 	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64,
@@ -211,8 +469,67 @@ var file_pkg_proto_configuration_bb_copy_bb_copy_proto_rawDesc = []byte{
 	0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x65, 0x78,
 	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73,
 	0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x0e, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0e, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x61, 0x0a, 0x0e, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62,
+	0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x62, 0x62, 0x5f, 0x63, 0x6f, 0x70, 0x79, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x62,
+	0x6c, 0x6f, 0x62, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x62, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x5c, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x62, 0x62, 0x5f, 0x63, 0x6f, 0x70, 0x79, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x22, 0x87, 0x02, 0x0a, 0x18, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x52,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a,
+	0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x4e, 0x0a, 0x04, 0x73, 0x69, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3a, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x73, 0x69,
+	0x6e, 0x6b, 0x12, 0x47, 0x0a, 0x20, 0x65, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x1d, 0x65, 0x6e,
+	0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x22, 0x99, 0x03, 0x0a, 0x19,
+	0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x59, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x41, 0x2e,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x62, 0x62, 0x5f, 0x63, 0x6f, 0x70, 0x79, 0x2e,
+	0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x66, 0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x45, 0x2e,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x62, 0x62, 0x5f, 0x63, 0x6f, 0x70, 0x79, 0x2e,
+	0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x55, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x15, 0x0a, 0x11, 0x4e, 0x45,
+	0x57, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x42, 0x41, 0x5a, 0x45, 0x4c, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10,
+	0x01, 0x12, 0x14, 0x0a, 0x10, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x22, 0x4e, 0x0a, 0x0a, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4c, 0x4f, 0x42, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x44,
+	0x49, 0x52, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x59, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x52,
+	0x45, 0x45, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52,
+	0x45, 0x53, 0x55, 0x4c, 0x54, 0x10, 0x04, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -227,28 +544,39 @@ func file_pkg_proto_configuration_bb_copy_bb_copy_proto_rawDescGZIP() []byte {
 	return file_pkg_proto_configuration_bb_copy_bb_copy_proto_rawDescData
 }
 
-var file_pkg_proto_configuration_bb_copy_bb_copy_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_pkg_proto_configuration_bb_copy_bb_copy_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_pkg_proto_configuration_bb_copy_bb_copy_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_pkg_proto_configuration_bb_copy_bb_copy_proto_goTypes = []any{
-	(*ApplicationConfiguration)(nil),              // 0: buildbarn.configuration.bb_copy.ApplicationConfiguration
-	(*blobstore.BlobAccessConfiguration)(nil),     // 1: buildbarn.configuration.blobstore.BlobAccessConfiguration
-	(*blobstore.BlobReplicatorConfiguration)(nil), // 2: buildbarn.configuration.blobstore.BlobReplicatorConfiguration
-	(*v2.Digest)(nil),                             // 3: build.bazel.remote.execution.v2.Digest
-	(v2.DigestFunction_Value)(0),                  // 4: build.bazel.remote.execution.v2.DigestFunction.Value
+	(DigestSourceConfiguration_Format)(0),         // 0: buildbarn.configuration.bb_copy.DigestSourceConfiguration.Format
+	(DigestSourceConfiguration_ObjectType)(0),     // 1: buildbarn.configuration.bb_copy.DigestSourceConfiguration.ObjectType
+	(*ApplicationConfiguration)(nil),              // 2: buildbarn.configuration.bb_copy.ApplicationConfiguration
+	(*ActionCacheConfiguration)(nil),              // 3: buildbarn.configuration.bb_copy.ActionCacheConfiguration
+	(*DigestSourceConfiguration)(nil),             // 4: buildbarn.configuration.bb_copy.DigestSourceConfiguration
+	(*blobstore.BlobAccessConfiguration)(nil),     // 5: buildbarn.configuration.blobstore.BlobAccessConfiguration
+	(*blobstore.BlobReplicatorConfiguration)(nil), // 6: buildbarn.configuration.blobstore.BlobReplicatorConfiguration
+	(*v2.Digest)(nil),                             // 7: build.bazel.remote.execution.v2.Digest
+	(v2.DigestFunction_Value)(0),                  // 8: build.bazel.remote.execution.v2.DigestFunction.Value
 }
 var file_pkg_proto_configuration_bb_copy_bb_copy_proto_depIdxs = []int32{
-	1, // 0: buildbarn.configuration.bb_copy.ApplicationConfiguration.source:type_name -> buildbarn.configuration.blobstore.BlobAccessConfiguration
-	1, // 1: buildbarn.configuration.bb_copy.ApplicationConfiguration.sink:type_name -> buildbarn.configuration.blobstore.BlobAccessConfiguration
-	2, // 2: buildbarn.configuration.bb_copy.ApplicationConfiguration.replicator:type_name -> buildbarn.configuration.blobstore.BlobReplicatorConfiguration
-	3, // 3: buildbarn.configuration.bb_copy.ApplicationConfiguration.actions:type_name -> build.bazel.remote.execution.v2.Digest
-	3, // 4: buildbarn.configuration.bb_copy.ApplicationConfiguration.blobs:type_name -> build.bazel.remote.execution.v2.Digest
-	3, // 5: buildbarn.configuration.bb_copy.ApplicationConfiguration.directories:type_name -> build.bazel.remote.execution.v2.Digest
-	3, // 6: buildbarn.configuration.bb_copy.ApplicationConfiguration.trees:type_name -> build.bazel.remote.execution.v2.Digest
-	4, // 7: buildbarn.configuration.bb_copy.ApplicationConfiguration.digest_function:type_name -> build.bazel.remote.execution.v2.DigestFunction.Value
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	5,  // 0: buildbarn.configuration.bb_copy.ApplicationConfiguration.source:type_name -> buildbarn.configuration.blobstore.BlobAccessConfiguration
+	5,  // 1: buildbarn.configuration.bb_copy.ApplicationConfiguration.sink:type_name -> buildbarn.configuration.blobstore.BlobAccessConfiguration
+	6,  // 2: buildbarn.configuration.bb_copy.ApplicationConfiguration.replicator:type_name -> buildbarn.configuration.blobstore.BlobReplicatorConfiguration
+	7,  // 3: buildbarn.configuration.bb_copy.ApplicationConfiguration.actions:type_name -> build.bazel.remote.execution.v2.Digest
+	7,  // 4: buildbarn.configuration.bb_copy.ApplicationConfiguration.blobs:type_name -> build.bazel.remote.execution.v2.Digest
+	7,  // 5: buildbarn.configuration.bb_copy.ApplicationConfiguration.directories:type_name -> build.bazel.remote.execution.v2.Digest
+	7,  // 6: buildbarn.configuration.bb_copy.ApplicationConfiguration.trees:type_name -> build.bazel.remote.execution.v2.Digest
+	8,  // 7: buildbarn.configuration.bb_copy.ApplicationConfiguration.digest_function:type_name -> build.bazel.remote.execution.v2.DigestFunction.Value
+	4,  // 8: buildbarn.configuration.bb_copy.ApplicationConfiguration.digest_sources:type_name -> buildbarn.configuration.bb_copy.DigestSourceConfiguration
+	3,  // 9: buildbarn.configuration.bb_copy.ApplicationConfiguration.action_cache:type_name -> buildbarn.configuration.bb_copy.ActionCacheConfiguration
+	5,  // 10: buildbarn.configuration.bb_copy.ActionCacheConfiguration.source:type_name -> buildbarn.configuration.blobstore.BlobAccessConfiguration
+	5,  // 11: buildbarn.configuration.bb_copy.ActionCacheConfiguration.sink:type_name -> buildbarn.configuration.blobstore.BlobAccessConfiguration
+	0,  // 12: buildbarn.configuration.bb_copy.DigestSourceConfiguration.format:type_name -> buildbarn.configuration.bb_copy.DigestSourceConfiguration.Format
+	1,  // 13: buildbarn.configuration.bb_copy.DigestSourceConfiguration.object_type:type_name -> buildbarn.configuration.bb_copy.DigestSourceConfiguration.ObjectType
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_pkg_proto_configuration_bb_copy_bb_copy_proto_init() }
@@ -261,13 +589,14 @@ func file_pkg_proto_configuration_bb_copy_bb_copy_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_proto_configuration_bb_copy_bb_copy_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_pkg_proto_configuration_bb_copy_bb_copy_proto_goTypes,
 		DependencyIndexes: file_pkg_proto_configuration_bb_copy_bb_copy_proto_depIdxs,
+		EnumInfos:         file_pkg_proto_configuration_bb_copy_bb_copy_proto_enumTypes,
 		MessageInfos:      file_pkg_proto_configuration_bb_copy_bb_copy_proto_msgTypes,
 	}.Build()
 	File_pkg_proto_configuration_bb_copy_bb_copy_proto = out.File
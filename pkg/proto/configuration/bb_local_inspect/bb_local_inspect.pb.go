@@ -0,0 +1,221 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v5.28.3
+// source: pkg/proto/configuration/bb_local_inspect/bb_local_inspect.proto
+
+package bb_local_inspect
+
+import (
+	v2 "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	blobstore "github.com/buildbarn/bb-storage/pkg/proto/configuration/blobstore"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ApplicationConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Local                     *blobstore.LocalBlobAccessConfiguration `protobuf:"bytes,1,opt,name=local,proto3" json:"local,omitempty"`
+	ContentAddressableStorage bool                                    `protobuf:"varint,2,opt,name=content_addressable_storage,json=contentAddressableStorage,proto3" json:"content_addressable_storage,omitempty"`
+	InstanceNames             []string                                `protobuf:"bytes,3,rep,name=instance_names,json=instanceNames,proto3" json:"instance_names,omitempty"`
+	DigestFunctions           []v2.DigestFunction_Value               `protobuf:"varint,4,rep,packed,name=digest_functions,json=digestFunctions,proto3,enum=build.bazel.remote.execution.v2.DigestFunction_Value" json:"digest_functions,omitempty"`
+	ListBlobs                 bool                                    `protobuf:"varint,5,opt,name=list_blobs,json=listBlobs,proto3" json:"list_blobs,omitempty"`
+	ExportZipPath             string                                  `protobuf:"bytes,6,opt,name=export_zip_path,json=exportZipPath,proto3" json:"export_zip_path,omitempty"`
+	ExportDigestListPath      string                                  `protobuf:"bytes,7,opt,name=export_digest_list_path,json=exportDigestListPath,proto3" json:"export_digest_list_path,omitempty"`
+}
+
+func (x *ApplicationConfiguration) Reset() {
+	*x = ApplicationConfiguration{}
+	mi := &file_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationConfiguration) ProtoMessage() {}
+
+func (x *ApplicationConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationConfiguration.ProtoReflect.Descriptor instead.
+func (*ApplicationConfiguration) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ApplicationConfiguration) GetLocal() *blobstore.LocalBlobAccessConfiguration {
+	if x != nil {
+		return x.Local
+	}
+	return nil
+}
+
+func (x *ApplicationConfiguration) GetContentAddressableStorage() bool {
+	if x != nil {
+		return x.ContentAddressableStorage
+	}
+	return false
+}
+
+func (x *ApplicationConfiguration) GetInstanceNames() []string {
+	if x != nil {
+		return x.InstanceNames
+	}
+	return nil
+}
+
+func (x *ApplicationConfiguration) GetDigestFunctions() []v2.DigestFunction_Value {
+	if x != nil {
+		return x.DigestFunctions
+	}
+	return nil
+}
+
+func (x *ApplicationConfiguration) GetListBlobs() bool {
+	if x != nil {
+		return x.ListBlobs
+	}
+	return false
+}
+
+func (x *ApplicationConfiguration) GetExportZipPath() string {
+	if x != nil {
+		return x.ExportZipPath
+	}
+	return ""
+}
+
+func (x *ApplicationConfiguration) GetExportDigestListPath() string {
+	if x != nil {
+		return x.ExportDigestListPath
+	}
+	return ""
+}
+
+var File_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto protoreflect.FileDescriptor
+
+var file_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto_rawDesc = []byte{
+	0x0a, 0x3f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x62, 0x62, 0x5f, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x2f, 0x62, 0x62, 0x5f, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x28, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x62, 0x62, 0x5f, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x1a, 0x36, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x2f, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2f,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x32, 0x2f, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x31, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x62, 0x6c, 0x6f,
+	0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb8, 0x03, 0x0a, 0x18, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x62, 0x6c, 0x6f,
+	0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x62,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x3e, 0x0a, 0x1b, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x19, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x12, 0x60, 0x0a, 0x10, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x0f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x62,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f,
+	0x62, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x7a, 0x69, 0x70,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x5a, 0x69, 0x70, 0x50, 0x61, 0x74, 0x68, 0x12, 0x35, 0x0a, 0x17, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x74,
+	0x68, 0x42, 0x4a, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2f, 0x62, 0x62, 0x2d, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x62, 0x62, 0x5f,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto_rawDescOnce sync.Once
+	file_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto_rawDescData = file_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto_rawDesc
+)
+
+func file_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto_rawDescGZIP() []byte {
+	file_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto_rawDescOnce.Do(func() {
+		file_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto_rawDescData)
+	})
+	return file_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto_rawDescData
+}
+
+var file_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto_goTypes = []any{
+	(*ApplicationConfiguration)(nil),               // 0: buildbarn.configuration.bb_local_inspect.ApplicationConfiguration
+	(*blobstore.LocalBlobAccessConfiguration)(nil), // 1: buildbarn.configuration.blobstore.LocalBlobAccessConfiguration
+	(v2.DigestFunction_Value)(0),                   // 2: build.bazel.remote.execution.v2.DigestFunction.Value
+}
+var file_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto_depIdxs = []int32{
+	1, // 0: buildbarn.configuration.bb_local_inspect.ApplicationConfiguration.local:type_name -> buildbarn.configuration.blobstore.LocalBlobAccessConfiguration
+	2, // 1: buildbarn.configuration.bb_local_inspect.ApplicationConfiguration.digest_functions:type_name -> build.bazel.remote.execution.v2.DigestFunction.Value
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto_init() }
+func file_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto_init() {
+	if File_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto_goTypes,
+		DependencyIndexes: file_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto_depIdxs,
+		MessageInfos:      file_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto_msgTypes,
+	}.Build()
+	File_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto = out.File
+	file_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto_rawDesc = nil
+	file_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto_goTypes = nil
+	file_pkg_proto_configuration_bb_local_inspect_bb_local_inspect_proto_depIdxs = nil
+}
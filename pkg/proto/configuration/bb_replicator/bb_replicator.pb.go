@@ -28,12 +28,13 @@ type ApplicationConfiguration struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GrpcServers             []*grpc.ServerConfiguration            `protobuf:"bytes,2,rep,name=grpc_servers,json=grpcServers,proto3" json:"grpc_servers,omitempty"`
-	Source                  *blobstore.BlobAccessConfiguration     `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
-	Sink                    *blobstore.BlobAccessConfiguration     `protobuf:"bytes,4,opt,name=sink,proto3" json:"sink,omitempty"`
-	Replicator              *blobstore.BlobReplicatorConfiguration `protobuf:"bytes,5,opt,name=replicator,proto3" json:"replicator,omitempty"`
-	MaximumMessageSizeBytes int64                                  `protobuf:"varint,6,opt,name=maximum_message_size_bytes,json=maximumMessageSizeBytes,proto3" json:"maximum_message_size_bytes,omitempty"`
-	Global                  *global.Configuration                  `protobuf:"bytes,7,opt,name=global,proto3" json:"global,omitempty"`
+	GrpcServers                      []*grpc.ServerConfiguration            `protobuf:"bytes,2,rep,name=grpc_servers,json=grpcServers,proto3" json:"grpc_servers,omitempty"`
+	Source                           *blobstore.BlobAccessConfiguration     `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Sink                             *blobstore.BlobAccessConfiguration     `protobuf:"bytes,4,opt,name=sink,proto3" json:"sink,omitempty"`
+	Replicator                       *blobstore.BlobReplicatorConfiguration `protobuf:"bytes,5,opt,name=replicator,proto3" json:"replicator,omitempty"`
+	MaximumMessageSizeBytes          int64                                  `protobuf:"varint,6,opt,name=maximum_message_size_bytes,json=maximumMessageSizeBytes,proto3" json:"maximum_message_size_bytes,omitempty"`
+	Global                           *global.Configuration                  `protobuf:"bytes,7,opt,name=global,proto3" json:"global,omitempty"`
+	ReplicationQueueAdminGrpcServers []*grpc.ServerConfiguration            `protobuf:"bytes,8,rep,name=replication_queue_admin_grpc_servers,json=replicationQueueAdminGrpcServers,proto3" json:"replication_queue_admin_grpc_servers,omitempty"`
 }
 
 func (x *ApplicationConfiguration) Reset() {
@@ -108,6 +109,13 @@ func (x *ApplicationConfiguration) GetGlobal() *global.Configuration {
 	return nil
 }
 
+func (x *ApplicationConfiguration) GetReplicationQueueAdminGrpcServers() []*grpc.ServerConfiguration {
+	if x != nil {
+		return x.ReplicationQueueAdminGrpcServers
+	}
+	return nil
+}
+
 var File_pkg_proto_configuration_bb_replicator_bb_replicator_proto protoreflect.FileDescriptor
 
 var file_pkg_proto_configuration_bb_replicator_bb_replicator_proto_rawDesc = []byte{
@@ -125,7 +133,7 @@ var file_pkg_proto_configuration_bb_replicator_bb_replicator_proto_rawDesc = []b
 	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x2f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x27, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63,
-	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfe, 0x03, 0x0a, 0x18,
+	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x82, 0x05, 0x0a, 0x18,
 	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x54, 0x0a, 0x0c, 0x67, 0x72, 0x70, 0x63,
 	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31,
@@ -157,12 +165,21 @@ var file_pkg_proto_configuration_bb_replicator_bb_replicator_proto_rawDesc = []b
 	0x2d, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
 	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
-	0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x42, 0x47, 0x5a, 0x45,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64,
-	0x62, 0x61, 0x72, 0x6e, 0x2f, 0x62, 0x62, 0x2d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f,
-	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x62, 0x62, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x81, 0x01, 0x0a, 0x24, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72,
+	0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x20, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47,
+	0x72, 0x70, 0x63, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02,
+	0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2f, 0x62, 0x62, 0x2d, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x62, 0x62, 0x5f, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -191,11 +208,12 @@ var file_pkg_proto_configuration_bb_replicator_bb_replicator_proto_depIdxs = []i
 	2, // 2: buildbarn.configuration.bb_replicator.ApplicationConfiguration.sink:type_name -> buildbarn.configuration.blobstore.BlobAccessConfiguration
 	3, // 3: buildbarn.configuration.bb_replicator.ApplicationConfiguration.replicator:type_name -> buildbarn.configuration.blobstore.BlobReplicatorConfiguration
 	4, // 4: buildbarn.configuration.bb_replicator.ApplicationConfiguration.global:type_name -> buildbarn.configuration.global.Configuration
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	1, // 5: buildbarn.configuration.bb_replicator.ApplicationConfiguration.replication_queue_admin_grpc_servers:type_name -> buildbarn.configuration.grpc.ServerConfiguration
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_pkg_proto_configuration_bb_replicator_bb_replicator_proto_init() }
@@ -73,6 +73,104 @@ func (x *FileConfiguration) GetSizeBytes() int64 {
 	return 0
 }
 
+type StripedConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Devices         []*Configuration `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	StripeSizeBytes int64            `protobuf:"varint,2,opt,name=stripe_size_bytes,json=stripeSizeBytes,proto3" json:"stripe_size_bytes,omitempty"`
+}
+
+func (x *StripedConfiguration) Reset() {
+	*x = StripedConfiguration{}
+	mi := &file_pkg_proto_configuration_blockdevice_blockdevice_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StripedConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StripedConfiguration) ProtoMessage() {}
+
+func (x *StripedConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_configuration_blockdevice_blockdevice_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StripedConfiguration.ProtoReflect.Descriptor instead.
+func (*StripedConfiguration) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_configuration_blockdevice_blockdevice_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StripedConfiguration) GetDevices() []*Configuration {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+func (x *StripedConfiguration) GetStripeSizeBytes() int64 {
+	if x != nil {
+		return x.StripeSizeBytes
+	}
+	return 0
+}
+
+type DirectIOConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IoUringEntries uint32 `protobuf:"varint,1,opt,name=io_uring_entries,json=ioUringEntries,proto3" json:"io_uring_entries,omitempty"`
+}
+
+func (x *DirectIOConfiguration) Reset() {
+	*x = DirectIOConfiguration{}
+	mi := &file_pkg_proto_configuration_blockdevice_blockdevice_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DirectIOConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DirectIOConfiguration) ProtoMessage() {}
+
+func (x *DirectIOConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_configuration_blockdevice_blockdevice_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DirectIOConfiguration.ProtoReflect.Descriptor instead.
+func (*DirectIOConfiguration) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_configuration_blockdevice_blockdevice_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DirectIOConfiguration) GetIoUringEntries() uint32 {
+	if x != nil {
+		return x.IoUringEntries
+	}
+	return 0
+}
+
 type Configuration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -82,12 +180,14 @@ type Configuration struct {
 	//
 	//	*Configuration_DevicePath
 	//	*Configuration_File
-	Source isConfiguration_Source `protobuf_oneof:"source"`
+	//	*Configuration_Striped
+	Source   isConfiguration_Source `protobuf_oneof:"source"`
+	DirectIo *DirectIOConfiguration `protobuf:"bytes,3,opt,name=direct_io,json=directIo,proto3" json:"direct_io,omitempty"`
 }
 
 func (x *Configuration) Reset() {
 	*x = Configuration{}
-	mi := &file_pkg_proto_configuration_blockdevice_blockdevice_proto_msgTypes[1]
+	mi := &file_pkg_proto_configuration_blockdevice_blockdevice_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -99,7 +199,7 @@ func (x *Configuration) String() string {
 func (*Configuration) ProtoMessage() {}
 
 func (x *Configuration) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_configuration_blockdevice_blockdevice_proto_msgTypes[1]
+	mi := &file_pkg_proto_configuration_blockdevice_blockdevice_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -112,7 +212,7 @@ func (x *Configuration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Configuration.ProtoReflect.Descriptor instead.
 func (*Configuration) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_configuration_blockdevice_blockdevice_proto_rawDescGZIP(), []int{1}
+	return file_pkg_proto_configuration_blockdevice_blockdevice_proto_rawDescGZIP(), []int{3}
 }
 
 func (m *Configuration) GetSource() isConfiguration_Source {
@@ -136,6 +236,20 @@ func (x *Configuration) GetFile() *FileConfiguration {
 	return nil
 }
 
+func (x *Configuration) GetStriped() *StripedConfiguration {
+	if x, ok := x.GetSource().(*Configuration_Striped); ok {
+		return x.Striped
+	}
+	return nil
+}
+
+func (x *Configuration) GetDirectIo() *DirectIOConfiguration {
+	if x != nil {
+		return x.DirectIo
+	}
+	return nil
+}
+
 type isConfiguration_Source interface {
 	isConfiguration_Source()
 }
@@ -148,10 +262,16 @@ type Configuration_File struct {
 	File *FileConfiguration `protobuf:"bytes,2,opt,name=file,proto3,oneof"`
 }
 
+type Configuration_Striped struct {
+	Striped *StripedConfiguration `protobuf:"bytes,4,opt,name=striped,proto3,oneof"`
+}
+
 func (*Configuration_DevicePath) isConfiguration_Source() {}
 
 func (*Configuration_File) isConfiguration_Source() {}
 
+func (*Configuration_Striped) isConfiguration_Source() {}
+
 var File_pkg_proto_configuration_blockdevice_blockdevice_proto protoreflect.FileDescriptor
 
 var file_pkg_proto_configuration_blockdevice_blockdevice_proto_rawDesc = []byte{
@@ -165,20 +285,45 @@ var file_pkg_proto_configuration_blockdevice_blockdevice_proto_rawDesc = []byte{
 	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79,
 	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42,
-	0x79, 0x74, 0x65, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x64,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x4c, 0x0a, 0x04, 0x66, 0x69, 0x6c,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x69, 0x70, 0x65, 0x64,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a,
+	0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32,
+	0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x73,
+	0x74, 0x72, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x73, 0x74, 0x72, 0x69, 0x70, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x41, 0x0a, 0x15, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x49, 0x4f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x28, 0x0a, 0x10, 0x69, 0x6f, 0x5f, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x69, 0x6f, 0x55, 0x72,
+	0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xba, 0x02, 0x0a, 0x0d, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0b,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x4c, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x55, 0x0a,
+	0x07, 0x73, 0x74, 0x72, 0x69, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39,
+	0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x07, 0x73, 0x74, 0x72,
+	0x69, 0x70, 0x65, 0x64, 0x12, 0x57, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x69,
+	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62,
 	0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69,
-	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
-	0x00, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2f, 0x62, 0x62, 0x2d, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x49, 0x4f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x49, 0x6f, 0x42, 0x08, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2f,
+	0x62, 0x62, 0x2d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -193,18 +338,23 @@ func file_pkg_proto_configuration_blockdevice_blockdevice_proto_rawDescGZIP() []
 	return file_pkg_proto_configuration_blockdevice_blockdevice_proto_rawDescData
 }
 
-var file_pkg_proto_configuration_blockdevice_blockdevice_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pkg_proto_configuration_blockdevice_blockdevice_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_pkg_proto_configuration_blockdevice_blockdevice_proto_goTypes = []any{
-	(*FileConfiguration)(nil), // 0: buildbarn.configuration.blockdevice.FileConfiguration
-	(*Configuration)(nil),     // 1: buildbarn.configuration.blockdevice.Configuration
+	(*FileConfiguration)(nil),     // 0: buildbarn.configuration.blockdevice.FileConfiguration
+	(*StripedConfiguration)(nil),  // 1: buildbarn.configuration.blockdevice.StripedConfiguration
+	(*DirectIOConfiguration)(nil), // 2: buildbarn.configuration.blockdevice.DirectIOConfiguration
+	(*Configuration)(nil),         // 3: buildbarn.configuration.blockdevice.Configuration
 }
 var file_pkg_proto_configuration_blockdevice_blockdevice_proto_depIdxs = []int32{
-	0, // 0: buildbarn.configuration.blockdevice.Configuration.file:type_name -> buildbarn.configuration.blockdevice.FileConfiguration
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 0: buildbarn.configuration.blockdevice.StripedConfiguration.devices:type_name -> buildbarn.configuration.blockdevice.Configuration
+	0, // 1: buildbarn.configuration.blockdevice.Configuration.file:type_name -> buildbarn.configuration.blockdevice.FileConfiguration
+	1, // 2: buildbarn.configuration.blockdevice.Configuration.striped:type_name -> buildbarn.configuration.blockdevice.StripedConfiguration
+	2, // 3: buildbarn.configuration.blockdevice.Configuration.direct_io:type_name -> buildbarn.configuration.blockdevice.DirectIOConfiguration
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_pkg_proto_configuration_blockdevice_blockdevice_proto_init() }
@@ -212,9 +362,10 @@ func file_pkg_proto_configuration_blockdevice_blockdevice_proto_init() {
 	if File_pkg_proto_configuration_blockdevice_blockdevice_proto != nil {
 		return
 	}
-	file_pkg_proto_configuration_blockdevice_blockdevice_proto_msgTypes[1].OneofWrappers = []any{
+	file_pkg_proto_configuration_blockdevice_blockdevice_proto_msgTypes[3].OneofWrappers = []any{
 		(*Configuration_DevicePath)(nil),
 		(*Configuration_File)(nil),
+		(*Configuration_Striped)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -222,7 +373,7 @@ func file_pkg_proto_configuration_blockdevice_blockdevice_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_proto_configuration_blockdevice_blockdevice_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
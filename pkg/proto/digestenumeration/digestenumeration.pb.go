@@ -0,0 +1,567 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v5.28.3
+// source: pkg/proto/digestenumeration/digestenumeration.proto
+
+package digestenumeration
+
+import (
+	v2 "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StorageType int32
+
+const (
+	StorageType_CONTENT_ADDRESSABLE_STORAGE StorageType = 0
+	StorageType_ACTION_CACHE                StorageType = 1
+)
+
+// Enum value maps for StorageType.
+var (
+	StorageType_name = map[int32]string{
+		0: "CONTENT_ADDRESSABLE_STORAGE",
+		1: "ACTION_CACHE",
+	}
+	StorageType_value = map[string]int32{
+		"CONTENT_ADDRESSABLE_STORAGE": 0,
+		"ACTION_CACHE":                1,
+	}
+)
+
+func (x StorageType) Enum() *StorageType {
+	p := new(StorageType)
+	*p = x
+	return p
+}
+
+func (x StorageType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StorageType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_proto_digestenumeration_digestenumeration_proto_enumTypes[0].Descriptor()
+}
+
+func (StorageType) Type() protoreflect.EnumType {
+	return &file_pkg_proto_digestenumeration_digestenumeration_proto_enumTypes[0]
+}
+
+func (x StorageType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StorageType.Descriptor instead.
+func (StorageType) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_proto_digestenumeration_digestenumeration_proto_rawDescGZIP(), []int{0}
+}
+
+type SummarizeDigestRangesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StorageType        StorageType `protobuf:"varint,1,opt,name=storage_type,json=storageType,proto3,enum=buildbarn.digestenumeration.StorageType" json:"storage_type,omitempty"`
+	InstanceNamePrefix string      `protobuf:"bytes,2,opt,name=instance_name_prefix,json=instanceNamePrefix,proto3" json:"instance_name_prefix,omitempty"`
+	RangeBits          uint32      `protobuf:"varint,3,opt,name=range_bits,json=rangeBits,proto3" json:"range_bits,omitempty"`
+}
+
+func (x *SummarizeDigestRangesRequest) Reset() {
+	*x = SummarizeDigestRangesRequest{}
+	mi := &file_pkg_proto_digestenumeration_digestenumeration_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SummarizeDigestRangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SummarizeDigestRangesRequest) ProtoMessage() {}
+
+func (x *SummarizeDigestRangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_digestenumeration_digestenumeration_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SummarizeDigestRangesRequest.ProtoReflect.Descriptor instead.
+func (*SummarizeDigestRangesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_digestenumeration_digestenumeration_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SummarizeDigestRangesRequest) GetStorageType() StorageType {
+	if x != nil {
+		return x.StorageType
+	}
+	return StorageType_CONTENT_ADDRESSABLE_STORAGE
+}
+
+func (x *SummarizeDigestRangesRequest) GetInstanceNamePrefix() string {
+	if x != nil {
+		return x.InstanceNamePrefix
+	}
+	return ""
+}
+
+func (x *SummarizeDigestRangesRequest) GetRangeBits() uint32 {
+	if x != nil {
+		return x.RangeBits
+	}
+	return 0
+}
+
+type DigestRangeSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlobsCount uint64 `protobuf:"varint,1,opt,name=blobs_count,json=blobsCount,proto3" json:"blobs_count,omitempty"`
+	Hash       []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *DigestRangeSummary) Reset() {
+	*x = DigestRangeSummary{}
+	mi := &file_pkg_proto_digestenumeration_digestenumeration_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DigestRangeSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DigestRangeSummary) ProtoMessage() {}
+
+func (x *DigestRangeSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_digestenumeration_digestenumeration_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DigestRangeSummary.ProtoReflect.Descriptor instead.
+func (*DigestRangeSummary) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_digestenumeration_digestenumeration_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DigestRangeSummary) GetBlobsCount() uint64 {
+	if x != nil {
+		return x.BlobsCount
+	}
+	return 0
+}
+
+func (x *DigestRangeSummary) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+type SummarizeDigestRangesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ranges []*DigestRangeSummary `protobuf:"bytes,1,rep,name=ranges,proto3" json:"ranges,omitempty"`
+}
+
+func (x *SummarizeDigestRangesResponse) Reset() {
+	*x = SummarizeDigestRangesResponse{}
+	mi := &file_pkg_proto_digestenumeration_digestenumeration_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SummarizeDigestRangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SummarizeDigestRangesResponse) ProtoMessage() {}
+
+func (x *SummarizeDigestRangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_digestenumeration_digestenumeration_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SummarizeDigestRangesResponse.ProtoReflect.Descriptor instead.
+func (*SummarizeDigestRangesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_digestenumeration_digestenumeration_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SummarizeDigestRangesResponse) GetRanges() []*DigestRangeSummary {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+type ListDigestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StorageType        StorageType `protobuf:"varint,1,opt,name=storage_type,json=storageType,proto3,enum=buildbarn.digestenumeration.StorageType" json:"storage_type,omitempty"`
+	InstanceNamePrefix string      `protobuf:"bytes,2,opt,name=instance_name_prefix,json=instanceNamePrefix,proto3" json:"instance_name_prefix,omitempty"`
+	RangeBits          uint32      `protobuf:"varint,3,opt,name=range_bits,json=rangeBits,proto3" json:"range_bits,omitempty"`
+	Ranges             []uint32    `protobuf:"varint,4,rep,packed,name=ranges,proto3" json:"ranges,omitempty"`
+}
+
+func (x *ListDigestsRequest) Reset() {
+	*x = ListDigestsRequest{}
+	mi := &file_pkg_proto_digestenumeration_digestenumeration_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDigestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDigestsRequest) ProtoMessage() {}
+
+func (x *ListDigestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_digestenumeration_digestenumeration_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDigestsRequest.ProtoReflect.Descriptor instead.
+func (*ListDigestsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_digestenumeration_digestenumeration_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListDigestsRequest) GetStorageType() StorageType {
+	if x != nil {
+		return x.StorageType
+	}
+	return StorageType_CONTENT_ADDRESSABLE_STORAGE
+}
+
+func (x *ListDigestsRequest) GetInstanceNamePrefix() string {
+	if x != nil {
+		return x.InstanceNamePrefix
+	}
+	return ""
+}
+
+func (x *ListDigestsRequest) GetRangeBits() uint32 {
+	if x != nil {
+		return x.RangeBits
+	}
+	return 0
+}
+
+func (x *ListDigestsRequest) GetRanges() []uint32 {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+type ListDigestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blobs []*ListDigestsResponse_Blob `protobuf:"bytes,1,rep,name=blobs,proto3" json:"blobs,omitempty"`
+}
+
+func (x *ListDigestsResponse) Reset() {
+	*x = ListDigestsResponse{}
+	mi := &file_pkg_proto_digestenumeration_digestenumeration_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDigestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDigestsResponse) ProtoMessage() {}
+
+func (x *ListDigestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_digestenumeration_digestenumeration_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDigestsResponse.ProtoReflect.Descriptor instead.
+func (*ListDigestsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_digestenumeration_digestenumeration_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListDigestsResponse) GetBlobs() []*ListDigestsResponse_Blob {
+	if x != nil {
+		return x.Blobs
+	}
+	return nil
+}
+
+type ListDigestsResponse_Blob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceName   string                  `protobuf:"bytes,1,opt,name=instance_name,json=instanceName,proto3" json:"instance_name,omitempty"`
+	DigestFunction v2.DigestFunction_Value `protobuf:"varint,2,opt,name=digest_function,json=digestFunction,proto3,enum=build.bazel.remote.execution.v2.DigestFunction_Value" json:"digest_function,omitempty"`
+	Digest         *v2.Digest              `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+}
+
+func (x *ListDigestsResponse_Blob) Reset() {
+	*x = ListDigestsResponse_Blob{}
+	mi := &file_pkg_proto_digestenumeration_digestenumeration_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDigestsResponse_Blob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDigestsResponse_Blob) ProtoMessage() {}
+
+func (x *ListDigestsResponse_Blob) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_digestenumeration_digestenumeration_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDigestsResponse_Blob.ProtoReflect.Descriptor instead.
+func (*ListDigestsResponse_Blob) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_digestenumeration_digestenumeration_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *ListDigestsResponse_Blob) GetInstanceName() string {
+	if x != nil {
+		return x.InstanceName
+	}
+	return ""
+}
+
+func (x *ListDigestsResponse_Blob) GetDigestFunction() v2.DigestFunction_Value {
+	if x != nil {
+		return x.DigestFunction
+	}
+	return v2.DigestFunction_Value(0)
+}
+
+func (x *ListDigestsResponse_Blob) GetDigest() *v2.Digest {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+var File_pkg_proto_digestenumeration_digestenumeration_proto protoreflect.FileDescriptor
+
+var file_pkg_proto_digestenumeration_digestenumeration_proto_rawDesc = []byte{
+	0x0a, 0x33, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x65, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x64, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x65, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e,
+	0x2e, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x65, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x1a, 0x36, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2f, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2f,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x76, 0x32, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x01, 0x0a, 0x1c, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x7a, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x0c, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x28, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x64, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x65, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x5f, 0x62, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x69, 0x74, 0x73, 0x22, 0x49, 0x0a, 0x12, 0x44, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
+	0x1f, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x22, 0x68, 0x0a, 0x1d, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x7a,
+	0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72,
+	0x6e, 0x2e, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x65, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xca,
+	0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x65, 0x6e,
+	0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x62, 0x69,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x42,
+	0x69, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0d, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xb1, 0x02, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x35, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x64,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x65, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73,
+	0x1a, 0xcc, 0x01, 0x0a, 0x04, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x5e,
+	0x0a, 0x0f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e,
+	0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e,
+	0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f,
+	0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32,
+	0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x2a,
+	0x40, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f,
+	0x0a, 0x1b, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53,
+	0x53, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x10, 0x00, 0x12,
+	0x10, 0x0a, 0x0c, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x41, 0x43, 0x48, 0x45, 0x10,
+	0x01, 0x32, 0x98, 0x02, 0x0a, 0x11, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x75, 0x6d,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x8e, 0x01, 0x0a, 0x15, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x69, 0x7a, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x12, 0x39, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x64, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x65, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x7a, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x65,
+	0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x69, 0x7a, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2f, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62,
+	0x61, 0x72, 0x6e, 0x2e, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x65, 0x6e, 0x75, 0x6d, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x62, 0x61, 0x72, 0x6e, 0x2e, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x65, 0x6e, 0x75, 0x6d, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x3d, 0x5a, 0x3b,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x62, 0x61, 0x72, 0x6e, 0x2f, 0x62, 0x62, 0x2d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x65, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_proto_digestenumeration_digestenumeration_proto_rawDescOnce sync.Once
+	file_pkg_proto_digestenumeration_digestenumeration_proto_rawDescData = file_pkg_proto_digestenumeration_digestenumeration_proto_rawDesc
+)
+
+func file_pkg_proto_digestenumeration_digestenumeration_proto_rawDescGZIP() []byte {
+	file_pkg_proto_digestenumeration_digestenumeration_proto_rawDescOnce.Do(func() {
+		file_pkg_proto_digestenumeration_digestenumeration_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_proto_digestenumeration_digestenumeration_proto_rawDescData)
+	})
+	return file_pkg_proto_digestenumeration_digestenumeration_proto_rawDescData
+}
+
+var file_pkg_proto_digestenumeration_digestenumeration_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pkg_proto_digestenumeration_digestenumeration_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_pkg_proto_digestenumeration_digestenumeration_proto_goTypes = []any{
+	(StorageType)(0),                      // 0: buildbarn.digestenumeration.StorageType
+	(*SummarizeDigestRangesRequest)(nil),  // 1: buildbarn.digestenumeration.SummarizeDigestRangesRequest
+	(*DigestRangeSummary)(nil),            // 2: buildbarn.digestenumeration.DigestRangeSummary
+	(*SummarizeDigestRangesResponse)(nil), // 3: buildbarn.digestenumeration.SummarizeDigestRangesResponse
+	(*ListDigestsRequest)(nil),            // 4: buildbarn.digestenumeration.ListDigestsRequest
+	(*ListDigestsResponse)(nil),           // 5: buildbarn.digestenumeration.ListDigestsResponse
+	(*ListDigestsResponse_Blob)(nil),      // 6: buildbarn.digestenumeration.ListDigestsResponse.Blob
+	(v2.DigestFunction_Value)(0),          // 7: build.bazel.remote.execution.v2.DigestFunction.Value
+	(*v2.Digest)(nil),                     // 8: build.bazel.remote.execution.v2.Digest
+}
+var file_pkg_proto_digestenumeration_digestenumeration_proto_depIdxs = []int32{
+	0, // 0: buildbarn.digestenumeration.SummarizeDigestRangesRequest.storage_type:type_name -> buildbarn.digestenumeration.StorageType
+	2, // 1: buildbarn.digestenumeration.SummarizeDigestRangesResponse.ranges:type_name -> buildbarn.digestenumeration.DigestRangeSummary
+	0, // 2: buildbarn.digestenumeration.ListDigestsRequest.storage_type:type_name -> buildbarn.digestenumeration.StorageType
+	6, // 3: buildbarn.digestenumeration.ListDigestsResponse.blobs:type_name -> buildbarn.digestenumeration.ListDigestsResponse.Blob
+	7, // 4: buildbarn.digestenumeration.ListDigestsResponse.Blob.digest_function:type_name -> build.bazel.remote.execution.v2.DigestFunction.Value
+	8, // 5: buildbarn.digestenumeration.ListDigestsResponse.Blob.digest:type_name -> build.bazel.remote.execution.v2.Digest
+	1, // 6: buildbarn.digestenumeration.DigestEnumeration.SummarizeDigestRanges:input_type -> buildbarn.digestenumeration.SummarizeDigestRangesRequest
+	4, // 7: buildbarn.digestenumeration.DigestEnumeration.ListDigests:input_type -> buildbarn.digestenumeration.ListDigestsRequest
+	3, // 8: buildbarn.digestenumeration.DigestEnumeration.SummarizeDigestRanges:output_type -> buildbarn.digestenumeration.SummarizeDigestRangesResponse
+	5, // 9: buildbarn.digestenumeration.DigestEnumeration.ListDigests:output_type -> buildbarn.digestenumeration.ListDigestsResponse
+	8, // [8:10] is the sub-list for method output_type
+	6, // [6:8] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_pkg_proto_digestenumeration_digestenumeration_proto_init() }
+func file_pkg_proto_digestenumeration_digestenumeration_proto_init() {
+	if File_pkg_proto_digestenumeration_digestenumeration_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_proto_digestenumeration_digestenumeration_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_proto_digestenumeration_digestenumeration_proto_goTypes,
+		DependencyIndexes: file_pkg_proto_digestenumeration_digestenumeration_proto_depIdxs,
+		EnumInfos:         file_pkg_proto_digestenumeration_digestenumeration_proto_enumTypes,
+		MessageInfos:      file_pkg_proto_digestenumeration_digestenumeration_proto_msgTypes,
+	}.Build()
+	File_pkg_proto_digestenumeration_digestenumeration_proto = out.File
+	file_pkg_proto_digestenumeration_digestenumeration_proto_rawDesc = nil
+	file_pkg_proto_digestenumeration_digestenumeration_proto_goTypes = nil
+	file_pkg_proto_digestenumeration_digestenumeration_proto_depIdxs = nil
+}
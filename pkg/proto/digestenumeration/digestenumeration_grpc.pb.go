@@ -0,0 +1,172 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.28.3
+// source: pkg/proto/digestenumeration/digestenumeration.proto
+
+package digestenumeration
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	DigestEnumeration_SummarizeDigestRanges_FullMethodName = "/buildbarn.digestenumeration.DigestEnumeration/SummarizeDigestRanges"
+	DigestEnumeration_ListDigests_FullMethodName           = "/buildbarn.digestenumeration.DigestEnumeration/ListDigests"
+)
+
+// DigestEnumerationClient is the client API for DigestEnumeration service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DigestEnumerationClient interface {
+	SummarizeDigestRanges(ctx context.Context, in *SummarizeDigestRangesRequest, opts ...grpc.CallOption) (*SummarizeDigestRangesResponse, error)
+	ListDigests(ctx context.Context, in *ListDigestsRequest, opts ...grpc.CallOption) (DigestEnumeration_ListDigestsClient, error)
+}
+
+type digestEnumerationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDigestEnumerationClient(cc grpc.ClientConnInterface) DigestEnumerationClient {
+	return &digestEnumerationClient{cc}
+}
+
+func (c *digestEnumerationClient) SummarizeDigestRanges(ctx context.Context, in *SummarizeDigestRangesRequest, opts ...grpc.CallOption) (*SummarizeDigestRangesResponse, error) {
+	out := new(SummarizeDigestRangesResponse)
+	err := c.cc.Invoke(ctx, DigestEnumeration_SummarizeDigestRanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *digestEnumerationClient) ListDigests(ctx context.Context, in *ListDigestsRequest, opts ...grpc.CallOption) (DigestEnumeration_ListDigestsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DigestEnumeration_ServiceDesc.Streams[0], DigestEnumeration_ListDigests_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &digestEnumerationListDigestsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DigestEnumeration_ListDigestsClient interface {
+	Recv() (*ListDigestsResponse, error)
+	grpc.ClientStream
+}
+
+type digestEnumerationListDigestsClient struct {
+	grpc.ClientStream
+}
+
+func (x *digestEnumerationListDigestsClient) Recv() (*ListDigestsResponse, error) {
+	m := new(ListDigestsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// DigestEnumerationServer is the server API for DigestEnumeration service.
+// All implementations should embed UnimplementedDigestEnumerationServer
+// for forward compatibility
+type DigestEnumerationServer interface {
+	SummarizeDigestRanges(context.Context, *SummarizeDigestRangesRequest) (*SummarizeDigestRangesResponse, error)
+	ListDigests(*ListDigestsRequest, DigestEnumeration_ListDigestsServer) error
+}
+
+// UnimplementedDigestEnumerationServer should be embedded to have forward compatible implementations.
+type UnimplementedDigestEnumerationServer struct {
+}
+
+func (UnimplementedDigestEnumerationServer) SummarizeDigestRanges(context.Context, *SummarizeDigestRangesRequest) (*SummarizeDigestRangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SummarizeDigestRanges not implemented")
+}
+func (UnimplementedDigestEnumerationServer) ListDigests(*ListDigestsRequest, DigestEnumeration_ListDigestsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListDigests not implemented")
+}
+
+// UnsafeDigestEnumerationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DigestEnumerationServer will
+// result in compilation errors.
+type UnsafeDigestEnumerationServer interface {
+	mustEmbedUnimplementedDigestEnumerationServer()
+}
+
+func RegisterDigestEnumerationServer(s grpc.ServiceRegistrar, srv DigestEnumerationServer) {
+	s.RegisterService(&DigestEnumeration_ServiceDesc, srv)
+}
+
+func _DigestEnumeration_SummarizeDigestRanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SummarizeDigestRangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DigestEnumerationServer).SummarizeDigestRanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DigestEnumeration_SummarizeDigestRanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DigestEnumerationServer).SummarizeDigestRanges(ctx, req.(*SummarizeDigestRangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DigestEnumeration_ListDigests_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListDigestsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DigestEnumerationServer).ListDigests(m, &digestEnumerationListDigestsServer{stream})
+}
+
+type DigestEnumeration_ListDigestsServer interface {
+	Send(*ListDigestsResponse) error
+	grpc.ServerStream
+}
+
+type digestEnumerationListDigestsServer struct {
+	grpc.ServerStream
+}
+
+func (x *digestEnumerationListDigestsServer) Send(m *ListDigestsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// DigestEnumeration_ServiceDesc is the grpc.ServiceDesc for DigestEnumeration service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DigestEnumeration_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "buildbarn.digestenumeration.DigestEnumeration",
+	HandlerType: (*DigestEnumerationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SummarizeDigestRanges",
+			Handler:    _DigestEnumeration_SummarizeDigestRanges_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListDigests",
+			Handler:       _DigestEnumeration_ListDigests_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pkg/proto/digestenumeration/digestenumeration.proto",
+}
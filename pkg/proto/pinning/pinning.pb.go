@@ -0,0 +1,524 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v5.28.3
+// source: pkg/proto/pinning/pinning.proto
+
+package pinning
+
+import (
+	v2 "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PinBlobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceName   string                  `protobuf:"bytes,1,opt,name=instance_name,json=instanceName,proto3" json:"instance_name,omitempty"`
+	BlobDigests    []*v2.Digest            `protobuf:"bytes,2,rep,name=blob_digests,json=blobDigests,proto3" json:"blob_digests,omitempty"`
+	DigestFunction v2.DigestFunction_Value `protobuf:"varint,3,opt,name=digest_function,json=digestFunction,proto3,enum=build.bazel.remote.execution.v2.DigestFunction_Value" json:"digest_function,omitempty"`
+}
+
+func (x *PinBlobsRequest) Reset() {
+	*x = PinBlobsRequest{}
+	mi := &file_pkg_proto_pinning_pinning_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinBlobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinBlobsRequest) ProtoMessage() {}
+
+func (x *PinBlobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_pinning_pinning_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinBlobsRequest.ProtoReflect.Descriptor instead.
+func (*PinBlobsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_pinning_pinning_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PinBlobsRequest) GetInstanceName() string {
+	if x != nil {
+		return x.InstanceName
+	}
+	return ""
+}
+
+func (x *PinBlobsRequest) GetBlobDigests() []*v2.Digest {
+	if x != nil {
+		return x.BlobDigests
+	}
+	return nil
+}
+
+func (x *PinBlobsRequest) GetDigestFunction() v2.DigestFunction_Value {
+	if x != nil {
+		return x.DigestFunction
+	}
+	return v2.DigestFunction_Value(0)
+}
+
+type PinBlobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MissingBlobDigests []*v2.Digest `protobuf:"bytes,1,rep,name=missing_blob_digests,json=missingBlobDigests,proto3" json:"missing_blob_digests,omitempty"`
+}
+
+func (x *PinBlobsResponse) Reset() {
+	*x = PinBlobsResponse{}
+	mi := &file_pkg_proto_pinning_pinning_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinBlobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinBlobsResponse) ProtoMessage() {}
+
+func (x *PinBlobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_pinning_pinning_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinBlobsResponse.ProtoReflect.Descriptor instead.
+func (*PinBlobsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_pinning_pinning_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PinBlobsResponse) GetMissingBlobDigests() []*v2.Digest {
+	if x != nil {
+		return x.MissingBlobDigests
+	}
+	return nil
+}
+
+type UnpinBlobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceName   string                  `protobuf:"bytes,1,opt,name=instance_name,json=instanceName,proto3" json:"instance_name,omitempty"`
+	BlobDigests    []*v2.Digest            `protobuf:"bytes,2,rep,name=blob_digests,json=blobDigests,proto3" json:"blob_digests,omitempty"`
+	DigestFunction v2.DigestFunction_Value `protobuf:"varint,3,opt,name=digest_function,json=digestFunction,proto3,enum=build.bazel.remote.execution.v2.DigestFunction_Value" json:"digest_function,omitempty"`
+}
+
+func (x *UnpinBlobsRequest) Reset() {
+	*x = UnpinBlobsRequest{}
+	mi := &file_pkg_proto_pinning_pinning_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinBlobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinBlobsRequest) ProtoMessage() {}
+
+func (x *UnpinBlobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_pinning_pinning_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinBlobsRequest.ProtoReflect.Descriptor instead.
+func (*UnpinBlobsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_pinning_pinning_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UnpinBlobsRequest) GetInstanceName() string {
+	if x != nil {
+		return x.InstanceName
+	}
+	return ""
+}
+
+func (x *UnpinBlobsRequest) GetBlobDigests() []*v2.Digest {
+	if x != nil {
+		return x.BlobDigests
+	}
+	return nil
+}
+
+func (x *UnpinBlobsRequest) GetDigestFunction() v2.DigestFunction_Value {
+	if x != nil {
+		return x.DigestFunction
+	}
+	return v2.DigestFunction_Value(0)
+}
+
+type GetPinnedUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlobsCount        int64 `protobuf:"varint,1,opt,name=blobs_count,json=blobsCount,proto3" json:"blobs_count,omitempty"`
+	SizeBytes         int64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	MissingBlobsCount int64 `protobuf:"varint,3,opt,name=missing_blobs_count,json=missingBlobsCount,proto3" json:"missing_blobs_count,omitempty"`
+}
+
+func (x *GetPinnedUsageResponse) Reset() {
+	*x = GetPinnedUsageResponse{}
+	mi := &file_pkg_proto_pinning_pinning_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPinnedUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPinnedUsageResponse) ProtoMessage() {}
+
+func (x *GetPinnedUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_pinning_pinning_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPinnedUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetPinnedUsageResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_pinning_pinning_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPinnedUsageResponse) GetBlobsCount() int64 {
+	if x != nil {
+		return x.BlobsCount
+	}
+	return 0
+}
+
+func (x *GetPinnedUsageResponse) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *GetPinnedUsageResponse) GetMissingBlobsCount() int64 {
+	if x != nil {
+		return x.MissingBlobsCount
+	}
+	return 0
+}
+
+type PersistentState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blobs []*PersistentState_Blob `protobuf:"bytes,1,rep,name=blobs,proto3" json:"blobs,omitempty"`
+}
+
+func (x *PersistentState) Reset() {
+	*x = PersistentState{}
+	mi := &file_pkg_proto_pinning_pinning_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersistentState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistentState) ProtoMessage() {}
+
+func (x *PersistentState) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_pinning_pinning_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistentState.ProtoReflect.Descriptor instead.
+func (*PersistentState) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_pinning_pinning_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PersistentState) GetBlobs() []*PersistentState_Blob {
+	if x != nil {
+		return x.Blobs
+	}
+	return nil
+}
+
+type PersistentState_Blob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceName   string                  `protobuf:"bytes,1,opt,name=instance_name,json=instanceName,proto3" json:"instance_name,omitempty"`
+	DigestFunction v2.DigestFunction_Value `protobuf:"varint,2,opt,name=digest_function,json=digestFunction,proto3,enum=build.bazel.remote.execution.v2.DigestFunction_Value" json:"digest_function,omitempty"`
+	Digest         *v2.Digest              `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+}
+
+func (x *PersistentState_Blob) Reset() {
+	*x = PersistentState_Blob{}
+	mi := &file_pkg_proto_pinning_pinning_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersistentState_Blob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistentState_Blob) ProtoMessage() {}
+
+func (x *PersistentState_Blob) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_pinning_pinning_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistentState_Blob.ProtoReflect.Descriptor instead.
+func (*PersistentState_Blob) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_pinning_pinning_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *PersistentState_Blob) GetInstanceName() string {
+	if x != nil {
+		return x.InstanceName
+	}
+	return ""
+}
+
+func (x *PersistentState_Blob) GetDigestFunction() v2.DigestFunction_Value {
+	if x != nil {
+		return x.DigestFunction
+	}
+	return v2.DigestFunction_Value(0)
+}
+
+func (x *PersistentState_Blob) GetDigest() *v2.Digest {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+var File_pkg_proto_pinning_pinning_proto protoreflect.FileDescriptor
+
+var file_pkg_proto_pinning_pinning_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x69, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2f, 0x70, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x11, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x70, 0x69, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x1a, 0x36, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2f, 0x62, 0x61, 0x7a, 0x65,
+	0x6c, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x76, 0x32, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe2, 0x01, 0x0a, 0x0f, 0x50, 0x69,
+	0x6e, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x62, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x12, 0x5e,
+	0x0a, 0x0f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e,
+	0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e,
+	0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6d,
+	0x0a, 0x10, 0x50, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x59, 0x0a, 0x14, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x6c,
+	0x6f, 0x62, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x32, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x12, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x62, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x22, 0xe4, 0x01,
+	0x0a, 0x11, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x62,
+	0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32,
+	0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x62, 0x44, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x5e, 0x0a, 0x0f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x66,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e,
+	0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x88, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e,
+	0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x2e, 0x0a, 0x13, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x73,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x9f, 0x02, 0x0a, 0x0f, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x70,
+	0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x05, 0x62, 0x6c, 0x6f,
+	0x62, 0x73, 0x1a, 0xcc, 0x01, 0x0a, 0x04, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x23, 0x0a, 0x0d, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x5e, 0x0a, 0x0f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0e, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x3f, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x32, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x32, 0xff, 0x01, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x53, 0x0a,
+	0x08, 0x50, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x12, 0x22, 0x2e, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x70, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x69,
+	0x6e, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x70, 0x69, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x50, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0a, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x62, 0x73,
+	0x12, 0x24, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x70, 0x69, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x53,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x29, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x62, 0x61, 0x72, 0x6e, 0x2e, 0x70, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2f, 0x62, 0x62, 0x2d, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x70, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_proto_pinning_pinning_proto_rawDescOnce sync.Once
+	file_pkg_proto_pinning_pinning_proto_rawDescData = file_pkg_proto_pinning_pinning_proto_rawDesc
+)
+
+func file_pkg_proto_pinning_pinning_proto_rawDescGZIP() []byte {
+	file_pkg_proto_pinning_pinning_proto_rawDescOnce.Do(func() {
+		file_pkg_proto_pinning_pinning_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_proto_pinning_pinning_proto_rawDescData)
+	})
+	return file_pkg_proto_pinning_pinning_proto_rawDescData
+}
+
+var file_pkg_proto_pinning_pinning_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_pkg_proto_pinning_pinning_proto_goTypes = []any{
+	(*PinBlobsRequest)(nil),        // 0: buildbarn.pinning.PinBlobsRequest
+	(*PinBlobsResponse)(nil),       // 1: buildbarn.pinning.PinBlobsResponse
+	(*UnpinBlobsRequest)(nil),      // 2: buildbarn.pinning.UnpinBlobsRequest
+	(*GetPinnedUsageResponse)(nil), // 3: buildbarn.pinning.GetPinnedUsageResponse
+	(*PersistentState)(nil),        // 4: buildbarn.pinning.PersistentState
+	(*PersistentState_Blob)(nil),   // 5: buildbarn.pinning.PersistentState.Blob
+	(*v2.Digest)(nil),              // 6: build.bazel.remote.execution.v2.Digest
+	(v2.DigestFunction_Value)(0),   // 7: build.bazel.remote.execution.v2.DigestFunction.Value
+	(*emptypb.Empty)(nil),          // 8: google.protobuf.Empty
+}
+var file_pkg_proto_pinning_pinning_proto_depIdxs = []int32{
+	6,  // 0: buildbarn.pinning.PinBlobsRequest.blob_digests:type_name -> build.bazel.remote.execution.v2.Digest
+	7,  // 1: buildbarn.pinning.PinBlobsRequest.digest_function:type_name -> build.bazel.remote.execution.v2.DigestFunction.Value
+	6,  // 2: buildbarn.pinning.PinBlobsResponse.missing_blob_digests:type_name -> build.bazel.remote.execution.v2.Digest
+	6,  // 3: buildbarn.pinning.UnpinBlobsRequest.blob_digests:type_name -> build.bazel.remote.execution.v2.Digest
+	7,  // 4: buildbarn.pinning.UnpinBlobsRequest.digest_function:type_name -> build.bazel.remote.execution.v2.DigestFunction.Value
+	5,  // 5: buildbarn.pinning.PersistentState.blobs:type_name -> buildbarn.pinning.PersistentState.Blob
+	7,  // 6: buildbarn.pinning.PersistentState.Blob.digest_function:type_name -> build.bazel.remote.execution.v2.DigestFunction.Value
+	6,  // 7: buildbarn.pinning.PersistentState.Blob.digest:type_name -> build.bazel.remote.execution.v2.Digest
+	0,  // 8: buildbarn.pinning.Pinning.PinBlobs:input_type -> buildbarn.pinning.PinBlobsRequest
+	2,  // 9: buildbarn.pinning.Pinning.UnpinBlobs:input_type -> buildbarn.pinning.UnpinBlobsRequest
+	8,  // 10: buildbarn.pinning.Pinning.GetPinnedUsage:input_type -> google.protobuf.Empty
+	1,  // 11: buildbarn.pinning.Pinning.PinBlobs:output_type -> buildbarn.pinning.PinBlobsResponse
+	8,  // 12: buildbarn.pinning.Pinning.UnpinBlobs:output_type -> google.protobuf.Empty
+	3,  // 13: buildbarn.pinning.Pinning.GetPinnedUsage:output_type -> buildbarn.pinning.GetPinnedUsageResponse
+	11, // [11:14] is the sub-list for method output_type
+	8,  // [8:11] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_pkg_proto_pinning_pinning_proto_init() }
+func file_pkg_proto_pinning_pinning_proto_init() {
+	if File_pkg_proto_pinning_pinning_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_proto_pinning_pinning_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_proto_pinning_pinning_proto_goTypes,
+		DependencyIndexes: file_pkg_proto_pinning_pinning_proto_depIdxs,
+		MessageInfos:      file_pkg_proto_pinning_pinning_proto_msgTypes,
+	}.Build()
+	File_pkg_proto_pinning_pinning_proto = out.File
+	file_pkg_proto_pinning_pinning_proto_rawDesc = nil
+	file_pkg_proto_pinning_pinning_proto_goTypes = nil
+	file_pkg_proto_pinning_pinning_proto_depIdxs = nil
+}
@@ -0,0 +1,182 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.28.3
+// source: pkg/proto/pinning/pinning.proto
+
+package pinning
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Pinning_PinBlobs_FullMethodName       = "/buildbarn.pinning.Pinning/PinBlobs"
+	Pinning_UnpinBlobs_FullMethodName     = "/buildbarn.pinning.Pinning/UnpinBlobs"
+	Pinning_GetPinnedUsage_FullMethodName = "/buildbarn.pinning.Pinning/GetPinnedUsage"
+)
+
+// PinningClient is the client API for Pinning service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PinningClient interface {
+	PinBlobs(ctx context.Context, in *PinBlobsRequest, opts ...grpc.CallOption) (*PinBlobsResponse, error)
+	UnpinBlobs(ctx context.Context, in *UnpinBlobsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetPinnedUsage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPinnedUsageResponse, error)
+}
+
+type pinningClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPinningClient(cc grpc.ClientConnInterface) PinningClient {
+	return &pinningClient{cc}
+}
+
+func (c *pinningClient) PinBlobs(ctx context.Context, in *PinBlobsRequest, opts ...grpc.CallOption) (*PinBlobsResponse, error) {
+	out := new(PinBlobsResponse)
+	err := c.cc.Invoke(ctx, Pinning_PinBlobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinningClient) UnpinBlobs(ctx context.Context, in *UnpinBlobsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Pinning_UnpinBlobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinningClient) GetPinnedUsage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPinnedUsageResponse, error) {
+	out := new(GetPinnedUsageResponse)
+	err := c.cc.Invoke(ctx, Pinning_GetPinnedUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PinningServer is the server API for Pinning service.
+// All implementations should embed UnimplementedPinningServer
+// for forward compatibility
+type PinningServer interface {
+	PinBlobs(context.Context, *PinBlobsRequest) (*PinBlobsResponse, error)
+	UnpinBlobs(context.Context, *UnpinBlobsRequest) (*emptypb.Empty, error)
+	GetPinnedUsage(context.Context, *emptypb.Empty) (*GetPinnedUsageResponse, error)
+}
+
+// UnimplementedPinningServer should be embedded to have forward compatible implementations.
+type UnimplementedPinningServer struct {
+}
+
+func (UnimplementedPinningServer) PinBlobs(context.Context, *PinBlobsRequest) (*PinBlobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinBlobs not implemented")
+}
+func (UnimplementedPinningServer) UnpinBlobs(context.Context, *UnpinBlobsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinBlobs not implemented")
+}
+func (UnimplementedPinningServer) GetPinnedUsage(context.Context, *emptypb.Empty) (*GetPinnedUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPinnedUsage not implemented")
+}
+
+// UnsafePinningServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PinningServer will
+// result in compilation errors.
+type UnsafePinningServer interface {
+	mustEmbedUnimplementedPinningServer()
+}
+
+func RegisterPinningServer(s grpc.ServiceRegistrar, srv PinningServer) {
+	s.RegisterService(&Pinning_ServiceDesc, srv)
+}
+
+func _Pinning_PinBlobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinBlobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinningServer).PinBlobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pinning_PinBlobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinningServer).PinBlobs(ctx, req.(*PinBlobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pinning_UnpinBlobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinBlobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinningServer).UnpinBlobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pinning_UnpinBlobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinningServer).UnpinBlobs(ctx, req.(*UnpinBlobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pinning_GetPinnedUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinningServer).GetPinnedUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pinning_GetPinnedUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinningServer).GetPinnedUsage(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Pinning_ServiceDesc is the grpc.ServiceDesc for Pinning service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Pinning_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "buildbarn.pinning.Pinning",
+	HandlerType: (*PinningServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PinBlobs",
+			Handler:    _Pinning_PinBlobs_Handler,
+		},
+		{
+			MethodName: "UnpinBlobs",
+			Handler:    _Pinning_UnpinBlobs_Handler,
+		},
+		{
+			MethodName: "GetPinnedUsage",
+			Handler:    _Pinning_GetPinnedUsage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/proto/pinning/pinning.proto",
+}
@@ -106,3 +106,128 @@ var Replicator_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/proto/replicator/replicator.proto",
 }
+
+const (
+	ReplicationQueue_ListPendingReplications_FullMethodName  = "/buildbarn.replicator.ReplicationQueue/ListPendingReplications"
+	ReplicationQueue_PurgePendingReplications_FullMethodName = "/buildbarn.replicator.ReplicationQueue/PurgePendingReplications"
+)
+
+// ReplicationQueueClient is the client API for ReplicationQueue service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReplicationQueueClient interface {
+	ListPendingReplications(ctx context.Context, in *ListPendingReplicationsRequest, opts ...grpc.CallOption) (*ListPendingReplicationsResponse, error)
+	PurgePendingReplications(ctx context.Context, in *PurgePendingReplicationsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type replicationQueueClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReplicationQueueClient(cc grpc.ClientConnInterface) ReplicationQueueClient {
+	return &replicationQueueClient{cc}
+}
+
+func (c *replicationQueueClient) ListPendingReplications(ctx context.Context, in *ListPendingReplicationsRequest, opts ...grpc.CallOption) (*ListPendingReplicationsResponse, error) {
+	out := new(ListPendingReplicationsResponse)
+	err := c.cc.Invoke(ctx, ReplicationQueue_ListPendingReplications_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *replicationQueueClient) PurgePendingReplications(ctx context.Context, in *PurgePendingReplicationsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ReplicationQueue_PurgePendingReplications_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReplicationQueueServer is the server API for ReplicationQueue service.
+// All implementations should embed UnimplementedReplicationQueueServer
+// for forward compatibility
+type ReplicationQueueServer interface {
+	ListPendingReplications(context.Context, *ListPendingReplicationsRequest) (*ListPendingReplicationsResponse, error)
+	PurgePendingReplications(context.Context, *PurgePendingReplicationsRequest) (*emptypb.Empty, error)
+}
+
+// UnimplementedReplicationQueueServer should be embedded to have forward compatible implementations.
+type UnimplementedReplicationQueueServer struct {
+}
+
+func (UnimplementedReplicationQueueServer) ListPendingReplications(context.Context, *ListPendingReplicationsRequest) (*ListPendingReplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingReplications not implemented")
+}
+func (UnimplementedReplicationQueueServer) PurgePendingReplications(context.Context, *PurgePendingReplicationsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgePendingReplications not implemented")
+}
+
+// UnsafeReplicationQueueServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReplicationQueueServer will
+// result in compilation errors.
+type UnsafeReplicationQueueServer interface {
+	mustEmbedUnimplementedReplicationQueueServer()
+}
+
+func RegisterReplicationQueueServer(s grpc.ServiceRegistrar, srv ReplicationQueueServer) {
+	s.RegisterService(&ReplicationQueue_ServiceDesc, srv)
+}
+
+func _ReplicationQueue_ListPendingReplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingReplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplicationQueueServer).ListPendingReplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReplicationQueue_ListPendingReplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplicationQueueServer).ListPendingReplications(ctx, req.(*ListPendingReplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReplicationQueue_PurgePendingReplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgePendingReplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplicationQueueServer).PurgePendingReplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReplicationQueue_PurgePendingReplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplicationQueueServer).PurgePendingReplications(ctx, req.(*PurgePendingReplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReplicationQueue_ServiceDesc is the grpc.ServiceDesc for ReplicationQueue service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReplicationQueue_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "buildbarn.replicator.ReplicationQueue",
+	HandlerType: (*ReplicationQueueServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPendingReplications",
+			Handler:    _ReplicationQueue_ListPendingReplications_Handler,
+		},
+		{
+			MethodName: "PurgePendingReplications",
+			Handler:    _ReplicationQueue_PurgePendingReplications_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/proto/replicator/replicator.proto",
+}